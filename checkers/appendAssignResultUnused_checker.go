@@ -0,0 +1,199 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "appendAssignResultUnused"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects appends to slice copies and params that can't be observed"
+	info.Before = `
+func addDefaults(opts []string) {
+	opts = append(opts, "-v")
+}`
+	info.After = `
+func addDefaults(opts []string) []string {
+	return append(opts, "-v")
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&appendAssignResultUnusedChecker{ctx: ctx}), nil
+	})
+}
+
+type appendAssignResultUnusedChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// sliceCopy is a `dst := src` slice variable copy.
+type sliceCopy struct {
+	stmt     *ast.AssignStmt
+	dst, src types.Object
+}
+
+// sliceUse is a slice variable use.
+type sliceUse struct {
+	id *ast.Ident
+	// selfAppend is true for the `x = append(x, ...)` operands.
+	selfAppend bool
+	// assigned is true if the variable is assigned to.
+	assigned bool
+	// inClosure is true if the use is inside a function literal.
+	inClosure bool
+}
+
+func (c *appendAssignResultUnusedChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	appends := make(map[types.Object][]*ast.AssignStmt)
+	uses := make(map[types.Object][]sliceUse)
+	selfAppends := make(map[*ast.Ident]bool)
+	assigned := make(map[*ast.Ident]bool)
+	var copies []sliceCopy
+	var loops []ast.Stmt
+	closureDepth := 0
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			if _, ok := stack[len(stack)-1].(*ast.FuncLit); ok {
+				closureDepth--
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			closureDepth++
+		case *ast.ForStmt, *ast.RangeStmt:
+			loops = append(loops, n.(ast.Stmt))
+		case *ast.AssignStmt:
+			c.collectAssign(n, appends, selfAppends, assigned, &copies)
+		case *ast.Ident:
+			obj := c.ctx.TypesInfo.ObjectOf(n)
+			if obj == nil || !c.isSlice(obj.Type()) {
+				break
+			}
+			uses[obj] = append(uses[obj], sliceUse{
+				id:         n,
+				selfAppend: selfAppends[n],
+				assigned:   assigned[n],
+				inClosure:  closureDepth != 0,
+			})
+		}
+		return true
+	})
+
+	for _, cp := range copies {
+		c.checkCopy(cp, appends[cp.dst], uses[cp.src])
+	}
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			obj := c.ctx.TypesInfo.ObjectOf(name)
+			if obj == nil {
+				continue
+			}
+			if paramAppends := appends[obj]; len(paramAppends) != 0 {
+				c.checkParam(paramAppends, uses[obj], loops)
+			}
+		}
+	}
+}
+
+func (c *appendAssignResultUnusedChecker) collectAssign(assign *ast.AssignStmt, appends map[types.Object][]*ast.AssignStmt, selfAppends, assigned map[*ast.Ident]bool, copies *[]sliceCopy) {
+	if assign.Tok == token.ASSIGN || assign.Tok == token.DEFINE {
+		for _, lhs := range assign.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok {
+				assigned[id] = true
+			}
+		}
+	}
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	lhs := astcast.ToIdent(assign.Lhs[0])
+	dst := c.ctx.TypesInfo.ObjectOf(lhs)
+	if dst == nil || !c.isSlice(dst.Type()) {
+		return
+	}
+
+	if rhs, ok := assign.Rhs[0].(*ast.Ident); ok && assign.Tok == token.DEFINE {
+		if src := c.ctx.TypesInfo.ObjectOf(rhs); src != nil && src != dst {
+			*copies = append(*copies, sliceCopy{stmt: assign, dst: dst, src: src})
+		}
+		return
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	if assign.Tok != token.ASSIGN || !isBuiltinCall(c.ctx.TypesInfo, call, "append") || len(call.Args) == 0 {
+		return
+	}
+	arg := astcast.ToIdent(call.Args[0])
+	if c.ctx.TypesInfo.ObjectOf(arg) == dst {
+		appends[dst] = append(appends[dst], assign)
+		selfAppends[lhs] = true
+		selfAppends[arg] = true
+	}
+}
+
+// checkCopy reports `b := a; b = append(b, x)` followed by a read of a.
+func (c *appendAssignResultUnusedChecker) checkCopy(cp sliceCopy, appends []*ast.AssignStmt, srcUses []sliceUse) {
+	var appendStmt *ast.AssignStmt
+	for _, stmt := range appends {
+		if stmt.Pos() > cp.stmt.End() {
+			appendStmt = stmt
+			break
+		}
+	}
+	if appendStmt == nil {
+		return
+	}
+	for _, use := range srcUses {
+		if use.id.Pos() < appendStmt.End() {
+			continue
+		}
+		if !use.assigned {
+			c.ctx.Warn(appendStmt, "%s is a copy of %s, appending to it doesn't change %s",
+				cp.dst.Name(), cp.src.Name(), cp.src.Name())
+		}
+		return
+	}
+}
+
+// checkParam reports slice params that are appended to,
+// while the result is never used afterwards.
+func (c *appendAssignResultUnusedChecker) checkParam(appends []*ast.AssignStmt, uses []sliceUse, loops []ast.Stmt) {
+	first := appends[0]
+	for _, use := range uses {
+		if use.selfAppend {
+			continue
+		}
+		if use.inClosure || use.id.Pos() > first.Pos() {
+			return
+		}
+		// Uses before the append are still executed after it
+		// if they are in the same loop.
+		for _, loop := range loops {
+			if loop.Pos() <= use.id.Pos() && first.End() <= loop.End() {
+				return
+			}
+		}
+	}
+	c.ctx.Warn(first, "append result is invisible to the caller; return the slice or use a pointer")
+}
+
+func (c *appendAssignResultUnusedChecker) isSlice(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Slice)
+	return ok
+}
@@ -0,0 +1,210 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "atomicValueMixedUse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects variables and fields that are accessed both atomically and non-atomically"
+	info.Before = `
+atomic.AddInt64(&s.hits, 1)
+// ...
+if s.hits > limit { /* ... */ }`
+	info.After = `
+atomic.AddInt64(&s.hits, 1)
+// ...
+if atomic.LoadInt64(&s.hits) > limit { /* ... */ }`
+	info.Note = "Plain accesses in init functions and in constructors before the first go statement are permitted"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &atomicValueMixedUseChecker{ctx: ctx}, nil
+	})
+}
+
+type atomicValueMixedUseChecker struct {
+	ctx *linter.CheckerContext
+
+	// mixed maps the first plain access of every variable
+	// to the first atomic access of the same variable.
+	mixed map[ast.Expr]ast.Expr
+}
+
+// atomicAccesses holds the first accesses of a variable of each kind.
+type atomicAccesses struct {
+	atomic ast.Expr
+	plain  ast.Expr
+}
+
+func (c *atomicValueMixedUseChecker) WalkPackage(files []*ast.File) {
+	accesses := make(map[*types.Var]*atomicAccesses)
+	var order []*types.Var
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Body == nil {
+				continue
+			}
+			c.collectAccesses(decl, func(v *types.Var, e ast.Expr, atomic bool) {
+				acc := accesses[v]
+				if acc == nil {
+					acc = &atomicAccesses{}
+					accesses[v] = acc
+					order = append(order, v)
+				}
+				if atomic && acc.atomic == nil {
+					acc.atomic = e
+				}
+				if !atomic && acc.plain == nil {
+					acc.plain = e
+				}
+			})
+		}
+	}
+
+	c.mixed = make(map[ast.Expr]ast.Expr)
+	for _, v := range order {
+		acc := accesses[v]
+		if acc.atomic != nil && acc.plain != nil {
+			c.mixed[acc.plain] = acc.atomic
+		}
+	}
+}
+
+func (c *atomicValueMixedUseChecker) collectAccesses(decl *ast.FuncDecl, visit func(v *types.Var, e ast.Expr, atomic bool)) {
+	// Accesses before exemptEnd are done before the value is shared.
+	exemptEnd := token.NoPos
+	if decl.Recv == nil {
+		switch name := decl.Name.Name; {
+		case name == "init":
+			exemptEnd = decl.Body.End()
+		case strings.HasPrefix(name, "New") || strings.HasPrefix(name, "new"):
+			exemptEnd = c.firstGoStmt(decl.Body)
+		}
+	}
+
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		v, ok := c.ctx.TypesInfo.Uses[id].(*types.Var)
+		if !ok {
+			return true
+		}
+		// e is the whole access expression, like x or s.x.
+		var e ast.Expr = id
+		parents := stack[:len(stack)-1]
+		if len(parents) != 0 {
+			if sel, ok := parents[len(parents)-1].(*ast.SelectorExpr); ok && sel.Sel == id {
+				e = sel
+				parents = parents[:len(parents)-1]
+			}
+		}
+		if len(parents) == 0 {
+			return true
+		}
+
+		switch parent := parents[len(parents)-1].(type) {
+		case *ast.UnaryExpr:
+			if parent.Op != token.AND {
+				break
+			}
+			if len(parents) >= 2 {
+				if call, ok := parents[len(parents)-2].(*ast.CallExpr); ok && c.isAtomicCall(call, parent) {
+					visit(v, e, true)
+				}
+			}
+			// Address escapes somewhere, we can't tell how it's accessed.
+			return true
+		case *ast.SelectorExpr:
+			if parent.X == e {
+				if c.isAtomicType(v.Type()) {
+					visit(v, e, true)
+				}
+				// Otherwise it's an access to the other variable field.
+				return true
+			}
+		case *ast.KeyValueExpr:
+			if parent.Key == e {
+				// Composite literal key initializes a new value.
+				return true
+			}
+		}
+		if e.Pos() < exemptEnd {
+			return true
+		}
+		visit(v, e, false)
+		return true
+	})
+}
+
+// isAtomicCall reports whether call is a sync/atomic function call
+// with addr as its first argument.
+func (c *atomicValueMixedUseChecker) isAtomicCall(call *ast.CallExpr, addr ast.Expr) bool {
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "sync/atomic" {
+		return false
+	}
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return false
+	}
+	return len(call.Args) != 0 && call.Args[0] == addr
+}
+
+func (c *atomicValueMixedUseChecker) isAtomicType(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	pkg := named.Obj().Pkg()
+	return pkg != nil && pkg.Path() == "sync/atomic"
+}
+
+func (c *atomicValueMixedUseChecker) firstGoStmt(body *ast.BlockStmt) token.Pos {
+	pos := body.End()
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n, ok := n.(*ast.GoStmt); ok && n.Pos() < pos {
+			pos = n.Pos()
+		}
+		return true
+	})
+	return pos
+}
+
+func (c *atomicValueMixedUseChecker) WalkFile(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		e, ok := n.(ast.Expr)
+		if !ok {
+			return true
+		}
+		atomic := c.mixed[e]
+		if atomic == nil {
+			return true
+		}
+		c.ctx.Warn(e, "%s is accessed both atomically at %s and non-atomically at %s; use sync/atomic for every access",
+			e, c.location(atomic), c.location(e))
+		return true
+	})
+}
+
+func (c *atomicValueMixedUseChecker) location(n ast.Node) string {
+	pos := c.ctx.FileSet.Position(n.Pos())
+	return fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+}
@@ -0,0 +1,211 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "base64DecodedLenMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects misused destination buffers of encoding/base64 and encoding/hex"
+	info.Before = `
+dst := make([]byte, len(src))
+base64.StdEncoding.Decode(dst, src)
+return dst`
+	info.After = `
+dst := make([]byte, base64.StdEncoding.DecodedLen(len(src)))
+n, err := base64.StdEncoding.Decode(dst, src)
+if err != nil {
+	return nil, err
+}
+return dst[:n]`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&base64DecodedLenMisuseChecker{ctx: ctx}), nil
+	})
+}
+
+type base64DecodedLenMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// sizes maps the []byte variables to their last make size expression.
+	sizes map[types.Object]ast.Expr
+}
+
+// codecCall is an Encode or Decode call of the encoding/base64 or encoding/hex packages.
+type codecCall struct {
+	call *ast.CallExpr
+	// codec is a base64 encoding or a hex package expression.
+	codec  ast.Expr
+	method string
+	isHex  bool
+}
+
+func (c *base64DecodedLenMisuseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	c.sizes = make(map[types.Object]ast.Expr)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			c.collectSizes(n)
+		case *ast.ExprStmt:
+			if call, ok := c.codecCall(n.X); ok {
+				c.checkCall(decl, call, false)
+			}
+			return false
+		case *ast.CallExpr:
+			if call, ok := c.codecCall(n); ok {
+				c.checkCall(decl, call, true)
+			}
+		}
+		return true
+	})
+}
+
+func (c *base64DecodedLenMisuseChecker) collectSizes(assign *ast.AssignStmt) {
+	if len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, rhs := range assign.Rhs {
+		id, ok := assign.Lhs[i].(*ast.Ident)
+		if !ok {
+			continue
+		}
+		obj := c.ctx.TypesInfo.ObjectOf(id)
+		if obj == nil {
+			continue
+		}
+		call := astcast.ToCallExpr(rhs)
+		if isBuiltinCall(c.ctx.TypesInfo, call, "make") && len(call.Args) >= 2 {
+			c.sizes[obj] = call.Args[1]
+		} else {
+			delete(c.sizes, obj)
+		}
+	}
+}
+
+func (c *base64DecodedLenMisuseChecker) codecCall(x ast.Expr) (codecCall, bool) {
+	call := astcast.ToCallExpr(x)
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 2 {
+		return codecCall{}, false
+	}
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "(*encoding/base64.Encoding).Encode", "(*encoding/base64.Encoding).Decode":
+		return codecCall{call: call, codec: sel.X, method: sel.Sel.Name}, true
+	case "encoding/hex.Encode", "encoding/hex.Decode":
+		return codecCall{call: call, codec: sel.X, method: sel.Sel.Name, isHex: true}, true
+	default:
+		return codecCall{}, false
+	}
+}
+
+// checkCall checks the codec call; resultUsed is false if call is a statement.
+func (c *base64DecodedLenMisuseChecker) checkCall(decl *ast.FuncDecl, call codecCall, resultUsed bool) {
+	dst, src := call.call.Args[0], call.call.Args[1]
+
+	if !call.isHex && c.overlap(dst, src) {
+		c.ctx.Warn(call.call, "src and dst of %s overlap, the input is overwritten while it's processed", call.call.Fun)
+		return
+	}
+
+	dstObj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(dst))
+	if dstObj == nil {
+		return
+	}
+	size := c.sizes[dstObj]
+	lenFunc := call.method + "dLen"
+	if c.isLenOf(size, src) {
+		c.ctx.Warn(call.call, "%s is sized with len(%s), use %s.%s(len(%s)) instead",
+			dst, src, call.codec, lenFunc, src)
+		return
+	}
+
+	if call.method != "Decode" || (call.isHex && c.isDecodedLenOf(size, call, src)) {
+		return
+	}
+	if !resultUsed || c.isBlankResult(decl, call.call) {
+		if c.usedAfter(decl, dstObj, call.call) {
+			c.ctx.Warn(call.call, "decoded length returned by %s is ignored, %s may contain garbage after the decoded bytes; use %s[:n]",
+				call.call.Fun, dst, dst)
+		}
+	}
+}
+
+// isBlankResult reports whether the call's n result is assigned to the blank identifier.
+func (c *base64DecodedLenMisuseChecker) isBlankResult(decl *ast.FuncDecl, call *ast.CallExpr) bool {
+	blank := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return !blank
+		}
+		if len(assign.Rhs) == 1 && assign.Rhs[0] == call {
+			if id, ok := assign.Lhs[0].(*ast.Ident); ok && id.Name == "_" {
+				blank = true
+			}
+		}
+		return !blank
+	})
+	return blank
+}
+
+func (c *base64DecodedLenMisuseChecker) usedAfter(decl *ast.FuncDecl, obj types.Object, call *ast.CallExpr) bool {
+	used := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Pos() > call.End() && c.ctx.TypesInfo.Uses[id] == obj {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
+
+func (c *base64DecodedLenMisuseChecker) isLenOf(size, src ast.Expr) bool {
+	call := astcast.ToCallExpr(size)
+	return isBuiltinCall(c.ctx.TypesInfo, call, "len") &&
+		len(call.Args) == 1 &&
+		astequal.Expr(call.Args[0], src)
+}
+
+func (c *base64DecodedLenMisuseChecker) isDecodedLenOf(size ast.Expr, call codecCall, src ast.Expr) bool {
+	sizeCall := astcast.ToCallExpr(size)
+	sel, ok := sizeCall.Fun.(*ast.SelectorExpr)
+	return ok &&
+		sel.Sel.Name == "DecodedLen" &&
+		astequal.Expr(sel.X, call.codec) &&
+		len(sizeCall.Args) == 1 &&
+		c.isLenOf(sizeCall.Args[0], src)
+}
+
+// overlap reports whether dst and src are slices of the same variable.
+func (c *base64DecodedLenMisuseChecker) overlap(dst, src ast.Expr) bool {
+	dstObj := c.sliceRoot(dst)
+	return dstObj != nil && dstObj == c.sliceRoot(src)
+}
+
+func (c *base64DecodedLenMisuseChecker) sliceRoot(x ast.Expr) types.Object {
+	for {
+		switch e := x.(type) {
+		case *ast.ParenExpr:
+			x = e.X
+		case *ast.SliceExpr:
+			x = e.X
+		case *ast.Ident:
+			return c.ctx.TypesInfo.ObjectOf(e)
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "benchmarkResetTimer"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"requireReportAllocs": {
+			Value: false,
+			Usage: "whether to report benchmarks that don't call b.ReportAllocs",
+		},
+	}
+	info.Summary = "Detects benchmarks that measure their setup or run a wrong number of iterations"
+	info.Before = `
+func BenchmarkParse(b *testing.B) {
+	data, _ := os.ReadFile("testdata/input.json")
+	for i := 0; i < b.N; i++ {
+		parse(data)
+	}
+}`
+	info.After = `
+func BenchmarkParse(b *testing.B) {
+	data, _ := os.ReadFile("testdata/input.json")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parse(data)
+	}
+}`
+	info.Note = "Benchmarks that use b.Loop are skipped, b.Loop excludes the setup from the measurements"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&benchmarkResetTimerChecker{
+			ctx:                 ctx,
+			requireReportAllocs: info.Params.Bool("requireReportAllocs"),
+		}), nil
+	})
+}
+
+type benchmarkResetTimerChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	requireReportAllocs bool
+}
+
+// expensiveFuncs lists the functions which calls are too slow
+// to be included into the benchmark measurements.
+var expensiveFuncs = map[string]bool{
+	"os.Open":                       true,
+	"os.OpenFile":                   true,
+	"os.Create":                     true,
+	"os.ReadFile":                   true,
+	"os.ReadDir":                    true,
+	"io.ReadAll":                    true,
+	"io/ioutil.ReadFile":            true,
+	"io/ioutil.ReadAll":             true,
+	"io/ioutil.ReadDir":             true,
+	"net.Dial":                      true,
+	"net.DialTimeout":               true,
+	"net.Listen":                    true,
+	"net/http.Get":                  true,
+	"net/http.Post":                 true,
+	"database/sql.Open":             true,
+	"(*os/exec.Cmd).Run":            true,
+	"(*os/exec.Cmd).Output":         true,
+	"(*os/exec.Cmd).CombinedOutput": true,
+}
+
+// minExpensiveMake is the minimal constant size of a make call
+// that is considered to be an expensive setup.
+const minExpensiveMake = 1 << 16
+
+func (c *benchmarkResetTimerChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || !strings.HasPrefix(decl.Name.Name, "Benchmark") {
+		return
+	}
+	b := c.benchParam(decl.Type)
+	if b == nil {
+		return
+	}
+	c.checkBenchmark(decl.Name, b, decl.Body)
+
+	// Sub-benchmarks are checked the same way.
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			if b := c.benchParam(fn.Type); b != nil {
+				c.checkBenchmark(fn, b, fn.Body)
+			}
+		}
+		return true
+	})
+}
+
+// benchParam returns the *testing.B parameter object, or nil if there is none.
+func (c *benchmarkResetTimerChecker) benchParam(typ *ast.FuncType) types.Object {
+	if len(typ.Params.List) != 1 || len(typ.Params.List[0].Names) != 1 {
+		return nil
+	}
+	name := typ.Params.List[0].Names[0]
+	obj := c.ctx.TypesInfo.ObjectOf(name)
+	if obj == nil || obj.Type().String() != "*testing.B" {
+		return nil
+	}
+	return obj
+}
+
+func (c *benchmarkResetTimerChecker) checkBenchmark(cause ast.Node, b types.Object, body *ast.BlockStmt) {
+	if c.requireReportAllocs && !c.callsMethod(body, b, "ReportAllocs") {
+		if decl, ok := cause.(*ast.Ident); ok {
+			c.ctx.Warn(decl, "%s doesn't call %s.ReportAllocs()", decl, b.Name())
+		}
+	}
+
+	for _, stmt := range body.List {
+		if c.isLoopCall(stmt, b) {
+			// b.Loop excludes the setup from the measurements.
+			return
+		}
+	}
+	first := true
+	for i, stmt := range body.List {
+		if !c.isNLoop(stmt, b) {
+			continue
+		}
+		if first {
+			c.checkSetup(b, body.List[:i])
+			first = false
+		}
+		c.checkIterations(b, stmt)
+		c.checkHoistable(b, stmt)
+	}
+}
+
+// checkSetup reports the expensive setup calls that are
+// not followed by the b.ResetTimer call.
+func (c *benchmarkResetTimerChecker) checkSetup(b types.Object, setup []ast.Stmt) {
+	var expensive ast.Expr
+	stopped := false
+	for _, stmt := range setup {
+		switch {
+		case c.callsMethod(stmt, b, "ResetTimer"):
+			expensive = nil
+		case c.callsMethod(stmt, b, "StopTimer"):
+			stopped = true
+		case c.callsMethod(stmt, b, "StartTimer"):
+			stopped = false
+		case !stopped && expensive == nil:
+			expensive = c.findExpensive(stmt)
+		}
+	}
+	if expensive != nil {
+		c.ctx.Warn(expensive, "%s before the benchmark loop is included in the measured time; call %s.ResetTimer() before the loop",
+			expensive, b.Name())
+	}
+}
+
+// findExpensive returns the first expensive call or large make inside stmt.
+func (c *benchmarkResetTimerChecker) findExpensive(stmt ast.Stmt) ast.Expr {
+	var found ast.Expr
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if expensiveFuncs[calledFuncName(c.ctx.TypesInfo, n)] {
+				found = n.Fun
+				return false
+			}
+			if isBuiltinCall(c.ctx.TypesInfo, n, "make") && len(n.Args) >= 2 {
+				size := c.ctx.TypesInfo.Types[n.Args[len(n.Args)-1]].Value
+				if size != nil && constant.Compare(size, token.GEQ, constant.MakeInt64(minExpensiveMake)) {
+					found = n
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// checkIterations reports the b.N loops that run a different number of iterations.
+func (c *benchmarkResetTimerChecker) checkIterations(b types.Object, stmt ast.Stmt) {
+	loop, ok := stmt.(*ast.ForStmt)
+	if !ok {
+		v := c.ctx.GoVersion
+		if !v.IsAny() && !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 22}) {
+			c.ctx.Warn(stmt, "ranging over %s.N requires go1.22; use for i := 0; i < %s.N; i++", b.Name(), b.Name())
+		}
+		return
+	}
+	cond := loop.Cond.(*ast.BinaryExpr)
+	if cond.Op == token.LEQ {
+		c.ctx.Warn(cond, "benchmark loop runs %s.N+1 iterations; use %s < %s.N", b.Name(), cond.X, b.Name())
+		return
+	}
+	init, ok := loop.Init.(*ast.AssignStmt)
+	if !ok || len(init.Rhs) != 1 {
+		return
+	}
+	start := c.ctx.TypesInfo.Types[init.Rhs[0]].Value
+	if start != nil && constant.Sign(start) > 0 {
+		c.ctx.Warn(init, "benchmark loop starts from %s and runs fewer than %s.N iterations; start from 0",
+			init.Rhs[0], b.Name())
+	}
+}
+
+// checkHoistable reports the allocations inside the b.N loop
+// that don't depend on the iteration.
+func (c *benchmarkResetTimerChecker) checkHoistable(b types.Object, loop ast.Stmt) {
+	var body *ast.BlockStmt
+	switch loop := loop.(type) {
+	case *ast.ForStmt:
+		body = loop.Body
+	case *ast.RangeStmt:
+		body = loop.Body
+	}
+	stmts := 0
+	for _, stmt := range body.List {
+		if assign, ok := stmt.(*ast.AssignStmt); !ok || !isBlankAssign(assign) {
+			stmts++
+		}
+	}
+	if stmts < 2 {
+		// The allocation is likely the benchmarked operation.
+		return
+	}
+	for _, stmt := range body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			continue
+		}
+		if c.isAllocation(assign.Rhs[0]) && c.isInvariant(loop, assign.Rhs[0]) {
+			c.ctx.Warn(assign, "%s is allocated on every iteration but doesn't depend on it; allocate it before the loop and call %s.ResetTimer()",
+				assign.Lhs[0], b.Name())
+		}
+	}
+}
+
+func (c *benchmarkResetTimerChecker) isAllocation(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.CompositeLit:
+		switch c.ctx.TypeOf(x).Underlying().(type) {
+		case *types.Slice, *types.Map:
+			return len(x.Elts) != 0
+		}
+	case *ast.CallExpr:
+		return isBuiltinCall(c.ctx.TypesInfo, x, "make")
+	}
+	return false
+}
+
+// isInvariant reports whether x only references the variables declared outside
+// of loop and doesn't call functions other than builtins.
+func (c *benchmarkResetTimerChecker) isInvariant(loop ast.Node, x ast.Expr) bool {
+	invariant := true
+	ast.Inspect(x, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if c.ctx.TypesInfo.Types[n.Fun].IsType() {
+				// Conversions are pure.
+				break
+			}
+			if id, ok := n.Fun.(*ast.Ident); !ok || !c.isBuiltin(id) {
+				invariant = false
+			}
+		case *ast.Ident:
+			if v, ok := c.ctx.TypesInfo.Uses[n].(*types.Var); ok && v.Pos() >= loop.Pos() {
+				invariant = false
+			}
+		}
+		return invariant
+	})
+	return invariant
+}
+
+func (c *benchmarkResetTimerChecker) isBuiltin(id *ast.Ident) bool {
+	_, ok := c.ctx.TypesInfo.Uses[id].(*types.Builtin)
+	return ok
+}
+
+// isNLoop reports whether stmt is a loop over b.N.
+func (c *benchmarkResetTimerChecker) isNLoop(stmt ast.Stmt, b types.Object) bool {
+	switch loop := stmt.(type) {
+	case *ast.ForStmt:
+		cond, ok := loop.Cond.(*ast.BinaryExpr)
+		return ok && (cond.Op == token.LSS || cond.Op == token.LEQ) && c.isN(cond.Y, b)
+	case *ast.RangeStmt:
+		return c.isN(loop.X, b)
+	default:
+		return false
+	}
+}
+
+// isLoopCall reports whether stmt is the for b.Loop() loop.
+func (c *benchmarkResetTimerChecker) isLoopCall(stmt ast.Stmt, b types.Object) bool {
+	loop, ok := stmt.(*ast.ForStmt)
+	if !ok {
+		return false
+	}
+	call, ok := loop.Cond.(*ast.CallExpr)
+	return ok && c.isMethodCall(call, b, "Loop")
+}
+
+func (c *benchmarkResetTimerChecker) isN(x ast.Expr, b types.Object) bool {
+	sel, ok := x.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "N" {
+		return false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == b
+}
+
+// callsMethod reports whether there is the b.method() call inside n.
+func (c *benchmarkResetTimerChecker) callsMethod(n ast.Node, b types.Object, method string) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && c.isMethodCall(call, b, method) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *benchmarkResetTimerChecker) isMethodCall(call *ast.CallExpr, b types.Object, method string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method {
+		return false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == b
+}
+
+// isBlankAssign reports whether assign is the _ = x statement
+// that only marks x as used.
+func isBlankAssign(assign *ast.AssignStmt) bool {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 {
+		return false
+	}
+	id, ok := assign.Lhs[0].(*ast.Ident)
+	return ok && id.Name == "_"
+}
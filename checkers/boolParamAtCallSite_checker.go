@@ -0,0 +1,104 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "boolParamAtCallSite"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minBoolArgs": {
+			Value: 2,
+			Usage: "min number of unlabeled bool literal arguments to trigger a warning",
+		},
+	}
+	info.Summary = "Detects calls with several unlabeled bool literal arguments"
+	info.Before = `deploy(ctx, app, true, false)`
+	info.After = `deploy(ctx, app, true /* force */, false /* dryRun */)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&boolParamAtCallSiteChecker{
+			ctx:         ctx,
+			minBoolArgs: info.Params.Int("minBoolArgs"),
+		}), nil
+	})
+}
+
+type boolParamAtCallSiteChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	minBoolArgs int
+
+	file *ast.File
+}
+
+func (c *boolParamAtCallSiteChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *boolParamAtCallSiteChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) < c.minBoolArgs {
+		return
+	}
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil || isStdlibPkg(fn.Pkg()) {
+		return
+	}
+	sig := fn.Type().(*types.Signature)
+	params := sig.Params()
+
+	names := make([]string, 0, len(call.Args))
+	for i, arg := range call.Args {
+		if i >= params.Len() || (sig.Variadic() && i >= params.Len()-1) {
+			break
+		}
+		if !c.isBoolLiteral(arg) || c.isLabeled(arg) {
+			continue
+		}
+		name := params.At(i).Name()
+		if name == "" || name == "_" {
+			name = "#" + strconv.Itoa(i+1)
+		}
+		names = append(names, name)
+	}
+	if len(names) < c.minBoolArgs {
+		return
+	}
+	c.ctx.Warn(call, "%s is called with bool literals for %s; add inline comments with the parameter names or use named constants",
+		call.Fun, strings.Join(names, ", "))
+}
+
+func (c *boolParamAtCallSiteChecker) isBoolLiteral(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	return obj != nil && (obj == types.Universe.Lookup("true") || obj == types.Universe.Lookup("false"))
+}
+
+// isLabeled reports whether arg has a comment right before or after it.
+func (c *boolParamAtCallSiteChecker) isLabeled(arg ast.Expr) bool {
+	const maxGap = token.Pos(1)
+	for _, cg := range c.file.Comments {
+		if cg.End() <= arg.Pos() && arg.Pos()-cg.End() <= maxGap {
+			return true
+		}
+		if cg.Pos() >= arg.End() && cg.Pos()-arg.End() <= maxGap {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,225 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "buildTagPlacement"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects build constraints that are ignored, malformed or disagree with each other"
+	info.Before = `
+//go:build linux
+package sys`
+	info.After = `
+//go:build linux
+
+package sys`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &buildTagPlacementChecker{ctx: ctx}, nil
+	})
+}
+
+type buildTagPlacementChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// goPorts lists the supported GOOS/GOARCH pairs.
+var goPorts = []string{
+	"aix/ppc64",
+	"android/386", "android/amd64", "android/arm", "android/arm64",
+	"darwin/amd64", "darwin/arm64",
+	"dragonfly/amd64",
+	"freebsd/386", "freebsd/amd64", "freebsd/arm", "freebsd/arm64", "freebsd/riscv64",
+	"illumos/amd64",
+	"ios/amd64", "ios/arm64",
+	"js/wasm",
+	"linux/386", "linux/amd64", "linux/arm", "linux/arm64", "linux/loong64",
+	"linux/mips", "linux/mips64", "linux/mips64le", "linux/mipsle",
+	"linux/ppc64", "linux/ppc64le", "linux/riscv64", "linux/s390x",
+	"netbsd/386", "netbsd/amd64", "netbsd/arm", "netbsd/arm64",
+	"openbsd/386", "openbsd/amd64", "openbsd/arm", "openbsd/arm64",
+	"plan9/386", "plan9/amd64", "plan9/arm",
+	"solaris/amd64",
+	"wasip1/wasm",
+	"windows/386", "windows/amd64", "windows/arm", "windows/arm64",
+}
+
+// portTags is a set of all GOOS and GOARCH values, including the unix tag.
+var portTags = func() map[string]bool {
+	tags := map[string]bool{"unix": true}
+	for _, port := range goPorts {
+		slash := strings.IndexByte(port, '/')
+		tags[port[:slash]] = true
+		tags[port[slash+1:]] = true
+	}
+	return tags
+}()
+
+// unixOS is a set of GOOS values that satisfy the unix build tag.
+var unixOS = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true,
+	"freebsd": true, "hurd": true, "illumos": true, "ios": true,
+	"linux": true, "netbsd": true, "openbsd": true, "solaris": true,
+}
+
+// maxFreeBuildTags limits the number of the non-port tags
+// which combinations are evaluated.
+const maxFreeBuildTags = 8
+
+func (c *buildTagPlacementChecker) WalkFile(f *ast.File) {
+	pkgLine := c.ctx.FileSet.Position(f.Package).Line
+
+	var goBuild *ast.Comment
+	var goBuildExpr constraint.Expr
+	var plusBuild []*ast.Comment
+	var plusBuildExpr constraint.Expr
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			isGoBuild := constraint.IsGoBuild(comment.Text)
+			if !isGoBuild && !constraint.IsPlusBuild(comment.Text) {
+				continue
+			}
+			switch {
+			case comment.Pos() > f.Package:
+				c.ctx.Warn(comment, "%s after the package clause is ignored; move it to the top of the file", comment.Text)
+				continue
+			case c.ctx.FileSet.Position(group.End()).Line+1 >= pkgLine:
+				c.ctx.Warn(comment, "%s is ignored because it is directly above the package clause; separate it with a blank line", comment.Text)
+				continue
+			}
+
+			expr, err := constraint.Parse(comment.Text)
+			if err != nil {
+				c.ctx.Warn(comment, "malformed build constraint %s: %v", comment.Text, err)
+				continue
+			}
+			if isGoBuild {
+				goBuild, goBuildExpr = comment, expr
+				continue
+			}
+			plusBuild = append(plusBuild, comment)
+			if plusBuildExpr == nil {
+				plusBuildExpr = expr
+			} else {
+				// Several +build lines are ANDed together.
+				plusBuildExpr = &constraint.AndExpr{X: plusBuildExpr, Y: expr}
+			}
+		}
+	}
+
+	switch {
+	case goBuild != nil && plusBuild != nil:
+		if config := c.disagreement(goBuildExpr, plusBuildExpr); config != "" {
+			c.ctx.Warn(plusBuild[0], "// +build lines disagree with %s for %s; remove them or run gofmt to sync them",
+				goBuild.Text, config)
+		}
+	case plusBuild != nil:
+		c.ctx.Warn(plusBuild[0], "// +build lines have no //go:build counterpart; add //go:build %s", plusBuildExpr)
+	case goBuild != nil:
+		if c.unsatisfiable(goBuildExpr) {
+			c.ctx.Warn(goBuild, "%s can never be satisfied", goBuild.Text)
+		}
+	}
+}
+
+// disagreement returns the build configuration for which x and y evaluate
+// to different results, or an empty string if there is no such configuration.
+func (c *buildTagPlacementChecker) disagreement(x, y constraint.Expr) string {
+	config := ""
+	c.forEachConfig([]constraint.Expr{x, y}, func(tags map[string]bool, desc string) bool {
+		has := func(tag string) bool { return tags[tag] }
+		if x.Eval(has) != y.Eval(has) {
+			config = desc
+			return false
+		}
+		return true
+	})
+	return config
+}
+
+func (c *buildTagPlacementChecker) unsatisfiable(x constraint.Expr) bool {
+	satisfiable := false
+	enumerated := c.forEachConfig([]constraint.Expr{x}, func(tags map[string]bool, desc string) bool {
+		satisfiable = x.Eval(func(tag string) bool { return tags[tag] })
+		return !satisfiable
+	})
+	return enumerated && !satisfiable
+}
+
+// forEachConfig calls visit for every combination of the supported port
+// and the other tags used by exprs until visit returns false.
+//
+// If there are too many other tags, no configurations are visited
+// and false is returned.
+func (c *buildTagPlacementChecker) forEachConfig(exprs []constraint.Expr, visit func(tags map[string]bool, desc string) bool) bool {
+	free := make(map[string]bool)
+	for _, x := range exprs {
+		collectBuildTags(free, x)
+	}
+	if len(free) > maxFreeBuildTags {
+		return false
+	}
+	freeTags := make([]string, 0, len(free))
+	for tag := range free {
+		freeTags = append(freeTags, tag)
+	}
+	sort.Strings(freeTags)
+
+	for _, port := range goPorts {
+		slash := strings.IndexByte(port, '/')
+		goos, goarch := port[:slash], port[slash+1:]
+		for mask := 0; mask < 1<<uint(len(freeTags)); mask++ {
+			tags := map[string]bool{goos: true, goarch: true}
+			switch goos {
+			case "android":
+				tags["linux"] = true
+			case "ios":
+				tags["darwin"] = true
+			case "illumos":
+				tags["solaris"] = true
+			}
+			if unixOS[goos] {
+				tags["unix"] = true
+			}
+			desc := []string{port}
+			for i, tag := range freeTags {
+				if mask&(1<<uint(i)) != 0 {
+					tags[tag] = true
+					desc = append(desc, tag)
+				}
+			}
+			if !visit(tags, strings.Join(desc, " ")) {
+				return true
+			}
+		}
+	}
+	return true
+}
+
+// collectBuildTags adds the non-port tags used by x to the tags set.
+func collectBuildTags(tags map[string]bool, x constraint.Expr) {
+	switch x := x.(type) {
+	case *constraint.TagExpr:
+		if !portTags[x.Tag] {
+			tags[x.Tag] = true
+		}
+	case *constraint.NotExpr:
+		collectBuildTags(tags, x.X)
+	case *constraint.AndExpr:
+		collectBuildTags(tags, x.X)
+		collectBuildTags(tags, x.Y)
+	case *constraint.OrExpr:
+		collectBuildTags(tags, x.X)
+		collectBuildTags(tags, x.Y)
+	}
+}
@@ -0,0 +1,225 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcopy"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "bytesStringRoundTrip"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects redundant []byte and string conversion round trips"
+	info.Before = `
+w.Write([]byte(string(b)))
+s2 := string([]byte(s))`
+	info.After = `
+w.Write(b)
+s2 := s`
+	info.Note = "[]byte round trips are only fixed when the result is ranged over, written with a Write method or passed to a known read-only function like bytes.Equal, otherwise bytes.Clone is suggested to make the copy explicit"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&bytesStringRoundTripChecker{ctx: ctx}), nil
+	})
+}
+
+type bytesStringRoundTripChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// readOnly holds the expressions whose value is only read,
+	// so a byte slice copy can be replaced with the original slice.
+	readOnly map[ast.Expr]bool
+}
+
+func (c *bytesStringRoundTripChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.readOnly = make(map[ast.Expr]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.RangeStmt:
+			c.readOnly[astutil.Unparen(n.X)] = true
+			c.checkRange(n)
+		case *ast.CallExpr:
+			c.markReadOnlyArgs(n)
+			c.checkRoundTrip(n)
+		}
+		return true
+	})
+}
+
+// readOnlyByteFuncs lists the functions that neither modify
+// nor retain their []byte arguments.
+var readOnlyByteFuncs = map[string]bool{
+	"bytes.Compare":          true,
+	"bytes.Contains":         true,
+	"bytes.ContainsAny":      true,
+	"bytes.ContainsRune":     true,
+	"bytes.Count":            true,
+	"bytes.Equal":            true,
+	"bytes.EqualFold":        true,
+	"bytes.HasPrefix":        true,
+	"bytes.HasSuffix":        true,
+	"bytes.Index":            true,
+	"bytes.IndexAny":         true,
+	"bytes.IndexByte":        true,
+	"bytes.IndexRune":        true,
+	"bytes.LastIndex":        true,
+	"bytes.LastIndexByte":    true,
+	"encoding/json.Valid":    true,
+	"os.WriteFile":           true,
+	"unicode/utf8.FullRune":  true,
+	"unicode/utf8.RuneCount": true,
+	"unicode/utf8.Valid":     true,
+}
+
+// markReadOnlyArgs records the call arguments that are only read by the callee.
+//
+// Arbitrary functions may write into their []byte params (like io.Reader.Read does),
+// so only the known functions, io.Writer.Write-like methods and the
+// copy source and append spread arguments are considered.
+func (c *bytesStringRoundTripChecker) markReadOnlyArgs(call *ast.CallExpr) {
+	switch {
+	case isBuiltinCall(c.ctx.TypesInfo, call, "copy"):
+		// The destination is written to.
+		if len(call.Args) == 2 {
+			c.readOnly[astutil.Unparen(call.Args[1])] = true
+		}
+	case isBuiltinCall(c.ctx.TypesInfo, call, "append"):
+		// The first argument may be appended to in place.
+		if len(call.Args) == 2 && call.Ellipsis.IsValid() {
+			c.readOnly[astutil.Unparen(call.Args[1])] = true
+		}
+	default:
+		fn := calledFunc(c.ctx.TypesInfo, call)
+		if fn == nil || !(readOnlyByteFuncs[fn.FullName()] || isWriteMethod(fn)) {
+			return
+		}
+		for _, arg := range call.Args {
+			if isByteSlice(c.ctx.TypeOf(arg)) {
+				c.readOnly[astutil.Unparen(arg)] = true
+			}
+		}
+	}
+}
+
+// isWriteMethod reports whether fn has the io.Writer Write method signature.
+// Such methods must not modify the slice data and must not retain it.
+func isWriteMethod(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	if fn.Name() != "Write" || sig.Recv() == nil || sig.Params().Len() != 1 || sig.Results().Len() != 2 {
+		return false
+	}
+	n, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && n.Kind() == types.Int &&
+		isByteSlice(sig.Params().At(0).Type()) &&
+		isErrorType(sig.Results().At(1).Type())
+}
+
+func (c *bytesStringRoundTripChecker) checkRange(rng *ast.RangeStmt) {
+	if rng.Key == nil {
+		return
+	}
+	conv, arg := c.conversion(rng.X)
+	if conv == nil || !isByteSlice(c.ctx.TypeOf(conv)) {
+		return
+	}
+	typ := c.ctx.TypeOf(arg)
+	if !typep.HasStringKind(typ.Underlying()) || hasMethods(typ) {
+		return
+	}
+	if inner, _ := c.conversion(arg); inner != nil && isByteSlice(c.ctx.TypeOf(inner.Args[0])) {
+		// Reported as a round trip.
+		return
+	}
+	c.ctx.Warn(conv, "%s allocates a byte slice copy; iterate over len(%s) and index %s directly",
+		conv, arg, arg)
+}
+
+func (c *bytesStringRoundTripChecker) checkRoundTrip(outer *ast.CallExpr) {
+	_, x := c.conversion(outer)
+	if x == nil {
+		return
+	}
+	inner, arg := c.conversion(x)
+	if inner == nil {
+		return
+	}
+
+	outerType := c.ctx.TypeOf(outer)
+	innerType := c.ctx.TypeOf(inner)
+	argType := c.ctx.TypeOf(arg)
+	switch {
+	case isByteSlice(outerType) && typep.HasStringKind(innerType.Underlying()):
+	case typep.HasStringKind(outerType.Underlying()) && isByteSlice(innerType):
+	default:
+		return
+	}
+	if !types.Identical(outerType.Underlying(), argType.Underlying()) {
+		return
+	}
+	// Conversions via the types with methods may be intentional.
+	for _, typ := range []types.Type{outerType, innerType, argType} {
+		if hasMethods(typ) {
+			return
+		}
+	}
+
+	if isByteSlice(outerType) && !c.readOnly[outer] {
+		// The round trip makes a copy that may be modified later.
+		suggest := "append([]byte(nil), " + astfmt.Sprint(arg) + "...)"
+		if v := c.ctx.GoVersion; v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 20}) {
+			suggest = "bytes.Clone(" + astfmt.Sprint(arg) + ")"
+		}
+		c.ctx.Warn(outer, "%s copies %s via a string conversion; use %s instead", outer, arg, suggest)
+		return
+	}
+	if !types.Identical(outerType, argType) {
+		// Can't replace the expression with arg as it has
+		// a different type, simplify it to a single conversion.
+		suggest := astcopy.CallExpr(outer)
+		suggest.Args[0] = arg
+		c.ctx.Warn(outer, "%s is a redundant conversion round trip; use %s instead", outer, suggest)
+		return
+	}
+	c.ctx.WarnFixable(outer, linter.QuickFix{
+		From:        outer.Pos(),
+		To:          outer.End(),
+		Replacement: []byte(astfmt.Sprint(arg)),
+	}, "%s is a redundant conversion round trip; use %s instead", outer, arg)
+}
+
+// conversion returns x as a conversion expression along with its argument.
+// If x is not a conversion, nil values are returned.
+func (c *bytesStringRoundTripChecker) conversion(x ast.Expr) (*ast.CallExpr, ast.Expr) {
+	call, ok := astutil.Unparen(x).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, nil
+	}
+	if tv, ok := c.ctx.TypesInfo.Types[call.Fun]; !ok || !tv.IsType() {
+		return nil, nil
+	}
+	return call, astutil.Unparen(call.Args[0])
+}
+
+func isByteSlice(typ types.Type) bool {
+	slice, ok := typ.Underlying().(*types.Slice)
+	if !ok {
+		return false
+	}
+	elem, ok := slice.Elem().(*types.Basic)
+	return ok && elem.Kind() == types.Byte
+}
+
+func hasMethods(typ types.Type) bool {
+	return types.NewMethodSet(types.NewPointer(typ)).Len() != 0
+}
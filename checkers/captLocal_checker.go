@@ -2,9 +2,17 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcopy"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -14,16 +22,27 @@ func init() {
 	info.Params = linter.CheckerParams{
 		"paramsOnly": {
 			Value: true,
-			Usage: "whether to restrict checker to params only",
+			Usage: "whether to restrict checker to the function signature params only",
+		},
+		"allowedIdents": {
+			Value: "ID,URL,DB,IP,API",
+			Usage: "comma-separated list of capitalized names that are permitted",
 		},
 	}
 	info.Summary = "Detects capitalized names for local variables"
 	info.Before = `func f(IN int, OUT *int) (ERR error) {}`
 	info.After = `func f(in int, out *int) (err error) {}`
+	info.Note = `
+The quick fix renames the single capitalized name of the function
+if the new name doesn't collide with other names in scope.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
 		c := &captLocalChecker{ctx: ctx}
 		c.paramsOnly = info.Params.Bool("paramsOnly")
+		c.allowed = make(map[string]bool)
+		for _, name := range strings.Split(info.Params.String("allowedIdents"), ",") {
+			c.allowed[strings.TrimSpace(name)] = true
+		}
 		return astwalk.WalkerForLocalDef(c, ctx.TypesInfo), nil
 	})
 }
@@ -32,18 +51,175 @@ type captLocalChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
+	file *ast.File
+	fn   *ast.FuncDecl
+
+	// renameFunc is set if the fn has only one reported name,
+	// so the whole function can be rewritten by the fix.
+	renameFunc bool
+
 	paramsOnly bool
+	allowed    map[string]bool
+}
+
+func (c *captLocalChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *captLocalChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	if fn.Body == nil {
+		return false
+	}
+	c.fn = fn
+	c.renameFunc = c.countReported(fn) == 1 && !hasCommentsIn(c.file, fn)
+	return true
 }
 
 func (c *captLocalChecker) VisitLocalDef(def astwalk.Name, _ ast.Expr) {
-	if c.paramsOnly && def.Kind != astwalk.NameParam {
+	if c.paramsOnly && !c.isSignatureParam(def) {
 		return
 	}
-	if ast.IsExported(def.ID.Name) {
+	if c.isReported(def.ID.Name) {
 		c.warn(def.ID)
 	}
 }
 
-func (c *captLocalChecker) warn(id ast.Node) {
+// isSignatureParam reports whether def is declared by the function signature,
+// the function literals params are not.
+func (c *captLocalChecker) isSignatureParam(def astwalk.Name) bool {
+	return def.Kind == astwalk.NameParam && def.ID.Pos() < c.fn.Body.Pos()
+}
+
+func (c *captLocalChecker) isReported(name string) bool {
+	return ast.IsExported(name) && !c.allowed[name]
+}
+
+// countReported returns the number of names in fn that are reported.
+// It matches the set of names visited by the local defs walker.
+func (c *captLocalChecker) countReported(fn *ast.FuncDecl) int {
+	params := make(map[*ast.Ident]bool)
+	markParams := func(list *ast.FieldList) {
+		if list == nil {
+			return
+		}
+		for _, field := range list.List {
+			for _, id := range field.Names {
+				params[id] = true
+			}
+		}
+	}
+	markParams(fn.Recv)
+	markParams(fn.Type.Params)
+	markParams(fn.Type.Results)
+	count := 0
+	ast.Inspect(fn, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		switch obj := c.ctx.TypesInfo.Defs[id].(type) {
+		case *types.Var:
+			if obj.IsField() {
+				return true
+			}
+		case *types.Const:
+		default:
+			return true
+		}
+		if (!c.paramsOnly || params[id]) && c.isReported(id.Name) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// lowerName returns the name with the first rune lowered,
+// the names written in upper case are lowered completely.
+func (c *captLocalChecker) lowerName(name string) string {
+	if strings.ToUpper(name) == name {
+		return strings.ToLower(name)
+	}
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// collides reports whether the name is already declared in the obj scope,
+// its parents or its children.
+func (c *captLocalChecker) collides(obj types.Object, name string) bool {
+	if token.IsKeyword(name) {
+		return true
+	}
+	scope := obj.Parent()
+	if scope == nil {
+		return true
+	}
+	if _, found := scope.LookupParent(name, token.NoPos); found != nil {
+		return true
+	}
+	var declaredInside func(s *types.Scope) bool
+	declaredInside = func(s *types.Scope) bool {
+		for i := 0; i < s.NumChildren(); i++ {
+			child := s.Child(i)
+			if child.Lookup(name) != nil || declaredInside(child) {
+				return true
+			}
+		}
+		return false
+	}
+	return declaredInside(scope)
+}
+
+// suggestRename returns the fix that gives id the lowered name.
+func (c *captLocalChecker) suggestRename(id *ast.Ident) (linter.QuickFix, bool) {
+	obj := c.ctx.TypesInfo.Defs[id]
+	if obj == nil {
+		return linter.QuickFix{}, false
+	}
+	name := c.lowerName(id.Name)
+	if c.collides(obj, name) {
+		return linter.QuickFix{}, false
+	}
+
+	uses := make(map[token.Pos]bool)
+	ast.Inspect(c.fn, func(n ast.Node) bool {
+		if use, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.Uses[use] == obj {
+			uses[use.Pos()] = true
+		}
+		return true
+	})
+	if len(uses) == 0 {
+		return linter.QuickFix{
+			From:        id.Pos(),
+			To:          id.End(),
+			Replacement: []byte(name),
+		}, true
+	}
+	if !c.renameFunc {
+		// The uses can't be updated without overlapping
+		// with the other names fixes.
+		return linter.QuickFix{}, false
+	}
+	fixed := astcopy.FuncDecl(c.fn)
+	fixed.Doc = nil
+	astutil.Apply(fixed, func(cur *astutil.Cursor) bool {
+		if x, ok := cur.Node().(*ast.Ident); ok && (uses[x.Pos()] || x.Pos() == id.Pos()) {
+			x.Name = name
+		}
+		return true
+	}, nil)
+	return linter.QuickFix{
+		From:        c.fn.Pos(),
+		To:          c.fn.End(),
+		Replacement: []byte(astfmt.Sprint(fixed)),
+	}, true
+}
+
+func (c *captLocalChecker) warn(id *ast.Ident) {
+	if fix, ok := c.suggestRename(id); ok {
+		c.ctx.WarnFixable(id, fix, "`%s' should not be capitalized", id)
+		return
+	}
 	c.ctx.Warn(id, "`%s' should not be capitalized", id)
 }
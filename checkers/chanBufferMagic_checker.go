@@ -0,0 +1,197 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "chanBufferMagic"
+	info.Tags = []string{"diagnostic", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkUnboundedSize": {
+			Value: true,
+			Usage: "whether to report buffer sizes that come from params or parsed input without a bounds check",
+		},
+		"checkDoneSignal": {
+			Value: true,
+			Usage: "whether to report buffered channels that are only closed to signal completion",
+		},
+	}
+	info.Summary = "Detects suspicious channel buffer sizes"
+	info.Before = `
+func startWorkers(n int) {
+	jobs := make(chan job, n)
+	// ...
+}`
+	info.After = `
+func startWorkers(n int) {
+	if n > maxWorkers {
+		n = maxWorkers
+	}
+	jobs := make(chan job, n)
+	// ...
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&chanBufferMagicChecker{
+			ctx:                ctx,
+			checkUnboundedSize: info.Params.Bool("checkUnboundedSize"),
+			checkDoneSignal:    info.Params.Bool("checkDoneSignal"),
+		}), nil
+	})
+}
+
+type chanBufferMagicChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkUnboundedSize bool
+	checkDoneSignal    bool
+}
+
+func (c *chanBufferMagicChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	// inputs is a set of variables that hold unchecked input values:
+	// params and results of the strconv parsing functions.
+	inputs := make(map[types.Object]bool)
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			if obj := c.ctx.TypesInfo.ObjectOf(name); obj != nil {
+				inputs[obj] = true
+			}
+		}
+	}
+	// bounded maps the input variables to the first bounds check position.
+	bounded := make(map[types.Object]token.Pos)
+	// doneChans maps the local buffered channels to their make calls.
+	doneChans := make(map[types.Object]*ast.CallExpr)
+	var doneOrder []types.Object
+	closed := make(map[types.Object]bool)
+	sent := make(map[types.Object]bool)
+	var makes []*ast.CallExpr
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if obj := c.collectAssign(n, inputs); obj != nil {
+				doneChans[obj] = astcast.ToCallExpr(n.Rhs[0])
+				doneOrder = append(doneOrder, obj)
+			}
+		case *ast.BinaryExpr:
+			switch n.Op {
+			case token.LSS, token.LEQ, token.GTR, token.GEQ:
+				for _, x := range []ast.Expr{n.X, n.Y} {
+					obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(astutil.Unparen(x)))
+					if _, ok := bounded[obj]; obj != nil && !ok {
+						bounded[obj] = n.Pos()
+					}
+				}
+			}
+		case *ast.SendStmt:
+			if obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(n.Chan)); obj != nil {
+				sent[obj] = true
+			}
+		case *ast.CallExpr:
+			if isBuiltinCall(c.ctx.TypesInfo, n, "close") && len(n.Args) == 1 {
+				if obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(n.Args[0])); obj != nil {
+					closed[obj] = true
+				}
+			}
+			if c.isChanMake(n) {
+				makes = append(makes, n)
+			}
+		}
+		return true
+	})
+
+	if c.checkUnboundedSize {
+		for _, call := range makes {
+			c.checkSize(call, inputs, bounded)
+		}
+	}
+	if c.checkDoneSignal {
+		for _, obj := range doneOrder {
+			if closed[obj] && !sent[obj] {
+				c.ctx.Warn(doneChans[obj], "%s is only closed to signal completion, its buffer is unnecessary",
+					obj.Name())
+			}
+		}
+	}
+}
+
+// collectAssign records the parsed input variables.
+// If assign defines a channel with buffer of size 1, the channel is returned.
+func (c *chanBufferMagicChecker) collectAssign(assign *ast.AssignStmt, inputs map[types.Object]bool) types.Object {
+	if len(assign.Rhs) != 1 || assign.Tok != token.DEFINE && assign.Tok != token.ASSIGN {
+		return nil
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "strconv.Atoi", "strconv.ParseInt", "strconv.ParseUint":
+		if obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(assign.Lhs[0])); obj != nil {
+			inputs[obj] = true
+		}
+		return nil
+	}
+	if len(assign.Lhs) != 1 || assign.Tok != token.DEFINE || !c.isChanMake(call) || len(call.Args) != 2 {
+		return nil
+	}
+	size := c.ctx.TypesInfo.Types[call.Args[1]].Value
+	if size == nil || constant.Compare(size, token.NEQ, constant.MakeInt64(1)) {
+		return nil
+	}
+	return c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(assign.Lhs[0]))
+}
+
+func (c *chanBufferMagicChecker) checkSize(call *ast.CallExpr, inputs map[types.Object]bool, bounded map[types.Object]token.Pos) {
+	if len(call.Args) != 2 || c.ctx.TypesInfo.Types[call.Args[1]].Value != nil {
+		return
+	}
+	size := call.Args[1]
+	found := false
+	ast.Inspect(size, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			// Sizes derived from len and cap of existing collections are fine.
+			if isBuiltinCall(c.ctx.TypesInfo, n, "len") || isBuiltinCall(c.ctx.TypesInfo, n, "cap") {
+				return false
+			}
+		case *ast.Ident:
+			obj := c.ctx.TypesInfo.ObjectOf(n)
+			if obj == nil || !inputs[obj] {
+				break
+			}
+			typ, ok := obj.Type().Underlying().(*types.Basic)
+			if !ok || typ.Info()&types.IsInteger == 0 {
+				break
+			}
+			if pos, ok := bounded[obj]; !ok || pos > call.Pos() {
+				found = true
+			}
+		}
+		return true
+	})
+	if found {
+		c.ctx.Warn(size, "channel buffer size %s comes from unchecked input; bound it to avoid huge allocations", size)
+	}
+}
+
+func (c *chanBufferMagicChecker) isChanMake(call *ast.CallExpr) bool {
+	if !isBuiltinCall(c.ctx.TypesInfo, call, "make") {
+		return false
+	}
+	_, ok := c.ctx.TypeOf(call).Underlying().(*types.Chan)
+	return ok
+}
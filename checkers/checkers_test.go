@@ -9,7 +9,26 @@ import (
 
 func TestCheckers(t *testing.T) {
 	allParams := map[string]map[string]interface{}{
-		"captLocal": {"paramsOnly": false},
+		"captLocal":               {"paramsOnly": false},
+		"regexpCompileInLoop":     {"checkHandlers": true},
+		"unbufferedSignalChan":    {"aggressive": true},
+		"execShellInjection":      {"extraShells": "/usr/bin/env"},
+		"parallelTestEnvMutation": {"interprocedural": true},
+		"funcComplexity":          {"maxStatements": 8, "maxCyclomatic": 4, "maxNesting": 2},
+		"ignoredErrorResult":      {"flagBlankAssign": true},
+		"jsonTagStyle":            {"requireTags": true},
+		"stringsBuilderMisuse":    {"aggressive": true},
+		"deferEvaluatesArgsNow":   {"checkLoopVars": true},
+		"twoValueRangeUnusedKey":  {"suggestRangeValue": true},
+		"timeEqualMethod":         {"includeStructs": true},
+		"printfStyleFuncVerify":   {"funcs": "checker_test.logf:0, checker_test.wrapErrorf:1, (*checker_test.logger).Printf:0"},
+		"benchmarkResetTimer":     {"requireReportAllocs": true},
+		"fmtVerbTypeMismatch":     {"checkErrorV": true},
+		"closeNilOrDoubleClose":   {"flagParamClose": true},
+		"hugeParam":               {"skipMutated": true},
+		"rangeValCopy":            {"sizeThresholdOverrides": "example.com/huge=4096"},
+		"paramTypeCombine":        {"checkTypeParams": true},
+		"duplicateStringLiteral":  {"skipTestdata": false},
 	}
 
 	for _, info := range linter.GetCheckersInfo() {
@@ -25,6 +44,7 @@ func TestCheckers(t *testing.T) {
 	cfg := linttest.CheckersTest{
 		IgnoreErrors: []string{
 			"caseOrder",
+			"embedDirectiveMisplacement",
 		},
 	}
 
@@ -49,7 +69,7 @@ func TestTags(t *testing.T) {
 		categories := 0
 		for _, tag := range info.Tags {
 			switch tag {
-			case "diagnostic", "style", "performance":
+			case "diagnostic", "style", "performance", "security":
 				// Category tags.
 				// Can only have one of them.
 				categories++
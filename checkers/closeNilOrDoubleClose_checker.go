@@ -0,0 +1,220 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "closeNilOrDoubleClose"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"flagParamClose": {
+			Value: false,
+			Usage: "whether to report closing of the bidirectional channel parameters",
+		},
+	}
+	info.Summary = "Detects channels that can be closed more than once or by a non-owner"
+	info.Before = `
+for _, job := range jobs {
+	results <- run(job)
+	close(results)
+}`
+	info.After = `
+for _, job := range jobs {
+	results <- run(job)
+}
+close(results)`
+	info.Note = `
+Closing a receive-only channel is rejected by the compiler, so it's not checked.
+Send-only channel parameters are not reported, the function is their sender.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&closeNilOrDoubleCloseChecker{
+			ctx:            ctx,
+			flagParamClose: info.Params.Bool("flagParamClose"),
+		}), nil
+	})
+}
+
+type closeNilOrDoubleCloseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	flagParamClose bool
+}
+
+func (c *closeNilOrDoubleCloseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	var stack []ast.Node
+	ast.Inspect(decl, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		if call, ok := n.(*ast.CallExpr); ok && len(call.Args) == 1 && isBuiltinCall(c.ctx.TypesInfo, call, "close") {
+			c.checkClose(call, stack)
+		}
+		return true
+	})
+}
+
+func (c *closeNilOrDoubleCloseChecker) checkClose(call *ast.CallExpr, stack []ast.Node) {
+	id, ok := astutil.Unparen(call.Args[0]).(*ast.Ident)
+	if !ok {
+		return
+	}
+	v, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var)
+	if !ok {
+		return
+	}
+	c.checkLoopClose(call, id, v, stack)
+	if c.flagParamClose {
+		c.checkParamClose(call, v, stack)
+	}
+}
+
+// checkLoopClose reports the close of the channel declared outside
+// of the loop that is not left after the close.
+func (c *closeNilOrDoubleCloseChecker) checkLoopClose(call *ast.CallExpr, id *ast.Ident, v *types.Var, stack []ast.Node) {
+	// Deferred closes are executed on return, once per iteration.
+	_, deferred := stack[len(stack)-2].(*ast.DeferStmt)
+
+	for i := len(stack) - 2; i >= 0; i-- {
+		var list []ast.Stmt
+		switch n := stack[i].(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			return
+		case *ast.BlockStmt:
+			list = n.List
+		case *ast.CaseClause:
+			list = n.Body
+		case *ast.CommClause:
+			list = n.Body
+		case *ast.ForStmt:
+			c.checkLoop(call, id, v, n, n.Body, deferred)
+			return
+		case *ast.RangeStmt:
+			c.checkLoop(call, id, v, n, n.Body, deferred)
+			return
+		}
+		if !deferred && c.leavesAfter(list, stack[i+1]) {
+			return
+		}
+	}
+}
+
+func (c *closeNilOrDoubleCloseChecker) checkLoop(call *ast.CallExpr, id *ast.Ident, v *types.Var, loop ast.Node, body *ast.BlockStmt, deferred bool) {
+	if v.Pos() >= loop.Pos() && v.Pos() < loop.End() {
+		return
+	}
+	if rng, ok := loop.(*ast.RangeStmt); ok && (c.isVar(rng.Key, v) || c.isVar(rng.Value, v)) {
+		return
+	}
+	if c.isAssigned(body, v) {
+		return
+	}
+	if deferred {
+		c.ctx.Warn(call, "close(%s) is deferred on every loop iteration, so %s is closed more than once on return; closing it twice panics",
+			id, id)
+		return
+	}
+	c.ctx.Warn(call, "close(%s) is called on every loop iteration without reassigning %s; closing it twice panics",
+		id, id)
+}
+
+// checkParamClose reports the close of the bidirectional channel parameter
+// that is never reassigned in the function.
+func (c *closeNilOrDoubleCloseChecker) checkParamClose(call *ast.CallExpr, v *types.Var, stack []ast.Node) {
+	ch, ok := v.Type().Underlying().(*types.Chan)
+	if !ok || ch.Dir() != types.SendRecv {
+		return
+	}
+	for i := len(stack) - 2; i >= 0; i-- {
+		var typ *ast.FuncType
+		var body *ast.BlockStmt
+		switch n := stack[i].(type) {
+		case *ast.FuncLit:
+			typ, body = n.Type, n.Body
+		case *ast.FuncDecl:
+			typ, body = n.Type, n.Body
+		default:
+			continue
+		}
+		if v.Pos() < typ.Params.Pos() || v.Pos() >= typ.Params.End() {
+			continue
+		}
+		if !c.isAssigned(body, v) {
+			c.ctx.Warn(call, "%s parameter is closed by the function that didn't create it; leave closing to the channel owner",
+				v.Name())
+		}
+		return
+	}
+}
+
+// leavesAfter reports whether any statement following the stmt in list
+// leaves the loop or the function.
+func (c *closeNilOrDoubleCloseChecker) leavesAfter(list []ast.Stmt, stmt ast.Node) bool {
+	after := false
+	for _, x := range list {
+		if x == stmt {
+			after = true
+			continue
+		}
+		if !after {
+			continue
+		}
+		switch x := x.(type) {
+		case *ast.ReturnStmt:
+			return true
+		case *ast.BranchStmt:
+			if x.Tok == token.BREAK || x.Tok == token.GOTO {
+				return true
+			}
+		case *ast.ExprStmt:
+			if call, ok := x.X.(*ast.CallExpr); ok && isBuiltinCall(c.ctx.TypesInfo, call, "panic") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *closeNilOrDoubleCloseChecker) isVar(x ast.Expr, v *types.Var) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(id) == v
+}
+
+// isAssigned reports whether v is assigned anywhere in body.
+func (c *closeNilOrDoubleCloseChecker) isAssigned(body *ast.BlockStmt, v *types.Var) bool {
+	assigned := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if c.isVar(lhs, v) {
+					assigned = true
+				}
+			}
+		case *ast.RangeStmt:
+			if c.isVar(n.Key, v) || c.isVar(n.Value, v) {
+				assigned = true
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND && c.isVar(n.X, v) {
+				assigned = true
+			}
+		}
+		return !assigned
+	})
+	return assigned
+}
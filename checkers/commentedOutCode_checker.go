@@ -3,6 +3,7 @@ package checkers
 import (
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"regexp"
 	"strings"
@@ -16,16 +17,37 @@ func init() {
 	var info linter.CheckerInfo
 	info.Name = "commentedOutCode"
 	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minLines": {
+			Value: 1,
+			Usage: "min number of commented-out code lines that makes the warning trigger",
+		},
+		"allowedPattern": {
+			Value: `^\s*(Example:|e\.g\.)`,
+			Usage: "regexp that matches the comment lines that are not reported, like doc examples",
+		},
+	}
 	info.Summary = "Detects commented-out code inside function bodies"
 	info.Before = `
 // fmt.Println("Debugging hard")
 foo(1, 2)`
 	info.After = `foo(1, 2)`
+	info.Note = `
+Directive comments and commented-out struct tags are never reported.
+Commented-out imports are reported by the commentedOutImport checker.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		allowed, err := regexp.Compile(info.Params.String("allowedPattern"))
+		if err != nil {
+			return nil, fmt.Errorf("allowedPattern: %v", err)
+		}
 		return astwalk.WalkerForLocalComment(&commentedOutCodeChecker{
 			ctx:              ctx,
+			minLines:         info.Params.Int("minLines"),
+			allowed:          allowed,
 			notQuiteFuncCall: regexp.MustCompile(`\w+\s+\([^)]*\)\s*$`),
+			directive:        regexp.MustCompile(`^//(line |extern |export |nolint|[a-z0-9]+:[a-z0-9])`),
+			structTag:        regexp.MustCompile("^`?(\\w+:\"[^\"]*\"\\s*)+`?$"),
 		}), nil
 	})
 }
@@ -35,7 +57,12 @@ type commentedOutCodeChecker struct {
 	ctx *linter.CheckerContext
 	fn  *ast.FuncDecl
 
+	minLines int
+	allowed  *regexp.Regexp
+
 	notQuiteFuncCall *regexp.Regexp
+	directive        *regexp.Regexp
+	structTag        *regexp.Regexp
 }
 
 func (c *commentedOutCodeChecker) EnterFunc(fn *ast.FuncDecl) bool {
@@ -44,8 +71,17 @@ func (c *commentedOutCodeChecker) EnterFunc(fn *ast.FuncDecl) bool {
 }
 
 func (c *commentedOutCodeChecker) VisitLocalComment(cg *ast.CommentGroup) {
+	cg = c.withoutDirectives(cg)
+	if cg == nil {
+		return
+	}
 	s := cg.Text() // Collect text once
 
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) < c.minLines || c.isAllowed(lines) {
+		return
+	}
+
 	// We do multiple heuristics to avoid false positives.
 	// Many things can be improved here.
 
@@ -110,9 +146,63 @@ func (c *commentedOutCodeChecker) VisitLocalComment(cg *ast.CommentGroup) {
 
 	if stmt, ok := stmt.(*ast.BlockStmt); ok && len(stmt.List) != 0 {
 		c.warn(cg)
+		return
+	}
+
+	// Block comments may contain the whole declarations.
+	if strings.HasPrefix(cg.List[0].Text, "/*") && c.isDeclList(s) {
+		c.warn(cg)
 	}
 }
 
+// withoutDirectives returns cg without the directive comments
+// or nil if there are only directives.
+func (c *commentedOutCodeChecker) withoutDirectives(cg *ast.CommentGroup) *ast.CommentGroup {
+	list := make([]*ast.Comment, 0, len(cg.List))
+	for _, comment := range cg.List {
+		if !c.directive.MatchString(comment.Text) {
+			list = append(list, comment)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return &ast.CommentGroup{List: list}
+}
+
+// isAllowed reports whether the comment lines are exempted
+// by the allowed pattern or look like the struct tags.
+func (c *commentedOutCodeChecker) isAllowed(lines []string) bool {
+	tags := true
+	for _, l := range lines {
+		if c.allowed.MatchString(l) {
+			return true
+		}
+		tags = tags && c.structTag.MatchString(strings.TrimSpace(l))
+	}
+	return tags
+}
+
+// isDeclList reports whether s is a list of declarations
+// that only make sense as the code.
+func (c *commentedOutCodeChecker) isDeclList(s string) bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+s, 0)
+	if err != nil {
+		return false
+	}
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			return true
+		case *ast.GenDecl:
+			if decl.Tok == token.VAR || decl.Tok == token.CONST {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *commentedOutCodeChecker) skipBlock(s string) bool {
 	lines := strings.Split(s, "\n") // There is at least 1 line, that's invariant
 
@@ -129,6 +219,11 @@ func (c *commentedOutCodeChecker) isPermittedStmt(stmt ast.Stmt) bool {
 	case *ast.ExprStmt:
 		return c.isPermittedExpr(stmt.X)
 	case *ast.LabeledStmt:
+		if _, ok := stmt.Stmt.(*ast.ExprStmt); ok {
+			// Labeled expressions are rare in the code,
+			// it's likely a text like "usage: foo(bar)".
+			return true
+		}
 		return c.isPermittedStmt(stmt.Stmt)
 	case *ast.DeclStmt:
 		decl := stmt.Decl.(*ast.GenDecl)
@@ -0,0 +1,179 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "contextCancelLeak"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects context cancel functions that are never called"
+	info.Before = `
+ctx, cancel := context.WithTimeout(ctx, time.Second)
+_ = cancel
+return fetch(ctx)`
+	info.After = `
+ctx, cancel := context.WithTimeout(ctx, time.Second)
+defer cancel()
+return fetch(ctx)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&contextCancelLeakChecker{ctx: ctx}), nil
+	})
+}
+
+type contextCancelLeakChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// cancelAssign is an assignment of the context constructor results.
+type cancelAssign struct {
+	stmt   *ast.AssignStmt
+	call   *ast.CallExpr
+	cancel types.Object
+	// block is the statements list that contains stmt.
+	block *ast.BlockStmt
+}
+
+// cancelUse is a cancel function use that calls it or lets it escape.
+type cancelUse struct {
+	id        *ast.Ident
+	inClosure bool
+}
+
+func (c *contextCancelLeakChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	var assigns []cancelAssign
+	uses := make(map[types.Object][]cancelUse)
+	// ignored is a set of idents that don't count as uses, like
+	// the assignment targets and `_ = cancel` values.
+	ignored := make(map[*ast.Ident]bool)
+	var blocks []*ast.BlockStmt
+	closureDepth := 0
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			switch stack[len(stack)-1].(type) {
+			case *ast.FuncLit:
+				closureDepth--
+			case *ast.BlockStmt:
+				blocks = blocks[:len(blocks)-1]
+			}
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			closureDepth++
+		case *ast.BlockStmt:
+			blocks = append(blocks, n)
+		case *ast.AssignStmt:
+			c.collectIgnored(n, ignored)
+			if assign, ok := c.cancelAssign(n); ok {
+				assign.block = blocks[len(blocks)-1]
+				assigns = append(assigns, assign)
+			}
+		case *ast.Ident:
+			if obj := c.ctx.TypesInfo.Uses[n]; obj != nil && !ignored[n] {
+				uses[obj] = append(uses[obj], cancelUse{id: n, inClosure: closureDepth != 0})
+			}
+		}
+		return true
+	})
+
+	for i, assign := range assigns {
+		if assign.cancel == nil {
+			c.ctx.Warn(assign.stmt.Lhs[1], "cancel function from %s is discarded, the context resources are never released",
+				assign.call.Fun)
+			continue
+		}
+		if c.isOuterVar(decl, assign.cancel) {
+			continue
+		}
+		// The cancel function should be used before it's overwritten
+		// by the next assignment in the same block.
+		end := decl.Body.End()
+		for _, next := range assigns[i+1:] {
+			if next.cancel == assign.cancel && next.block == assign.block {
+				end = next.stmt.Pos()
+				break
+			}
+		}
+		used := false
+		for _, use := range uses[assign.cancel] {
+			if use.inClosure || (use.id.Pos() > assign.stmt.End() && use.id.Pos() < end) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			name := assign.cancel.Name()
+			c.ctx.Warn(assign.stmt, "cancel function %s is never called, the context leaks; defer %s() after creating it",
+				name, name)
+		}
+	}
+}
+
+// cancelAssign returns the assignment info for `ctx, cancel := context.WithCancel(ctx)`.
+// Cancel object is nil if it's assigned to the blank identifier.
+func (c *contextCancelLeakChecker) cancelAssign(assign *ast.AssignStmt) (cancelAssign, bool) {
+	if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return cancelAssign{}, false
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "context.WithCancel", "context.WithTimeout", "context.WithDeadline":
+	default:
+		return cancelAssign{}, false
+	}
+	id, ok := assign.Lhs[1].(*ast.Ident)
+	if !ok {
+		// Stored into a field or an element.
+		return cancelAssign{}, false
+	}
+	result := cancelAssign{stmt: assign, call: call}
+	if id.Name != "_" {
+		result.cancel = c.ctx.TypesInfo.ObjectOf(id)
+		if result.cancel == nil {
+			return cancelAssign{}, false
+		}
+	}
+	return result, true
+}
+
+func (c *contextCancelLeakChecker) collectIgnored(assign *ast.AssignStmt, ignored map[*ast.Ident]bool) {
+	if assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		ignored[id] = true
+		if id.Name == "_" && len(assign.Lhs) == len(assign.Rhs) {
+			if rhs, ok := assign.Rhs[i].(*ast.Ident); ok {
+				ignored[rhs] = true
+			}
+		}
+	}
+}
+
+// isOuterVar reports whether obj is declared outside of the function body,
+// like named results and package-level variables, so it escapes.
+func (c *contextCancelLeakChecker) isOuterVar(decl *ast.FuncDecl, obj types.Object) bool {
+	return obj.Pos() < decl.Body.Pos() || obj.Pos() > decl.Body.End()
+}
@@ -0,0 +1,68 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "contextValueKeyType"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects context.WithValue calls that use built-in types for keys"
+	info.Before = `ctx = context.WithValue(ctx, "user", u)`
+	info.After = `
+type userKey struct{}
+ctx = context.WithValue(ctx, userKey{}, u)`
+	info.Note = "Keys of built-in types can collide with keys from other packages"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForLocalExpr(&contextValueKeyTypeChecker{ctx: ctx}), nil
+	})
+}
+
+type contextValueKeyTypeChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *contextValueKeyTypeChecker) VisitLocalExpr(expr ast.Expr) {
+	call := astcast.ToCallExpr(expr)
+	if len(call.Args) != 3 {
+		return
+	}
+	if calledFuncName(c.ctx.TypesInfo, call) != "context.WithValue" {
+		return
+	}
+
+	key := call.Args[1]
+	typ := c.ctx.TypeOf(key)
+	if c.isSharedType(typ) {
+		c.warn(key, types.Default(typ))
+	}
+}
+
+// isSharedType reports whether typ is likely to be used as a key
+// by other, unrelated packages.
+func (c *contextValueKeyTypeChecker) isSharedType(typ types.Type) bool {
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return typ.Kind() != types.Invalid && typ.Kind() != types.UntypedNil
+	case *types.Named:
+		// Types like time.Duration are not any better than
+		// their underlying basic types, since every package
+		// can use them. Types from the checked code are fine.
+		_, isBasic := typ.Underlying().(*types.Basic)
+		return isBasic && isStdlibPkg(typ.Obj().Pkg())
+	default:
+		return false
+	}
+}
+
+func (c *contextValueKeyTypeChecker) warn(key ast.Expr, typ types.Type) {
+	c.ctx.Warn(key, "should not use %s as a context.WithValue key; define an unexported key type instead", typ)
+}
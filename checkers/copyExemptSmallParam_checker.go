@@ -0,0 +1,222 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "copyExemptSmallParam"
+	info.Tags = []string{"performance", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"maxSize": {
+			Value: 16,
+			Usage: "max size in bytes of the pointed type that can be passed by value",
+		},
+	}
+	info.Summary = "Detects pointer params to small values that are never modified"
+	info.Before = `func distance(a, b *Point) float64`
+	info.After = `func distance(a, b Point) float64`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&copyExemptSmallParamChecker{
+			ctx:     ctx,
+			maxSize: int64(info.Params.Int("maxSize")),
+		}), nil
+	})
+}
+
+type copyExemptSmallParamChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	maxSize int64
+}
+
+func (c *copyExemptSmallParamChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok || (decl.Recv != nil && c.implementsIface(fn)) {
+		return
+	}
+
+	// candidates maps the small pointer params to their pointed types sizes.
+	candidates := make(map[types.Object]int64)
+	var order []*ast.Ident
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			obj := c.ctx.TypesInfo.ObjectOf(name)
+			if obj == nil || name.Name == "_" {
+				continue
+			}
+			if size, ok := c.smallPointee(obj.Type()); ok {
+				candidates[obj] = size
+				order = append(order, name)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	used := make(map[types.Object]bool)
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.ctx.TypesInfo.Uses[id]
+		if _, ok := candidates[obj]; !ok {
+			return true
+		}
+		used[obj] = true
+		if !c.isReadOnlyUse(stack) {
+			delete(candidates, obj)
+		}
+		return true
+	})
+
+	for _, name := range order {
+		obj := c.ctx.TypesInfo.ObjectOf(name)
+		size, ok := candidates[obj]
+		if !ok || !used[obj] {
+			continue
+		}
+		elem := obj.Type().(*types.Pointer).Elem()
+		c.ctx.Warn(name, "%s points to a small %s (%d bytes) that is never modified; consider passing it by value",
+			name, types.TypeString(elem, types.RelativeTo(c.ctx.Pkg)), size)
+	}
+}
+
+// smallPointee returns the pointed type size if typ is a pointer
+// to a small value that can be safely copied.
+func (c *copyExemptSmallParamChecker) smallPointee(typ types.Type) (int64, bool) {
+	ptr, ok := typ.(*types.Pointer)
+	if !ok {
+		return 0, false
+	}
+	elem := ptr.Elem()
+	switch elem.Underlying().(type) {
+	case *types.Struct, *types.Array, *types.Basic:
+	default:
+		return 0, false
+	}
+	size := c.ctx.SizesInfo.Sizeof(elem)
+	if size == 0 || size > c.maxSize || c.containsSync(elem) || c.hasPointerMethods(ptr) {
+		return 0, false
+	}
+	return size, true
+}
+
+// hasPointerMethods reports whether typ has methods with pointer receivers.
+// Such types are designed to be passed around by pointer.
+func (c *copyExemptSmallParamChecker) hasPointerMethods(typ *types.Pointer) bool {
+	mset := types.NewMethodSet(typ)
+	for i := 0; i < mset.Len(); i++ {
+		recv := mset.At(i).Obj().Type().(*types.Signature).Recv()
+		if _, ok := recv.Type().(*types.Pointer); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// containsSync reports whether typ contains sync primitives that must not be copied.
+func (c *copyExemptSmallParamChecker) containsSync(typ types.Type) bool {
+	if named, ok := typ.(*types.Named); ok && named.Obj().Pkg() != nil {
+		switch named.Obj().Pkg().Path() {
+		case "sync", "sync/atomic":
+			return true
+		}
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if c.containsSync(typ.Field(i).Type()) {
+				return true
+			}
+		}
+	case *types.Array:
+		return c.containsSync(typ.Elem())
+	}
+	return false
+}
+
+// isReadOnlyUse reports whether the pointer param at the top of the stack
+// is only used to read the pointed value, like in p.x or *p expressions.
+func (c *copyExemptSmallParamChecker) isReadOnlyUse(stack []ast.Node) bool {
+	// Find the outermost expression that accesses the pointed value.
+	i := len(stack) - 1
+	derefs := false
+	for i > 0 {
+		switch p := stack[i-1].(type) {
+		case *ast.ParenExpr:
+		case *ast.StarExpr:
+			derefs = true
+		case *ast.IndexExpr:
+			if p.X != stack[i] {
+				return derefs
+			}
+			derefs = true
+		case *ast.SelectorExpr:
+			sel := c.ctx.TypesInfo.Selections[p]
+			if sel == nil {
+				return false
+			}
+			if sel.Kind() == types.MethodVal {
+				// Pointer receiver methods may modify the value.
+				if _, ok := sel.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer); ok {
+					return false
+				}
+			}
+			derefs = true
+		default:
+			if !derefs {
+				// The pointer itself is compared, stored or passed.
+				return false
+			}
+			return !c.isWrite(p, stack[i])
+		}
+		i--
+	}
+	return derefs
+}
+
+// isWrite reports whether x is modified or its address is taken by parent.
+func (c *copyExemptSmallParamChecker) isWrite(parent, x ast.Node) bool {
+	switch parent := parent.(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == x {
+				return true
+			}
+		}
+	case *ast.IncDecStmt:
+		return true
+	case *ast.UnaryExpr:
+		return parent.Op == token.AND
+	case *ast.RangeStmt:
+		return parent.Key == x || parent.Value == x
+	}
+	return false
+}
+
+// implementsIface reports whether method fn is required by some
+// interface declared in the current or directly imported packages.
+func (c *copyExemptSmallParamChecker) implementsIface(fn *types.Func) bool {
+	pkgs := append([]*types.Package{c.ctx.Pkg}, c.ctx.Pkg.Imports()...)
+	return isIfaceMethod(fn, pkgs, nil)
+}
@@ -0,0 +1,198 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "copySliceReturnedFromGetter"
+	info.Tags = []string{"diagnostic", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"skipViews": {
+			Value: true,
+			Usage: "whether to skip types which names contain Buffer or View",
+		},
+		"skipByteSlices": {
+			Value: true,
+			Usage: "whether to skip []byte fields, they are usually shared for IO",
+		},
+	}
+	info.Summary = "Detects exported methods that return internal slices and maps which the package mutates"
+	info.Before = `
+func (r *Registry) Items() []Item {
+	return r.items
+}`
+	info.After = `
+func (r *Registry) Items() []Item {
+	return slices.Clone(r.items)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &copySliceReturnedFromGetterChecker{
+			ctx:            ctx,
+			skipViews:      info.Params.Bool("skipViews"),
+			skipByteSlices: info.Params.Bool("skipByteSlices"),
+		}, nil
+	})
+}
+
+type copySliceReturnedFromGetterChecker struct {
+	ctx *linter.CheckerContext
+
+	skipViews      bool
+	skipByteSlices bool
+
+	// mutated is a set of struct fields which contents
+	// are modified somewhere in the package.
+	mutated map[*types.Var]bool
+}
+
+func (c *copySliceReturnedFromGetterChecker) WalkPackage(files []*ast.File) {
+	c.mutated = make(map[*types.Var]bool)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				for i, lhs := range n.Lhs {
+					if index, ok := lhs.(*ast.IndexExpr); ok {
+						c.markMutated(index.X)
+					} else if len(n.Lhs) == len(n.Rhs) && c.isAppendTo(n.Rhs[i], lhs) {
+						c.markMutated(lhs)
+					}
+				}
+			case *ast.IncDecStmt:
+				if index, ok := n.X.(*ast.IndexExpr); ok {
+					c.markMutated(index.X)
+				}
+			case *ast.CallExpr:
+				if len(n.Args) == 0 {
+					break
+				}
+				if isBuiltinCall(c.ctx.TypesInfo, n, "delete") || isBuiltinCall(c.ctx.TypesInfo, n, "copy") {
+					c.markMutated(n.Args[0])
+				}
+				switch calledFuncName(c.ctx.TypesInfo, n) {
+				case "sort.Slice", "sort.SliceStable", "sort.Strings", "sort.Ints", "sort.Float64s",
+					"slices.Sort", "slices.SortFunc", "slices.SortStableFunc", "slices.Reverse":
+					c.markMutated(n.Args[0])
+				}
+			}
+			return true
+		})
+	}
+}
+
+// isAppendTo reports whether x is an append call that adds elements to dst.
+func (c *copySliceReturnedFromGetterChecker) isAppendTo(x, dst ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || !isBuiltinCall(c.ctx.TypesInfo, call, "append") {
+		return false
+	}
+	field := c.fieldOf(dst)
+	return field != nil && c.fieldOf(call.Args[0]) == field
+}
+
+func (c *copySliceReturnedFromGetterChecker) markMutated(x ast.Expr) {
+	if field := c.fieldOf(x); field != nil {
+		c.mutated[field] = true
+	}
+}
+
+// fieldOf returns the struct field selected by x or nil if x is not a field selector.
+func (c *copySliceReturnedFromGetterChecker) fieldOf(x ast.Expr) *types.Var {
+	sel, ok := astutil.Unparen(x).(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	selection := c.ctx.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.FieldVal {
+		return nil
+	}
+	field, _ := selection.Obj().(*types.Var)
+	return field
+}
+
+func (c *copySliceReturnedFromGetterChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Body == nil || !decl.Name.IsExported() {
+			continue
+		}
+		if len(decl.Recv.List[0].Names) == 0 {
+			continue
+		}
+		recv := c.ctx.TypesInfo.ObjectOf(decl.Recv.List[0].Names[0])
+		if recv == nil || c.skipViews && c.isView(recv.Type()) {
+			continue
+		}
+		c.checkReturns(decl, recv)
+	}
+}
+
+func (c *copySliceReturnedFromGetterChecker) checkReturns(decl *ast.FuncDecl, recv types.Object) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				c.checkResult(decl, recv, result)
+			}
+		}
+		return true
+	})
+}
+
+func (c *copySliceReturnedFromGetterChecker) checkResult(decl *ast.FuncDecl, recv types.Object, result ast.Expr) {
+	sel, ok := astutil.Unparen(result).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	if id, ok := sel.X.(*ast.Ident); !ok || c.ctx.TypesInfo.ObjectOf(id) != recv {
+		return
+	}
+	field := c.fieldOf(sel)
+	if field == nil || !c.mutated[field] {
+		return
+	}
+
+	var cloneFunc string
+	switch typ := field.Type().Underlying().(type) {
+	case *types.Slice:
+		if c.skipByteSlices && types.Identical(typ.Elem(), types.Typ[types.Byte]) {
+			return
+		}
+		cloneFunc = "slices.Clone"
+	case *types.Map:
+		cloneFunc = "maps.Clone"
+	default:
+		return
+	}
+	suggestion := "a copy"
+	if v := c.ctx.GoVersion; v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21}) {
+		suggestion = cloneFunc + "(" + astfmt.Sprint(sel) + ")"
+	}
+	c.ctx.Warn(result, "%s returns internal field %s that the package modifies, callers share it; return %s or document the aliasing",
+		decl.Name, sel, suggestion)
+}
+
+// isView reports whether typ is named like a view or a buffer,
+// such types are expected to expose their internals.
+func (c *copySliceReturnedFromGetterChecker) isView(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	name := named.Obj().Name()
+	return strings.Contains(name, "Buffer") || strings.Contains(name, "View")
+}
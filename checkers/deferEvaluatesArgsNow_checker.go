@@ -0,0 +1,157 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "deferEvaluatesArgsNow"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkLoopVars": {
+			Value: false,
+			Usage: "whether to report loop variables passed to the deferred calls",
+		},
+	}
+	info.Summary = "Detects deferred calls with arguments that are assigned after the defer statement"
+	info.Before = `
+var err error
+defer log.Println("finished with", err)
+err = run()`
+	info.After = `
+var err error
+defer func() { log.Println("finished with", err) }()
+err = run()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&deferEvaluatesArgsNowChecker{
+			ctx:           ctx,
+			checkLoopVars: info.Params.Bool("checkLoopVars"),
+		}), nil
+	})
+}
+
+type deferEvaluatesArgsNowChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkLoopVars bool
+}
+
+// deferInfo is a defer statement with the loop variables it can see.
+type deferInfo struct {
+	stmt     *ast.DeferStmt
+	loopVars map[types.Object]bool
+}
+
+func (c *deferEvaluatesArgsNowChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body != nil {
+		c.checkBody(decl.Type, decl.Body)
+	}
+}
+
+// checkBody checks the function body, the nested function literals
+// are checked separately.
+func (c *deferEvaluatesArgsNowChecker) checkBody(typ *ast.FuncType, body *ast.BlockStmt) {
+	var defers []deferInfo
+	// assigns maps the variables to their assignments positions.
+	assigns := make(map[types.Object][]token.Pos)
+	addAssign := func(x ast.Expr, pos token.Pos) {
+		if id, ok := x.(*ast.Ident); ok {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+				assigns[obj] = append(assigns[obj], pos)
+			}
+		}
+	}
+	var namedResults []*ast.Ident
+	if typ.Results != nil {
+		for _, field := range typ.Results.List {
+			namedResults = append(namedResults, field.Names...)
+		}
+	}
+
+	var stack []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			c.checkBody(n.Type, n.Body)
+			stack = stack[:len(stack)-1]
+			return false
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				addAssign(n.Key, n.Pos())
+				addAssign(n.Value, n.Pos())
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				addAssign(lhs, n.Pos())
+			}
+		case *ast.IncDecStmt:
+			addAssign(n.X, n.Pos())
+		case *ast.ReturnStmt:
+			if len(n.Results) != 0 {
+				for _, name := range namedResults {
+					addAssign(name, n.Pos())
+				}
+			}
+		case *ast.DeferStmt:
+			if _, ok := n.Call.Fun.(*ast.FuncLit); !ok {
+				defers = append(defers, deferInfo{stmt: n, loopVars: loopVars(c.ctx.TypesInfo, stack)})
+			}
+		}
+		return true
+	})
+
+	for _, d := range defers {
+		c.checkDefer(d, assigns)
+	}
+}
+
+func (c *deferEvaluatesArgsNowChecker) checkDefer(d deferInfo, assigns map[types.Object][]token.Pos) {
+	reported := make(map[types.Object]bool)
+	for _, arg := range d.stmt.Call.Args {
+		ast.Inspect(arg, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.UnaryExpr:
+				// Pointers see the later assignments.
+				return n.Op != token.AND
+			case *ast.Ident:
+				obj, ok := c.ctx.TypesInfo.Uses[n].(*types.Var)
+				if !ok || reported[obj] {
+					return true
+				}
+				if d.loopVars[obj] {
+					if c.checkLoopVars {
+						reported[obj] = true
+						c.ctx.Warn(d.stmt, "deferred %s captures loop variable %s at defer time; use a deferred closure if the final value is meant",
+							d.stmt.Call.Fun, n)
+					}
+					return true
+				}
+				for _, pos := range assigns[obj] {
+					if pos > d.stmt.End() {
+						reported[obj] = true
+						c.ctx.Warn(d.stmt, "deferred %s evaluates %s at defer time, so it doesn't see the later assignments; call it from a deferred closure instead",
+							d.stmt.Call.Fun, n)
+						break
+					}
+				}
+			}
+			return true
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "deferLoopCloseOverVariable"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects deferred calls in loops that see only the last value of the variable reassigned by the loop"
+	info.Before = `
+var f *os.File
+for _, name := range files {
+	f, err = os.Open(name)
+	// ...
+	defer func() { f.Close() }()
+}`
+	info.After = `
+for _, name := range files {
+	f, err := os.Open(name)
+	// ...
+	defer f.Close()
+}`
+	info.Note = "defer f.Close() evaluates f at defer time and is only reported if Close takes the address of f"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&deferLoopCloseOverVariableChecker{ctx: ctx}), nil
+	})
+}
+
+type deferLoopCloseOverVariableChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// reported is a set of receivers that are already reported
+	// for the enclosing loop.
+	reported map[*ast.Ident]bool
+}
+
+func (c *deferLoopCloseOverVariableChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.reported = make(map[*ast.Ident]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt:
+			c.checkLoop(n, n.Body, c.assigned(n.Body))
+		case *ast.RangeStmt:
+			assigned := c.assigned(n.Body)
+			if n.Tok == token.ASSIGN {
+				c.markAssigned(assigned, n.Key)
+				c.markAssigned(assigned, n.Value)
+			}
+			c.checkLoop(n, n.Body, assigned)
+		}
+		return true
+	})
+}
+
+// assigned returns a set of variables that are assigned inside body.
+func (c *deferLoopCloseOverVariableChecker) assigned(body *ast.BlockStmt) map[types.Object]bool {
+	assigned := make(map[types.Object]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				c.markAssigned(assigned, lhs)
+			}
+		}
+		return true
+	})
+	return assigned
+}
+
+func (c *deferLoopCloseOverVariableChecker) markAssigned(assigned map[types.Object]bool, x ast.Expr) {
+	if id, ok := x.(*ast.Ident); ok {
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			assigned[obj] = true
+		}
+	}
+}
+
+func (c *deferLoopCloseOverVariableChecker) checkLoop(loop ast.Stmt, body *ast.BlockStmt, assigned map[types.Object]bool) {
+	// reused reports whether x is the variable declared
+	// outside of the loop and reassigned inside it.
+	reused := func(x ast.Expr) (*ast.Ident, bool) {
+		id, ok := x.(*ast.Ident)
+		if !ok || c.reported[id] {
+			return nil, false
+		}
+		obj, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var)
+		return id, ok && obj.Parent() != c.ctx.Pkg.Scope() && obj.Pos() < loop.Pos() && assigned[obj]
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Deferred calls inside function literals are executed when the literal returns.
+			return false
+		case *ast.DeferStmt:
+			if fn, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.checkClosure(fn, reused)
+				return false
+			}
+			sel, ok := n.Call.Fun.(*ast.SelectorExpr)
+			if !ok || !c.takesAddress(sel) {
+				return true
+			}
+			if id, ok := reused(sel.X); ok {
+				c.reported[id] = true
+				c.ctx.Warn(n, "deferred %s takes the address of %s that is declared outside the loop and reassigned in it, so all deferred calls act on its final value",
+					sel, id)
+			}
+		}
+		return true
+	})
+}
+
+func (c *deferLoopCloseOverVariableChecker) checkClosure(fn *ast.FuncLit, reused func(ast.Expr) (*ast.Ident, bool)) {
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		selection := c.ctx.TypesInfo.Selections[sel]
+		if selection == nil || selection.Kind() != types.MethodVal {
+			return true
+		}
+		if id, ok := reused(sel.X); ok {
+			c.reported[id] = true
+			c.ctx.Warn(id, "deferred closure calls %s on %s that is declared outside the loop and reassigned in it, so all deferred calls act on its final value; pass %s as the closure argument",
+				sel, id, id)
+		}
+		return true
+	})
+}
+
+// takesAddress reports whether sel is a pointer receiver method
+// selected from the addressable non-pointer value.
+func (c *deferLoopCloseOverVariableChecker) takesAddress(sel *ast.SelectorExpr) bool {
+	selection := c.ctx.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return false
+	}
+	if _, ok := selection.Recv().Underlying().(*types.Pointer); ok {
+		return false
+	}
+	sig := selection.Obj().Type().(*types.Signature)
+	_, ok := sig.Recv().Type().(*types.Pointer)
+	return ok
+}
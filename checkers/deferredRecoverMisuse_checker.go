@@ -0,0 +1,97 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "deferredRecoverMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects recover calls that can't stop a panic or ignore the recovered value"
+	info.Before = `
+defer recover()`
+	info.After = `
+defer func() {
+	if r := recover(); r != nil {
+		log.Printf("recovered: %v", r)
+	}
+}()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&deferredRecoverMisuseChecker{ctx: ctx}), nil
+	})
+}
+
+type deferredRecoverMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *deferredRecoverMisuseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	// deferred is a set of function literals that are called by the defer statements.
+	deferred := make(map[*ast.FuncLit]bool)
+	reported := make(map[*ast.CallExpr]bool)
+	var lits []*ast.FuncLit
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			lits = lits[:len(lits)-1]
+			return true
+		}
+		lit, _ := n.(*ast.FuncLit)
+		lits = append(lits, lit)
+
+		switch n := n.(type) {
+		case *ast.DeferStmt:
+			if c.isRecover(n.Call) {
+				reported[n.Call] = true
+				c.ctx.Warn(n, "defer recover() doesn't stop panics; call recover inside a deferred function")
+				break
+			}
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				deferred[lit] = true
+				c.checkDeferredLit(lit)
+			}
+		case *ast.CallExpr:
+			if !c.isRecover(n) || reported[n] {
+				break
+			}
+			// Find the enclosing function literal.
+			// Recover in a named function works if that function is deferred.
+			for i := len(lits) - 1; i >= 0; i-- {
+				if lits[i] == nil {
+					continue
+				}
+				if !deferred[lits[i]] {
+					c.ctx.Warn(n, "recover() always returns nil outside of deferred functions")
+				}
+				break
+			}
+		}
+		return true
+	})
+}
+
+// checkDeferredLit reports deferred functions that only
+// call recover, so the panics are swallowed silently.
+func (c *deferredRecoverMisuseChecker) checkDeferredLit(lit *ast.FuncLit) {
+	if len(lit.Body.List) != 1 {
+		return
+	}
+	stmt, ok := lit.Body.List[0].(*ast.ExprStmt)
+	if ok && c.isRecover(astcast.ToCallExpr(stmt.X)) {
+		c.ctx.Warn(lit, "deferred function swallows panics silently; handle or log the recovered value")
+	}
+}
+
+func (c *deferredRecoverMisuseChecker) isRecover(call *ast.CallExpr) bool {
+	return isBuiltinCall(c.ctx.TypesInfo, call, "recover") && len(call.Args) == 0
+}
@@ -33,13 +33,11 @@ func init() {
 			}
 		}
 
-		// m maps pattern string to a matching function.
-		// String patterns are used for documentation purposes (readability).
-		m := map[string]func(*ast.CallExpr) bool{
-			"(x, x, ...)":    newMatcherFunc(0, 1),
-			"(x, _, x, ...)": newMatcherFunc(0, 2),
-			"(_, x, x, ...)": newMatcherFunc(1, 2),
-		}
+		// argsIJ matches the calls with identical i-th and j-th arguments.
+		// Pattern comments are used for documentation purposes (readability).
+		args01 := newMatcherFunc(0, 1) // (x, x, ...)
+		args02 := newMatcherFunc(0, 2) // (x, _, x, ...)
+		args12 := newMatcherFunc(1, 2) // (_, x, x, ...)
 
 		// TODO(quasilyte): handle x.Equal(x) cases.
 		// Example: *math/Big.Int.Cmp method.
@@ -51,47 +49,47 @@ func init() {
 		// that are not incorporated into this checker yet).
 
 		c.matchers = map[string]func(*ast.CallExpr) bool{
-			"copy": m["(x, x, ...)"],
-
-			"math.Max": m["(x, x, ...)"],
-			"math.Min": m["(x, x, ...)"],
-
-			"reflect.Copy":      m["(x, x, ...)"],
-			"reflect.DeepEqual": m["(x, x, ...)"],
-
-			"strings.Contains":    m["(x, x, ...)"],
-			"strings.Compare":     m["(x, x, ...)"],
-			"strings.EqualFold":   m["(x, x, ...)"],
-			"strings.HasPrefix":   m["(x, x, ...)"],
-			"strings.HasSuffix":   m["(x, x, ...)"],
-			"strings.Index":       m["(x, x, ...)"],
-			"strings.LastIndex":   m["(x, x, ...)"],
-			"strings.Split":       m["(x, x, ...)"],
-			"strings.SplitAfter":  m["(x, x, ...)"],
-			"strings.SplitAfterN": m["(x, x, ...)"],
-			"strings.SplitN":      m["(x, x, ...)"],
-			"strings.Replace":     m["(_, x, x, ...)"],
-			"strings.ReplaceAll":  m["(_, x, x, ...)"],
-
-			"bytes.Contains":    m["(x, x, ...)"],
-			"bytes.Compare":     m["(x, x, ...)"],
-			"bytes.Equal":       m["(x, x, ...)"],
-			"bytes.EqualFold":   m["(x, x, ...)"],
-			"bytes.HasPrefix":   m["(x, x, ...)"],
-			"bytes.HasSuffix":   m["(x, x, ...)"],
-			"bytes.Index":       m["(x, x, ...)"],
-			"bytes.LastIndex":   m["(x, x, ...)"],
-			"bytes.Split":       m["(x, x, ...)"],
-			"bytes.SplitAfter":  m["(x, x, ...)"],
-			"bytes.SplitAfterN": m["(x, x, ...)"],
-			"bytes.SplitN":      m["(x, x, ...)"],
-			"bytes.Replace":     m["(_, x, x, ...)"],
-			"bytes.ReplaceAll":  m["(_, x, x, ...)"],
-
-			"types.Identical":           m["(x, x, ...)"],
-			"types.IdenticalIgnoreTags": m["(x, x, ...)"],
-
-			"draw.Draw": m["(x, _, x, ...)"],
+			"copy": args01,
+
+			"math.Max": args01,
+			"math.Min": args01,
+
+			"reflect.Copy":      args01,
+			"reflect.DeepEqual": args01,
+
+			"strings.Contains":    args01,
+			"strings.Compare":     args01,
+			"strings.EqualFold":   args01,
+			"strings.HasPrefix":   args01,
+			"strings.HasSuffix":   args01,
+			"strings.Index":       args01,
+			"strings.LastIndex":   args01,
+			"strings.Split":       args01,
+			"strings.SplitAfter":  args01,
+			"strings.SplitAfterN": args01,
+			"strings.SplitN":      args01,
+			"strings.Replace":     args12,
+			"strings.ReplaceAll":  args12,
+
+			"bytes.Contains":    args01,
+			"bytes.Compare":     args01,
+			"bytes.Equal":       args01,
+			"bytes.EqualFold":   args01,
+			"bytes.HasPrefix":   args01,
+			"bytes.HasSuffix":   args01,
+			"bytes.Index":       args01,
+			"bytes.LastIndex":   args01,
+			"bytes.Split":       args01,
+			"bytes.SplitAfter":  args01,
+			"bytes.SplitAfterN": args01,
+			"bytes.SplitN":      args01,
+			"bytes.Replace":     args12,
+			"bytes.ReplaceAll":  args12,
+
+			"types.Identical":           args01,
+			"types.IdenticalIgnoreTags": args01,
+
+			"draw.Draw": args02,
 
 			// TODO(quasilyte): more of these.
 		}
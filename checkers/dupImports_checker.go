@@ -3,6 +3,7 @@ package checkers
 import (
 	"fmt"
 	"go/ast"
+	"strings"
 
 	"github.com/go-critic/go-critic/framework/linter"
 )
@@ -32,7 +33,7 @@ type dupImportChecker struct {
 }
 
 func (c *dupImportChecker) WalkFile(f *ast.File) {
-	imports := make(map[string][]*ast.ImportSpec)
+	imports := make(map[string][]*ast.ImportSpec, len(f.Imports))
 	for _, importDcl := range f.Imports {
 		pkg := importDcl.Path.Value
 		imports[pkg] = append(imports[pkg], importDcl)
@@ -47,16 +48,18 @@ func (c *dupImportChecker) WalkFile(f *ast.File) {
 }
 
 func (c *dupImportChecker) warn(importList []*ast.ImportSpec) {
-	msg := fmt.Sprintf("package is imported %d times under different aliases on lines", len(importList))
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "package is imported %d times under different aliases on lines", len(importList))
 	for idx, importDcl := range importList {
 		switch {
 		case idx == len(importList)-1:
-			msg += " and"
+			sb.WriteString(" and")
 		case idx > 0:
-			msg += ","
+			sb.WriteString(",")
 		}
-		msg += fmt.Sprintf(" %d", c.ctx.FileSet.Position(importDcl.Pos()).Line)
+		fmt.Fprintf(&sb, " %d", c.ctx.FileSet.Position(importDcl.Pos()).Line)
 	}
+	msg := sb.String()
 	for _, importDcl := range importList {
 		c.ctx.Warn(importDcl, msg)
 	}
@@ -0,0 +1,204 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "duplicateStringLiteral"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minLength": {
+			Value: 6,
+			Usage: "min length of the string literal to be checked",
+		},
+		"minOccurrences": {
+			Value: 4,
+			Usage: "min number of the literal occurrences in the package to trigger a warning",
+		},
+		"skipTests": {
+			Value: true,
+			Usage: "whether to skip _test.go files",
+		},
+		"skipTestdata": {
+			Value: true,
+			Usage: "whether to skip files in testdata directories",
+		},
+	}
+	info.Summary = "Detects string literals repeated across the package that could be constants"
+	info.Before = `
+req.Header.Set("application/json", v)
+// ... 3 more times in the package`
+	info.After = `
+const contentTypeJSON = "application/json"
+req.Header.Set(contentTypeJSON, v)`
+	info.Note = `Literals that look like names, like "append", "fmt.Sprintf" or "net/http",
+are not checked: they refer to the code entities and rarely benefit from a constant.
+Literals used as the keys of a map literal are treated like constants,
+the map already gives them a single declaration.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &duplicateStringLiteralChecker{
+			ctx:            ctx,
+			minLength:      info.Params.Int("minLength"),
+			minOccurrences: info.Params.Int("minOccurrences"),
+			skipTests:      info.Params.Bool("skipTests"),
+			skipTestdata:   info.Params.Bool("skipTestdata"),
+		}, nil
+	})
+}
+
+type duplicateStringLiteralChecker struct {
+	ctx *linter.CheckerContext
+
+	minLength      int
+	minOccurrences int
+	skipTests      bool
+	skipTestdata   bool
+
+	// duplicates maps the first occurrence of every repeated
+	// literal to all its occurrences in the package.
+	duplicates map[*ast.BasicLit][]*ast.BasicLit
+}
+
+func (c *duplicateStringLiteralChecker) WalkPackage(files []*ast.File) {
+	// declared is a set of the values that are already declared
+	// as constants or as the keys of map literals.
+	declared := make(map[string]bool)
+	occurrences := make(map[string][]*ast.BasicLit)
+	var order []string
+
+	for _, f := range files {
+		if !c.shouldCheck(f) {
+			continue
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ImportSpec:
+				return false
+			case *ast.Field:
+				// Struct tags are skipped, types can't contain string literals.
+				return false
+			case *ast.GenDecl:
+				if n.Tok == token.CONST {
+					c.collectConsts(n, declared)
+					return false
+				}
+			case *ast.CompositeLit:
+				c.collectMapKeys(n, declared)
+			case *ast.BasicLit:
+				s, ok := c.literalValue(n)
+				if !ok {
+					return true
+				}
+				if _, seen := occurrences[s]; !seen {
+					order = append(order, s)
+				}
+				occurrences[s] = append(occurrences[s], n)
+			}
+			return true
+		})
+	}
+
+	c.duplicates = make(map[*ast.BasicLit][]*ast.BasicLit)
+	for _, s := range order {
+		lits := occurrences[s]
+		if len(lits) >= c.minOccurrences && !declared[s] {
+			c.duplicates[lits[0]] = lits
+		}
+	}
+}
+
+func (c *duplicateStringLiteralChecker) shouldCheck(f *ast.File) bool {
+	filename := c.ctx.FileSet.Position(f.Pos()).Filename
+	if c.skipTests && strings.HasSuffix(filename, "_test.go") {
+		return false
+	}
+	if c.skipTestdata && strings.Contains(filepath.ToSlash(filename), "/testdata/") {
+		return false
+	}
+	return !isGeneratedFile(f) && !isRuleguardFile(f)
+}
+
+func (c *duplicateStringLiteralChecker) collectConsts(decl *ast.GenDecl, declared map[string]bool) {
+	for _, spec := range decl.Specs {
+		for _, v := range spec.(*ast.ValueSpec).Values {
+			if lit, ok := v.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if s, err := strconv.Unquote(lit.Value); err == nil {
+					declared[s] = true
+				}
+			}
+		}
+	}
+}
+
+func (c *duplicateStringLiteralChecker) collectMapKeys(lit *ast.CompositeLit, declared map[string]bool) {
+	if _, ok := c.ctx.TypeOf(lit).Underlying().(*types.Map); !ok {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.BasicLit); ok && key.Kind == token.STRING {
+			if s, err := strconv.Unquote(key.Value); err == nil {
+				declared[s] = true
+			}
+		}
+	}
+}
+
+func (c *duplicateStringLiteralChecker) literalValue(lit *ast.BasicLit) (string, bool) {
+	if lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil || len(s) < c.minLength || isNameLiteral(s) {
+		return "", false
+	}
+	return s, true
+}
+
+var nameLiteralRE = regexp.MustCompile(`^[\pL_][\pL\pN_]*(\.[\pL_][\pL\pN_]*)*$`)
+
+// isNameLiteral reports whether s looks like an identifier, a qualified
+// name or a standard library package path.
+func isNameLiteral(s string) bool {
+	return goStdlib[s] || nameLiteralRE.MatchString(s)
+}
+
+func (c *duplicateStringLiteralChecker) WalkFile(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		lits := c.duplicates[lit]
+		if lits == nil {
+			return true
+		}
+		others := lits[1:]
+		if len(others) > 3 {
+			others = others[:3]
+		}
+		locations := make([]string, len(others))
+		for i, other := range others {
+			pos := c.ctx.FileSet.Position(other.Pos())
+			locations[i] = fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+		}
+		c.ctx.Warn(lit, "string literal %s is repeated %d times in the package (also at %s); consider a named constant",
+			lit, len(lits), strings.Join(locations, ", "))
+		return true
+	})
+}
@@ -0,0 +1,210 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "durationUnitMultiplication"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"unitSuffixes": {
+			Value: "Ns:Nanosecond,Nanos:Nanosecond,Us:Microsecond,Micros:Microsecond," +
+				"Ms:Millisecond,Millis:Millisecond,Msec:Millisecond,Milliseconds:Millisecond," +
+				"Sec:Second,Secs:Second,Seconds:Second,Mins:Minute,Minutes:Minute,Hours:Hour",
+			Usage: "comma-separated list of suffix:Unit pairs that describe the units carried by variable names",
+		},
+	}
+	info.Summary = "Detects suspicious time.Duration arithmetic with mismatched units"
+	info.Before = `
+time.Sleep(time.Duration(timeoutMs) * time.Second)
+time.Sleep(5000)`
+	info.After = `
+time.Sleep(time.Duration(timeoutMs) * time.Millisecond)
+time.Sleep(5000 * time.Millisecond)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		suffixes := make(map[string]string)
+		for _, pair := range strings.Split(info.Params.String("unitSuffixes"), ",") {
+			parts := strings.Split(strings.TrimSpace(pair), ":")
+			if len(parts) == 2 {
+				suffixes[strings.ToLower(parts[0])] = parts[1]
+			}
+		}
+		return astwalk.WalkerForExpr(&durationUnitMultiplicationChecker{
+			ctx:      ctx,
+			suffixes: suffixes,
+			skip:     make(map[ast.Expr]bool),
+		}), nil
+	})
+}
+
+type durationUnitMultiplicationChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// suffixes maps lower-case name suffixes to the time unit names.
+	suffixes map[string]string
+
+	// skip is a set of conversions that are multiplied by a unit.
+	skip map[ast.Expr]bool
+}
+
+func (c *durationUnitMultiplicationChecker) VisitExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op == token.MUL {
+			c.checkMul(expr)
+		}
+	case *ast.CallExpr:
+		if c.skip[expr] {
+			return
+		}
+		if tv := c.ctx.TypesInfo.Types[expr.Fun]; tv.IsType() {
+			c.checkConversion(expr)
+		} else {
+			c.checkArgs(expr)
+		}
+	}
+}
+
+func (c *durationUnitMultiplicationChecker) checkMul(expr *ast.BinaryExpr) {
+	x, y := expr.X, expr.Y
+	unit := c.unitName(y)
+	if unit == "" {
+		x, y = y, x
+		unit = c.unitName(y)
+	}
+	if unit == "" {
+		return
+	}
+	x = astutil.Unparen(x)
+	if call, ok := x.(*ast.CallExpr); ok && c.isDurationConversion(call) {
+		c.skip[call] = true
+		x = astutil.Unparen(call.Args[0])
+	}
+
+	var name string
+	switch x := x.(type) {
+	case *ast.Ident:
+		name = x.Name
+	case *ast.SelectorExpr:
+		name = x.Sel.Name
+	default:
+		return
+	}
+	if c.ctx.TypesInfo.Types[x].Value != nil {
+		return // Constant expressions are OK
+	}
+	if nameUnit := c.nameUnit(name); nameUnit != "" && nameUnit != unit {
+		c.ctx.Warn(expr, "%s is multiplied by time.%s, but its name suggests it's in %ss",
+			x, unit, strings.ToLower(nameUnit))
+	}
+}
+
+// checkConversion reports time.Duration(x * 1000) conversions
+// that are probably expected to produce milliseconds.
+func (c *durationUnitMultiplicationChecker) checkConversion(call *ast.CallExpr) {
+	if !c.isDurationConversion(call) {
+		return
+	}
+	mul, ok := astutil.Unparen(call.Args[0]).(*ast.BinaryExpr)
+	if !ok || mul.Op != token.MUL || c.ctx.TypesInfo.Types[mul].Value != nil {
+		return
+	}
+	for _, operand := range []ast.Expr{mul.X, mul.Y} {
+		cv := c.ctx.TypesInfo.Types[operand].Value
+		if cv == nil {
+			continue
+		}
+		if v, ok := constant.Int64Val(constant.ToInt(cv)); ok && (v == int64(time.Microsecond) || v == int64(time.Millisecond)) {
+			c.ctx.Warn(call, "%s is measured in nanoseconds; multiply by a time unit constant instead of %s",
+				call, operand)
+			return
+		}
+	}
+}
+
+// checkArgs reports integer literals passed as time.Duration arguments.
+func (c *durationUnitMultiplicationChecker) checkArgs(call *ast.CallExpr) {
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return
+	}
+	params := sig.Params()
+	for i, arg := range call.Args {
+		var typ types.Type
+		switch {
+		case i < params.Len()-1 || (!sig.Variadic() && i < params.Len()):
+			typ = params.At(i).Type()
+		case sig.Variadic() && call.Ellipsis == token.NoPos:
+			typ = params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+		default:
+			continue
+		}
+		lit, ok := astutil.Unparen(arg).(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT || !c.isDuration(typ) {
+			continue
+		}
+		if v, ok := constant.Int64Val(c.ctx.TypesInfo.Types[lit].Value); ok && v > int64(time.Microsecond) {
+			c.ctx.Warn(arg, "%s is interpreted as %s nanoseconds; use an explicit unit like %s * time.Millisecond",
+				lit, lit, lit)
+		}
+	}
+}
+
+// unitName returns the time unit constant name for the expressions
+// like time.Second. For other expressions, empty string is returned.
+func (c *durationUnitMultiplicationChecker) unitName(x ast.Expr) string {
+	sel, ok := astutil.Unparen(x).(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	obj, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.Const)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != "time" || !c.isDuration(obj.Type()) {
+		return ""
+	}
+	return obj.Name()
+}
+
+// nameUnit returns the time unit that is suggested by the name suffix.
+// Suffixes are matched at camelCase or snake_case word boundaries.
+func (c *durationUnitMultiplicationChecker) nameUnit(name string) string {
+	lower := strings.ToLower(name)
+	for suffix, unit := range c.suffixes {
+		if !strings.HasSuffix(lower, suffix) {
+			continue
+		}
+		prefix := name[:len(name)-len(suffix)]
+		first, _ := utf8.DecodeRuneInString(name[len(prefix):])
+		if prefix == "" || strings.HasSuffix(prefix, "_") || unicode.IsUpper(first) {
+			return unit
+		}
+	}
+	return ""
+}
+
+func (c *durationUnitMultiplicationChecker) isDurationConversion(call *ast.CallExpr) bool {
+	tv := c.ctx.TypesInfo.Types[call.Fun]
+	return tv.IsType() && len(call.Args) == 1 && c.isDuration(tv.Type)
+}
+
+func (c *durationUnitMultiplicationChecker) isDuration(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration"
+}
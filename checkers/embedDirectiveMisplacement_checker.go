@@ -0,0 +1,161 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "embedDirectiveMisplacement"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects go:embed directives that can't be applied to the following declaration"
+	info.Before = `
+func loadTemplates() {
+	//go:embed templates/*.html
+	var templates embed.FS
+}`
+	info.After = `
+//go:embed templates/*.html
+var templates embed.FS`
+	info.Note = "Blank lines and line comments between the directive and the var declaration are permitted"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &embedDirectiveMisplacementChecker{ctx: ctx}, nil
+	})
+}
+
+type embedDirectiveMisplacementChecker struct {
+	ctx *linter.CheckerContext
+}
+
+func (c *embedDirectiveMisplacementChecker) WalkFile(f *ast.File) {
+	importsEmbed := false
+	for _, spec := range f.Imports {
+		if spec.Path.Value == `"embed"` {
+			importsEmbed = true
+		}
+	}
+
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			if !isEmbedDirective(comment.Text) {
+				continue
+			}
+			if !importsEmbed {
+				c.ctx.Warn(comment, `go:embed directive requires importing "embed"; add import _ "embed"`)
+				continue
+			}
+			c.checkDirective(f, comment)
+		}
+	}
+}
+
+func isEmbedDirective(text string) bool {
+	if !strings.HasPrefix(text, "//go:embed") {
+		return false
+	}
+	rest := text[len("//go:embed"):]
+	return rest == "" || rest[0] == ' ' || rest[0] == '\t'
+}
+
+func (c *embedDirectiveMisplacementChecker) checkDirective(f *ast.File, comment *ast.Comment) {
+	var next ast.Node
+	for _, decl := range f.Decls {
+		if decl.End() <= comment.Pos() {
+			continue
+		}
+		if decl.Pos() > comment.End() {
+			next = decl
+			break
+		}
+		// The directive is inside the declaration.
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			c.ctx.Warn(comment, "go:embed directive can only be applied to the package-level variables")
+			return
+		case *ast.GenDecl:
+			if decl.Tok != token.VAR {
+				break
+			}
+			for _, spec := range decl.Specs {
+				if spec.Pos() > comment.End() {
+					next = spec
+					break
+				}
+			}
+		}
+		break
+	}
+
+	var spec *ast.ValueSpec
+	switch n := next.(type) {
+	case *ast.GenDecl:
+		if n.Tok == token.VAR && !n.Lparen.IsValid() {
+			spec = n.Specs[0].(*ast.ValueSpec)
+		}
+	case *ast.ValueSpec:
+		spec = n
+	}
+	if spec == nil || c.separated(f, comment, spec) {
+		c.ctx.Warn(comment, "go:embed directive is not followed by a single var declaration")
+		return
+	}
+
+	switch {
+	case len(spec.Names) != 1:
+		c.ctx.Warn(comment, "go:embed directive can't be applied to several variables %s", c.names(spec))
+	case len(spec.Values) != 0:
+		c.ctx.Warn(comment, "go:embed directive can't be applied to the initialized variable %s", spec.Names[0])
+	default:
+		obj := c.ctx.TypesInfo.ObjectOf(spec.Names[0])
+		if obj != nil && !isEmbeddableType(obj.Type()) {
+			c.ctx.Warn(comment, "go:embed directive can't be applied to %s of type %s; use string, []byte or embed.FS",
+				spec.Names[0], types.TypeString(obj.Type(), types.RelativeTo(c.ctx.Pkg)))
+		}
+	}
+}
+
+// separated reports whether there is anything except blank lines and
+// line comments between the directive and the var spec.
+func (c *embedDirectiveMisplacementChecker) separated(f *ast.File, comment *ast.Comment, spec *ast.ValueSpec) bool {
+	for _, group := range f.Comments {
+		for _, other := range group.List {
+			if other.Pos() > comment.End() && other.End() < spec.Pos() && strings.HasPrefix(other.Text, "/*") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *embedDirectiveMisplacementChecker) names(spec *ast.ValueSpec) string {
+	names := make([]string, len(spec.Names))
+	for i, name := range spec.Names {
+		names[i] = name.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// isEmbeddableType reports whether the files can be embedded into typ variable.
+func isEmbeddableType(typ types.Type) bool {
+	if named, ok := typ.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "embed" && obj.Name() == "FS" {
+			return true
+		}
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Basic:
+		return typ.Kind() == types.String
+	case *types.Slice:
+		elem, ok := typ.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	default:
+		return false
+	}
+}
@@ -0,0 +1,230 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "emptyStructSignalChan"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Summary = "Detects chan bool and chan interface{} channels that are only used for signaling"
+	info.Before = `
+done := make(chan bool)
+go func() {
+	work()
+	done <- true
+}()
+<-done`
+	info.After = `
+done := make(chan struct{})
+go func() {
+	work()
+	done <- struct{}{}
+}()
+<-done`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &emptyStructSignalChanChecker{ctx: ctx}, nil
+	})
+}
+
+type emptyStructSignalChanChecker struct {
+	ctx *linter.CheckerContext
+
+	// signalChans is a set of channel variables and fields
+	// that are only used for signaling inside the current package.
+	signalChans map[types.Object]bool
+}
+
+func (c *emptyStructSignalChanChecker) WalkPackage(files []*ast.File) {
+	candidates := make(map[types.Object]bool)
+	// signalUses is a set of identifiers that are used in
+	// a send, receive or other operation that keeps the channel a signal one.
+	signalUses := make(map[*ast.Ident]bool)
+	// bad is a set of candidates that are used in a non-signal way.
+	bad := make(map[types.Object]bool)
+
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ValueSpec:
+				for i, name := range n.Names {
+					c.addCandidate(candidates, name)
+					// Channels declared without initializer are nil until
+					// they're assigned, these assignments are checked separately.
+					if len(n.Values) == 0 || (i < len(n.Values) && c.isMake(n.Values[i])) {
+						signalUses[name] = true
+					}
+				}
+			case *ast.StructType:
+				for _, field := range n.Fields.List {
+					for _, name := range field.Names {
+						c.addCandidate(candidates, name)
+					}
+				}
+			case *ast.AssignStmt:
+				c.walkAssign(n, candidates, signalUses)
+			case *ast.KeyValueExpr:
+				if id, ok := n.Key.(*ast.Ident); ok && c.isMake(n.Value) {
+					signalUses[id] = true
+				}
+			case *ast.SendStmt:
+				id := c.chanIdent(n.Chan)
+				if id == nil {
+					break
+				}
+				if c.isSignalValue(n.Value) {
+					signalUses[id] = true
+				} else if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+					bad[obj] = true
+				}
+			case *ast.ExprStmt:
+				// Receive statement with a discarded value.
+				if recv := c.recvIdent(n.X); recv != nil {
+					signalUses[recv] = true
+				}
+			case *ast.RangeStmt:
+				if id := c.chanIdent(n.X); id != nil && n.Key == nil {
+					signalUses[id] = true
+				}
+			case *ast.CallExpr:
+				for _, name := range []string{"close", "len", "cap"} {
+					if isBuiltinCall(c.ctx.TypesInfo, n, name) && len(n.Args) == 1 {
+						if id := c.chanIdent(n.Args[0]); id != nil {
+							signalUses[id] = true
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	for id, obj := range c.ctx.TypesInfo.Uses {
+		if candidates[obj] && !signalUses[id] {
+			bad[obj] = true
+		}
+	}
+	for id, obj := range c.ctx.TypesInfo.Defs {
+		// Variables that are initialized with something
+		// other than make() may alias other channels.
+		if v, ok := obj.(*types.Var); ok && candidates[obj] && !v.IsField() && !signalUses[id] {
+			bad[obj] = true
+		}
+	}
+
+	c.signalChans = make(map[types.Object]bool)
+	for obj := range candidates {
+		if !bad[obj] {
+			c.signalChans[obj] = true
+		}
+	}
+}
+
+func (c *emptyStructSignalChanChecker) WalkFile(f *ast.File) {
+	if len(c.signalChans) == 0 {
+		return
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.ctx.TypesInfo.Defs[id]
+		if obj != nil && c.signalChans[obj] {
+			c.ctx.Warn(id, "%s is only used for signaling; use chan struct{} instead of %s",
+				id, types.TypeString(obj.Type(), nil))
+		}
+		return true
+	})
+}
+
+func (c *emptyStructSignalChanChecker) addCandidate(candidates map[types.Object]bool, id *ast.Ident) {
+	obj := c.ctx.TypesInfo.Defs[id]
+	if obj == nil || obj.Exported() {
+		// Exported channels can be used outside of the package.
+		return
+	}
+	ch, ok := obj.Type().(*types.Chan)
+	if !ok || ch.Dir() != types.SendRecv {
+		return
+	}
+	elem := ch.Elem()
+	iface, isIface := elem.(*types.Interface)
+	if types.Identical(elem, types.Typ[types.Bool]) || (isIface && iface.Empty()) {
+		candidates[obj] = true
+	}
+}
+
+func (c *emptyStructSignalChanChecker) walkAssign(assign *ast.AssignStmt, candidates map[types.Object]bool, signalUses map[*ast.Ident]bool) {
+	if assign.Tok == token.DEFINE {
+		for _, lhs := range assign.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok {
+				c.addCandidate(candidates, id)
+			}
+		}
+	}
+
+	// Receive with a discarded value, like `_ = <-ch`.
+	if len(assign.Lhs) == 1 && len(assign.Rhs) == 1 && astcast.ToIdent(assign.Lhs[0]).Name == "_" {
+		if recv := c.recvIdent(assign.Rhs[0]); recv != nil {
+			signalUses[recv] = true
+		}
+		return
+	}
+
+	// Channel initialization with make() assignment.
+	if len(assign.Lhs) != len(assign.Rhs) || (assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE) {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		if id := c.chanIdent(lhs); id != nil && c.isMake(assign.Rhs[i]) {
+			signalUses[id] = true
+		}
+	}
+}
+
+func (c *emptyStructSignalChanChecker) recvIdent(x ast.Expr) *ast.Ident {
+	recv, ok := astutil.Unparen(x).(*ast.UnaryExpr)
+	if !ok || recv.Op != token.ARROW {
+		return nil
+	}
+	return c.chanIdent(recv.X)
+}
+
+// chanIdent returns the identifier of a channel variable or field.
+func (c *emptyStructSignalChanChecker) chanIdent(x ast.Expr) *ast.Ident {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.Ident:
+		return x
+	case *ast.SelectorExpr:
+		return x.Sel
+	default:
+		return nil
+	}
+}
+
+func (c *emptyStructSignalChanChecker) isMake(x ast.Expr) bool {
+	call, ok := astutil.Unparen(x).(*ast.CallExpr)
+	return ok && isBuiltinCall(c.ctx.TypesInfo, call, "make")
+}
+
+// isSignalValue reports whether x carries no information except the event itself.
+func (c *emptyStructSignalChanChecker) isSignalValue(x ast.Expr) bool {
+	if c.ctx.TypesInfo.Types[x].Value != nil || isNil(c.ctx.TypesInfo, x) {
+		return true
+	}
+	lit, ok := astutil.Unparen(x).(*ast.CompositeLit)
+	if !ok || len(lit.Elts) != 0 {
+		return false
+	}
+	typ, ok := c.ctx.TypeOf(lit).Underlying().(*types.Struct)
+	return ok && typ.NumFields() == 0
+}
@@ -0,0 +1,155 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "encodingJSONUnexportedFields"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects encoding of structs that don't have any exported fields"
+	info.Before = `
+type config struct {
+	name string
+	port int
+}
+data, err := json.Marshal(config{name: "api", port: 80})`
+	info.After = `
+type config struct {
+	Name string
+	Port int
+}
+data, err := json.Marshal(config{Name: "api", Port: 80})`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&encodingJSONUnexportedFieldsChecker{ctx: ctx}), nil
+	})
+}
+
+type encodingJSONUnexportedFieldsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// encodingFunc describes the function that encodes or decodes its argument.
+type encodingFunc struct {
+	// name is the function name as it's shown in the warnings.
+	name string
+	// arg is the index of the encoded argument.
+	arg int
+	// custom is the method that is suggested to customize the encoding.
+	custom string
+	// methods lists all methods that customize the encoding.
+	methods []string
+}
+
+var (
+	jsonMethods = []string{"MarshalJSON", "UnmarshalJSON", "MarshalText", "UnmarshalText"}
+	xmlMethods  = []string{"MarshalXML", "UnmarshalXML", "MarshalText", "UnmarshalText"}
+	gobMethods  = []string{"GobEncode", "GobDecode", "MarshalBinary", "UnmarshalBinary"}
+)
+
+var encodingFuncs = map[string]encodingFunc{
+	"encoding/json.Marshal":           {"json.Marshal", 0, "MarshalJSON", jsonMethods},
+	"encoding/json.MarshalIndent":     {"json.MarshalIndent", 0, "MarshalJSON", jsonMethods},
+	"encoding/json.Unmarshal":         {"json.Unmarshal", 1, "UnmarshalJSON", jsonMethods},
+	"(*encoding/json.Encoder).Encode": {"json.Encoder.Encode", 0, "MarshalJSON", jsonMethods},
+	"(*encoding/json.Decoder).Decode": {"json.Decoder.Decode", 0, "UnmarshalJSON", jsonMethods},
+	"encoding/xml.Marshal":            {"xml.Marshal", 0, "MarshalXML", xmlMethods},
+	"encoding/xml.MarshalIndent":      {"xml.MarshalIndent", 0, "MarshalXML", xmlMethods},
+	"encoding/xml.Unmarshal":          {"xml.Unmarshal", 1, "UnmarshalXML", xmlMethods},
+	"(*encoding/xml.Encoder).Encode":  {"xml.Encoder.Encode", 0, "MarshalXML", xmlMethods},
+	"(*encoding/xml.Decoder).Decode":  {"xml.Decoder.Decode", 0, "UnmarshalXML", xmlMethods},
+	"(*encoding/gob.Encoder).Encode":  {"gob.Encoder.Encode", 0, "GobEncode", gobMethods},
+	"(*encoding/gob.Decoder).Decode":  {"gob.Decoder.Decode", 0, "GobDecode", gobMethods},
+}
+
+func (c *encodingJSONUnexportedFieldsChecker) VisitExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.CallExpr:
+		c.checkCall(expr)
+	case *ast.StructType:
+		c.checkTags(expr)
+	}
+}
+
+func (c *encodingJSONUnexportedFieldsChecker) checkCall(call *ast.CallExpr) {
+	fn, ok := encodingFuncs[calledFuncName(c.ctx.TypesInfo, call)]
+	if !ok || len(call.Args) <= fn.arg {
+		return
+	}
+	typ := c.ctx.TypeOf(call.Args[fn.arg])
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || st.NumFields() == 0 || c.hasExportedFields(st) || c.hasMethod(named, fn.methods) {
+		return
+	}
+	c.ctx.Warn(call.Args[fn.arg], "%s has no exported fields, %s ignores all of them; export the fields or implement %s",
+		types.TypeString(named, types.RelativeTo(c.ctx.Pkg)), fn.name, fn.custom)
+}
+
+// hasExportedFields reports whether st has exported fields,
+// including the fields promoted from the embedded structs.
+func (c *encodingJSONUnexportedFieldsChecker) hasExportedFields(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if field.Exported() {
+			return true
+		}
+		if !field.Embedded() {
+			continue
+		}
+		typ := field.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if embedded, ok := typ.Underlying().(*types.Struct); ok && c.hasExportedFields(embedded) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *encodingJSONUnexportedFieldsChecker) hasMethod(named *types.Named, methods []string) bool {
+	mset := types.NewMethodSet(types.NewPointer(named))
+	for _, name := range methods {
+		if mset.Lookup(named.Obj().Pkg(), name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *encodingJSONUnexportedFieldsChecker) checkTags(typ *ast.StructType) {
+	for _, field := range typ.Fields.List {
+		if field.Tag == nil || len(field.Names) == 0 {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		value, ok := reflect.StructTag(tag).Lookup("json")
+		if !ok || value == "-" {
+			continue
+		}
+		for _, name := range field.Names {
+			if !ast.IsExported(name.Name) && name.Name != "_" {
+				c.ctx.Warn(field.Tag, "json tag of unexported field %s is ignored, encoding/json only uses exported fields", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errgroupWithContextMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects errgroup goroutines that ignore the derived context, are started after Wait or capture loop variables"
+	info.Before = `
+g, gctx := errgroup.WithContext(ctx)
+for _, url := range urls {
+	g.Go(func() error { return fetch(ctx, url) })
+}`
+	info.After = `
+g, gctx := errgroup.WithContext(ctx)
+for _, url := range urls {
+	url := url
+	g.Go(func() error { return fetch(gctx, url) })
+}`
+	info.Note = "Loop variables captures are only reported for Go versions before 1.22"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&errgroupWithContextMisuseChecker{ctx: ctx}), nil
+	})
+}
+
+type errgroupWithContextMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkLoopVars bool
+}
+
+// groupContext describes the contexts of the group created by errgroup.WithContext.
+type groupContext struct {
+	parent  types.Object
+	derived *ast.Ident
+}
+
+func (c *errgroupWithContextMisuseChecker) EnterFile(f *ast.File) bool {
+	// Since Go 1.22 every iteration has its own copy of loop variables.
+	v := c.ctx.GoVersion
+	c.checkLoopVars = v.IsAny() || !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 22})
+	return true
+}
+
+func (c *errgroupWithContextMisuseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	contexts := make(map[types.Object]groupContext)
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			c.collectContext(n, contexts)
+		case *ast.BlockStmt:
+			c.checkWaitOrder(n.List)
+		case *ast.CaseClause:
+			c.checkWaitOrder(n.Body)
+		case *ast.CommClause:
+			c.checkWaitOrder(n.Body)
+		case *ast.CallExpr:
+			if method := c.groupMethod(n); method == "Go" || method == "TryGo" {
+				c.checkClosure(n, contexts, stack)
+			}
+		}
+		return true
+	})
+}
+
+func (c *errgroupWithContextMisuseChecker) collectContext(assign *ast.AssignStmt, contexts map[types.Object]groupContext) {
+	if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || c.errgroupFunc(call) != "WithContext" {
+		return
+	}
+	derived, ok := assign.Lhs[1].(*ast.Ident)
+	if !ok {
+		return
+	}
+	parent := c.objectOf(call.Args[0])
+	group := c.objectOf(assign.Lhs[0])
+	if parent == nil || group == nil || parent == c.ctx.TypesInfo.ObjectOf(derived) {
+		// The parent context is replaced by the derived one.
+		return
+	}
+	contexts[group] = groupContext{parent: parent, derived: derived}
+}
+
+func (c *errgroupWithContextMisuseChecker) checkClosure(call *ast.CallExpr, contexts map[types.Object]groupContext, stack []ast.Node) {
+	if len(call.Args) != 1 {
+		return
+	}
+	fn, ok := call.Args[0].(*ast.FuncLit)
+	if !ok {
+		return
+	}
+	method := call.Fun.(*ast.SelectorExpr)
+	gctx, hasContext := contexts[c.objectOf(method.X)]
+	var vars map[types.Object]bool
+	if c.checkLoopVars {
+		vars = loopVars(c.ctx.TypesInfo, stack)
+	}
+
+	reported := make(map[types.Object]bool)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.ctx.TypesInfo.Uses[id]
+		if obj == nil || reported[obj] {
+			return true
+		}
+		switch {
+		case hasContext && obj == gctx.parent:
+			reported[obj] = true
+			if gctx.derived.Name == "_" {
+				c.ctx.Warn(id, "%s closure uses %s instead of the context derived by errgroup.WithContext, so it isn't canceled when another goroutine fails",
+					method, id)
+			} else {
+				c.ctx.Warn(id, "%s closure uses %s instead of the derived context %s, so it isn't canceled when another goroutine fails",
+					method, id, gctx.derived)
+			}
+		case vars[obj]:
+			reported[obj] = true
+			c.ctx.Warn(id, "%s closure captures loop variable %s that is reused by all iterations; copy it with %s := %s before the call",
+				method, id, id, id)
+		}
+		return true
+	})
+}
+
+// checkWaitOrder reports Go calls that follow the Wait call
+// of the same group in the statements list.
+func (c *errgroupWithContextMisuseChecker) checkWaitOrder(list []ast.Stmt) {
+	// waits maps the groups to their Wait calls that were seen so far.
+	waits := make(map[types.Object]*ast.CallExpr)
+	// late maps the Go calls to Wait calls which they follow,
+	// the Go call is not reported if there is another Wait after it.
+	late := make(map[*ast.CallExpr]*ast.CallExpr)
+	var order []*ast.CallExpr
+	for _, stmt := range list {
+		if call := c.waitCall(stmt); call != nil {
+			group := c.objectOf(call.Fun.(*ast.SelectorExpr).X)
+			for goCall := range late {
+				if c.objectOf(goCall.Fun.(*ast.SelectorExpr).X) == group {
+					delete(late, goCall)
+				}
+			}
+			waits[group] = call
+			continue
+		}
+		if len(waits) == 0 {
+			continue
+		}
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.CallExpr:
+				if method := c.groupMethod(n); method == "Go" || method == "TryGo" {
+					if wait := waits[c.objectOf(n.Fun.(*ast.SelectorExpr).X)]; wait != nil {
+						late[n] = wait
+						order = append(order, n)
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	for _, call := range order {
+		wait := late[call]
+		if wait == nil {
+			continue
+		}
+		sel := call.Fun.(*ast.SelectorExpr)
+		c.ctx.Warn(call, "%s is called after %s.Wait at line %d returned, so this goroutine is never awaited",
+			sel, sel.X, c.ctx.FileSet.Position(wait.Pos()).Line)
+	}
+}
+
+// waitCall returns the group Wait call that is executed by stmt
+// unconditionally, or nil if there is no such call.
+func (c *errgroupWithContextMisuseChecker) waitCall(stmt ast.Stmt) *ast.CallExpr {
+	var x ast.Expr
+	switch stmt := stmt.(type) {
+	case *ast.ExprStmt:
+		x = stmt.X
+	case *ast.AssignStmt:
+		if len(stmt.Rhs) == 1 {
+			x = stmt.Rhs[0]
+		}
+	case *ast.ReturnStmt:
+		if len(stmt.Results) == 1 {
+			x = stmt.Results[0]
+		}
+	case *ast.IfStmt:
+		if init, ok := stmt.Init.(*ast.AssignStmt); ok && len(init.Rhs) == 1 {
+			x = init.Rhs[0]
+		}
+	}
+	call, ok := astutil.Unparen(x).(*ast.CallExpr)
+	if !ok || c.groupMethod(call) != "Wait" {
+		return nil
+	}
+	return call
+}
+
+// groupMethod returns the name of the errgroup.Group method called by call.
+func (c *errgroupWithContextMisuseChecker) groupMethod(call *ast.CallExpr) string {
+	fn := c.errgroupObj(call)
+	if fn == nil {
+		return ""
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return ""
+	}
+	if ptr, ok := recv.Type().(*types.Pointer); ok {
+		if named, ok := ptr.Elem().(*types.Named); ok && named.Obj().Name() == "Group" {
+			return fn.Name()
+		}
+	}
+	return ""
+}
+
+// errgroupFunc returns the name of the errgroup package-level function called by call.
+func (c *errgroupWithContextMisuseChecker) errgroupFunc(call *ast.CallExpr) string {
+	fn := c.errgroupObj(call)
+	if fn == nil || fn.Type().(*types.Signature).Recv() != nil {
+		return ""
+	}
+	return fn.Name()
+}
+
+// errgroupObj returns the errgroup function or method called by call.
+//
+// The package is matched by its name, so golang.org/x/sync/errgroup
+// vendored copies and API-compatible forks are recognized as well.
+func (c *errgroupWithContextMisuseChecker) errgroupObj(call *ast.CallExpr) *types.Func {
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Name() != "errgroup" {
+		return nil
+	}
+	return fn
+}
+
+func (c *errgroupWithContextMisuseChecker) objectOf(x ast.Expr) types.Object {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.Ident:
+		return c.ctx.TypesInfo.ObjectOf(x)
+	case *ast.SelectorExpr:
+		return c.ctx.TypesInfo.ObjectOf(x.Sel)
+	default:
+		return nil
+	}
+}
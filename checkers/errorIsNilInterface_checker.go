@@ -0,0 +1,157 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorIsNilInterface"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects nil concrete error pointers returned as error"
+	info.Before = `
+func validate() error {
+	var err *ValidationError
+	return err
+}`
+	info.After = `
+func validate() error {
+	return nil
+}`
+	info.Note = "Only the variables that are never assigned a non-nil value are reported"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&errorIsNilInterfaceChecker{ctx: ctx}), nil
+	})
+}
+
+type errorIsNilInterfaceChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	body *ast.BlockStmt
+
+	// alwaysNil caches the isAlwaysNil results for the current function.
+	alwaysNil map[*types.Var]bool
+}
+
+func (c *errorIsNilInterfaceChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	c.body = decl.Body
+	c.alwaysNil = make(map[*types.Var]bool)
+	c.checkFunc(fn.Type().(*types.Signature), decl.Body)
+}
+
+func (c *errorIsNilInterfaceChecker) checkFunc(sig *types.Signature, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			if sig, ok := c.ctx.TypeOf(n).(*types.Signature); ok {
+				c.checkFunc(sig, n.Body)
+			}
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) != sig.Results().Len() {
+				return true
+			}
+			for i, result := range n.Results {
+				if isErrorType(sig.Results().At(i).Type()) {
+					c.checkResult(result)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *errorIsNilInterfaceChecker) checkResult(result ast.Expr) {
+	id, ok := astutil.Unparen(result).(*ast.Ident)
+	if !ok {
+		return
+	}
+	v, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var)
+	if !ok || v.Pos() < c.body.Pos() || v.Pos() >= c.body.End() {
+		// Parameters and package-level variables can hold anything.
+		return
+	}
+	if _, ok := v.Type().(*types.Pointer); !ok {
+		return
+	}
+	errorIface := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	if !types.Implements(v.Type(), errorIface) || !c.isAlwaysNil(v) {
+		return
+	}
+	c.ctx.Warn(result, "%s is a nil %s, returning it as error makes a non-nil error interface that holds a nil pointer; return nil instead",
+		id, types.TypeString(v.Type(), types.RelativeTo(c.ctx.Pkg)))
+}
+
+// isAlwaysNil reports whether v is declared and assigned only with nil values
+// and its address is never taken.
+func (c *errorIsNilInterfaceChecker) isAlwaysNil(v *types.Var) bool {
+	if result, ok := c.alwaysNil[v]; ok {
+		return result
+	}
+	declared := false
+	alwaysNil := true
+	is := func(x ast.Expr) bool {
+		id, ok := astutil.Unparen(x).(*ast.Ident)
+		return ok && c.ctx.TypesInfo.ObjectOf(id) == v
+	}
+	assigned := func(lhs, rhs []ast.Expr) {
+		for i, x := range lhs {
+			if !is(x) {
+				continue
+			}
+			if len(lhs) != len(rhs) || !isNil(c.ctx.TypesInfo, rhs[i]) {
+				alwaysNil = false
+			}
+		}
+	}
+	ast.Inspect(c.body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if c.ctx.TypesInfo.Defs[name] != v {
+					continue
+				}
+				declared = true
+				if len(n.Values) != 0 && (len(n.Values) != len(n.Names) || !isNil(c.ctx.TypesInfo, n.Values[i])) {
+					alwaysNil = false
+				}
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, x := range n.Lhs {
+					if id, ok := x.(*ast.Ident); ok && c.ctx.TypesInfo.Defs[id] == v {
+						declared = true
+					}
+				}
+			}
+			assigned(n.Lhs, n.Rhs)
+		case *ast.RangeStmt:
+			if (n.Key != nil && is(n.Key)) || (n.Value != nil && is(n.Value)) {
+				alwaysNil = false
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND && is(n.X) {
+				alwaysNil = false
+			}
+		}
+		return alwaysNil
+	})
+	result := declared && alwaysNil
+	c.alwaysNil[v] = result
+	return result
+}
@@ -0,0 +1,165 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorNilCheckStyle"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Summary = "Detects redundant error checks before the final return"
+	info.Before = `
+err := validate(x)
+if err != nil {
+	return err
+}
+return nil`
+	info.After = `
+err := validate(x)
+return err`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&errorNilCheckStyleChecker{ctx: ctx}), nil
+	})
+}
+
+type errorNilCheckStyleChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	file *ast.File
+}
+
+func (c *errorNilCheckStyleChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *errorNilCheckStyleChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || len(decl.Body.List) < 2 {
+		return
+	}
+	if c.hasNamedResults(decl) && c.hasDefer(decl.Body) {
+		// Deferred calls can modify the results after the check.
+		return
+	}
+
+	list := decl.Body.List
+	ifStmt, ok := list[len(list)-2].(*ast.IfStmt)
+	if !ok || ifStmt.Else != nil || len(ifStmt.Body.List) != 1 {
+		return
+	}
+	errReturn, ok := ifStmt.Body.List[0].(*ast.ReturnStmt)
+	if !ok {
+		return
+	}
+	finalReturn, ok := list[len(list)-1].(*ast.ReturnStmt)
+	if !ok || len(finalReturn.Results) != len(errReturn.Results) {
+		return
+	}
+	errVar := c.errNotNilVar(ifStmt.Cond)
+	if errVar == nil || !c.sameExceptErr(errReturn, finalReturn, errVar) {
+		return
+	}
+	if hasCommentsBetween(c.file, ifStmt.Pos(), finalReturn.End()) {
+		return
+	}
+
+	if ifStmt.Init == nil {
+		c.ctx.WarnFixable(ifStmt, linter.QuickFix{
+			From:        ifStmt.Pos(),
+			To:          finalReturn.End(),
+			Replacement: []byte(astfmt.Sprint(errReturn)),
+		}, "redundant error check before the final return; use `%s`", errReturn)
+		return
+	}
+
+	// The error is declared in the if statement init, like err := f().
+	assign, ok := ifStmt.Init.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	if astcast.ToIdent(assign.Lhs[0]).Name != errVar.Name || len(errReturn.Results) != 1 {
+		return
+	}
+	c.ctx.Warn(ifStmt, "redundant error check of %s before the final return; use `return %s`",
+		assign.Rhs[0], assign.Rhs[0])
+}
+
+// errNotNilVar returns err from the `err != nil` condition.
+func (c *errorNilCheckStyleChecker) errNotNilVar(cond ast.Expr) *ast.Ident {
+	bin, ok := cond.(*ast.BinaryExpr)
+	if !ok || bin.Op != token.NEQ || !isNil(c.ctx.TypesInfo, bin.Y) {
+		return nil
+	}
+	id, ok := bin.X.(*ast.Ident)
+	if !ok || !isErrorType(c.ctx.TypeOf(id)) {
+		return nil
+	}
+	return id
+}
+
+// sameExceptErr reports whether the returns only differ by the error result,
+// that is nil in the final return, while other results are the same zero values.
+func (c *errorNilCheckStyleChecker) sameExceptErr(errReturn, finalReturn *ast.ReturnStmt, errVar *ast.Ident) bool {
+	errFound := false
+	for i, x := range errReturn.Results {
+		y := finalReturn.Results[i]
+		if id, ok := x.(*ast.Ident); ok && id.Name == errVar.Name {
+			if errFound || !isNil(c.ctx.TypesInfo, y) {
+				return false
+			}
+			errFound = true
+			continue
+		}
+		if !astequal.Expr(x, y) || !c.isZeroValue(x) {
+			return false
+		}
+	}
+	return errFound
+}
+
+func (c *errorNilCheckStyleChecker) isZeroValue(x ast.Expr) bool {
+	if isNil(c.ctx.TypesInfo, x) {
+		return true
+	}
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		switch x.Value {
+		case "0", `""`, "``", "0.0":
+			return true
+		}
+	case *ast.Ident:
+		return x.Name == "false" && c.ctx.TypesInfo.ObjectOf(x) != nil && c.ctx.TypesInfo.ObjectOf(x).Pkg() == nil
+	case *ast.CompositeLit:
+		return len(x.Elts) == 0
+	}
+	return false
+}
+
+func (c *errorNilCheckStyleChecker) hasNamedResults(decl *ast.FuncDecl) bool {
+	results := decl.Type.Results
+	return results != nil && len(results.List) != 0 && len(results.List[0].Names) != 0
+}
+
+func (c *errorNilCheckStyleChecker) hasDefer(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.DeferStmt:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,138 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorStringStyle"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Summary = "Detects capitalized or punctuated error strings"
+	info.Before = `errors.New("Connection refused.")`
+	info.After = `errors.New("connection refused")`
+	info.Note = "Strings that start with an identifier from the package or an acronym are permitted."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &errorStringStyleChecker{ctx: ctx}, nil
+	})
+}
+
+type errorStringStyleChecker struct {
+	ctx *linter.CheckerContext
+
+	// idents is a set of identifier names defined inside the package.
+	idents map[string]bool
+}
+
+func (c *errorStringStyleChecker) WalkPackage(files []*ast.File) {
+	c.idents = make(map[string]bool)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.Defs[id] != nil {
+				c.idents[id.Name] = true
+			}
+			return true
+		})
+	}
+}
+
+func (c *errorStringStyleChecker) WalkFile(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			c.checkCall(call)
+		}
+		return true
+	})
+}
+
+func (c *errorStringStyleChecker) checkCall(call *ast.CallExpr) {
+	isFormat := false
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "errors.New":
+	case "fmt.Errorf":
+		isFormat = true
+	default:
+		return
+	}
+	if len(call.Args) == 0 {
+		return
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+
+	prefix := s
+	if isFormat {
+		if i := strings.IndexByte(s, '%'); i != -1 {
+			prefix = s[:i]
+		}
+	}
+	if c.isCapitalized(prefix) {
+		c.warnCapitalized(lit, prefix)
+	}
+
+	switch {
+	case strings.Contains(s, ". "):
+		c.ctx.Warn(lit, "error string %s has several sentences; use a single lowercase phrase", lit)
+	case strings.HasSuffix(s, "..."):
+		// Ellipsis is not a sentence end.
+	case strings.HasSuffix(s, "\n"):
+		c.ctx.Warn(lit, "error string %s should not end with a newline", lit)
+	case strings.HasSuffix(s, ".") || strings.HasSuffix(s, "!") || strings.HasSuffix(s, ":"):
+		c.ctx.Warn(lit, "error string %s should not end with punctuation", lit)
+	}
+}
+
+func (c *errorStringStyleChecker) warnCapitalized(lit *ast.BasicLit, s string) {
+	const format = "error string %s should not be capitalized"
+	// Only simple ASCII cases are fixed, so the replacement
+	// is a single byte right after the opening quote.
+	if s[0] >= 'A' && s[0] <= 'Z' && lit.Value[1] == s[0] {
+		c.ctx.WarnFixable(lit, linter.QuickFix{
+			From:        lit.Pos() + 1,
+			To:          lit.Pos() + 2,
+			Replacement: []byte{s[0] - 'A' + 'a'},
+		}, format, lit)
+		return
+	}
+	c.ctx.Warn(lit, format, lit)
+}
+
+// isCapitalized reports whether s starts with an upper case
+// letter that is not a part of acronym or known identifier.
+func (c *errorStringStyleChecker) isCapitalized(s string) bool {
+	first, _ := utf8.DecodeRuneInString(s)
+	if !unicode.IsUpper(first) {
+		return false
+	}
+	word := s
+	if i := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i != -1 {
+		word = s[:i]
+	}
+	if c.idents[word] {
+		return false
+	}
+	// Acronyms like HTTP and mixed case names like GetUser
+	// have more than one upper case letter.
+	for _, r := range word[utf8.RuneLen(first):] {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
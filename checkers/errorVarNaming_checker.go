@@ -0,0 +1,128 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorVarNaming"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkVars": {
+			Value: true,
+			Usage: "whether to check sentinel error variable names",
+		},
+		"checkTypes": {
+			Value: true,
+			Usage: "whether to check error type names",
+		},
+	}
+	info.Summary = "Detects sentinel errors and error types that don't follow the naming conventions"
+	info.Before = `
+var NotFound = errors.New("not found")
+type Parse struct{}
+func (Parse) Error() string { return "parse failed" }`
+	info.After = `
+var ErrNotFound = errors.New("not found")
+type ParseError struct{}
+func (ParseError) Error() string { return "parse failed" }`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &errorVarNamingChecker{
+			ctx:        ctx,
+			checkVars:  info.Params.Bool("checkVars"),
+			checkTypes: info.Params.Bool("checkTypes"),
+		}, nil
+	})
+}
+
+type errorVarNamingChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkVars  bool
+	checkTypes bool
+}
+
+func (c *errorVarNamingChecker) WalkFile(f *ast.File) {
+	if strings.HasSuffix(c.ctx.Filename, "_test.go") {
+		return
+	}
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.ValueSpec:
+				if c.checkVars {
+					c.checkValueSpec(spec)
+				}
+			case *ast.TypeSpec:
+				if c.checkTypes {
+					c.checkTypeSpec(spec)
+				}
+			}
+		}
+	}
+}
+
+func (c *errorVarNamingChecker) checkValueSpec(spec *ast.ValueSpec) {
+	if len(spec.Names) != len(spec.Values) {
+		return
+	}
+	for i, name := range spec.Names {
+		if name.Name == "_" || !isErrorType(c.ctx.TypeOf(name)) {
+			continue
+		}
+		switch calledFuncName(c.ctx.TypesInfo, astcast.ToCallExpr(spec.Values[i])) {
+		case "errors.New", "fmt.Errorf":
+		default:
+			continue
+		}
+		if strings.HasPrefix(name.Name, "Err") || strings.HasPrefix(name.Name, "err") {
+			continue
+		}
+		prefix := "err"
+		if ast.IsExported(name.Name) {
+			prefix = "Err"
+		}
+		c.ctx.Warn(name, "sentinel error %s should be named %s", name, prefix+capitalize(name.Name))
+	}
+}
+
+func (c *errorVarNamingChecker) checkTypeSpec(spec *ast.TypeSpec) {
+	if strings.HasSuffix(spec.Name.Name, "Error") || spec.Name.Name == "_" {
+		return
+	}
+	obj, ok := c.ctx.TypesInfo.Defs[spec.Name].(*types.TypeName)
+	if !ok || obj.IsAlias() {
+		return
+	}
+	typ := obj.Type()
+	// Interfaces that embed error describe behavior, not errors.
+	if types.IsInterface(typ) {
+		return
+	}
+	errorIface := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	if !types.Implements(typ, errorIface) && !types.Implements(types.NewPointer(typ), errorIface) {
+		return
+	}
+	c.ctx.Warn(spec.Name, "error type %s should be named %s", spec.Name, spec.Name.Name+"Error")
+}
+
+// capitalize returns s with the first letter in upper case.
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,234 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "errorsJoinSuggest"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"skipUserMessages": {
+			Value: true,
+			Usage: "whether to skip joined error strings that are printed or written to an HTTP response",
+		},
+	}
+	info.Summary = "Detects manual multi-error concatenation that can be replaced with errors.Join"
+	info.Before = `
+var msgs []string
+for _, task := range tasks {
+	if err := task.Run(); err != nil {
+		msgs = append(msgs, err.Error())
+	}
+}
+return errors.New(strings.Join(msgs, "\n"))`
+	info.After = `
+var errs []error
+for _, task := range tasks {
+	if err := task.Run(); err != nil {
+		errs = append(errs, err)
+	}
+}
+return errors.Join(errs...)`
+	info.Note = "Only reported for Go 1.20 and later"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&errorsJoinSuggestChecker{
+			ctx:              ctx,
+			skipUserMessages: info.Params.Bool("skipUserMessages"),
+		}), nil
+	})
+}
+
+type errorsJoinSuggestChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	skipUserMessages bool
+}
+
+// errorsJoinFormatRE matches the formats that only separate
+// the printed values, like "%v; %v" or "%s\n%s".
+var errorsJoinFormatRE = regexp.MustCompile(`^%[vs](?:[^%]+%[vs])+$`)
+
+func (c *errorsJoinSuggestChecker) EnterFile(f *ast.File) bool {
+	// errors.Join was added in Go 1.20.
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 20})
+}
+
+func (c *errorsJoinSuggestChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	// appends maps the []string variables to the first
+	// assignment that appends an error text to them.
+	appends := make(map[types.Object]*ast.AssignStmt)
+	// guarded is a set of appends that are done under `if err != nil`.
+	guarded := make(map[*ast.AssignStmt]bool)
+	// joined is a set of []string variables passed to strings.Join.
+	joined := make(map[types.Object]bool)
+	// printed is a set of []string variables that are used
+	// to build a message for a user.
+	printed := make(map[types.Object]bool)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			c.checkErrorf(n)
+		case *ast.IfStmt:
+			cond := astcast.ToBinaryExpr(n.Cond)
+			if cond.Op != token.NEQ || !isNil(c.ctx.TypesInfo, cond.Y) {
+				break
+			}
+			for _, stmt := range n.Body.List {
+				if assign, ok := stmt.(*ast.AssignStmt); ok {
+					guarded[assign] = true
+				}
+			}
+		case *ast.AssignStmt:
+			if obj := c.errorTextsAppend(n); obj != nil && appends[obj] == nil {
+				appends[obj] = n
+			}
+		}
+		return true
+	})
+	if len(appends) == 0 {
+		return
+	}
+
+	c.collectJoins(decl.Body, joined, printed)
+	for obj, assign := range appends {
+		if c.skipUserMessages && printed[obj] {
+			continue
+		}
+		if joined[obj] || guarded[assign] {
+			c.ctx.Warn(assign, "%s collects errors as strings; use []error and errors.Join to keep them wrapped",
+				obj.Name())
+		}
+	}
+}
+
+func (c *errorsJoinSuggestChecker) checkErrorf(call *ast.CallExpr) {
+	if calledFuncName(c.ctx.TypesInfo, call) != "fmt.Errorf" || len(call.Args) < 3 {
+		return
+	}
+	format := c.ctx.TypesInfo.Types[call.Args[0]].Value
+	if format == nil || format.Kind() != constant.String {
+		return
+	}
+	s := constant.StringVal(format)
+	args := call.Args[1:]
+	if !errorsJoinFormatRE.MatchString(s) || strings.Count(s, "%") != len(args) {
+		return
+	}
+	for _, arg := range args {
+		if !isErrorType(c.ctx.TypeOf(arg)) {
+			return
+		}
+	}
+	argList := make([]string, len(args))
+	for i, arg := range args {
+		argList[i] = astfmt.Sprint(arg)
+	}
+	c.ctx.Warn(call, "use errors.Join(%s) to combine errors and keep them wrapped",
+		strings.Join(argList, ", "))
+}
+
+// errorTextsAppend returns the []string variable for the
+// `xs = append(xs, err.Error())` assignments.
+func (c *errorsJoinSuggestChecker) errorTextsAppend(assign *ast.AssignStmt) types.Object {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	if !isBuiltinCall(c.ctx.TypesInfo, call, "append") || len(call.Args) < 2 || call.Ellipsis != token.NoPos {
+		return nil
+	}
+	lhs := astcast.ToIdent(assign.Lhs[0])
+	dst := astcast.ToIdent(call.Args[0])
+	obj := c.ctx.TypesInfo.ObjectOf(lhs)
+	if obj == nil || obj != c.ctx.TypesInfo.ObjectOf(dst) {
+		return nil
+	}
+	if slice, ok := obj.Type().Underlying().(*types.Slice); !ok || !types.Identical(slice.Elem(), types.Typ[types.String]) {
+		return nil
+	}
+	for _, arg := range call.Args[1:] {
+		errorCall := astcast.ToCallExpr(arg)
+		sel, ok := errorCall.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Error" || len(errorCall.Args) != 0 || !isErrorType(c.ctx.TypeOf(sel.X)) {
+			return nil
+		}
+	}
+	return obj
+}
+
+// collectJoins finds strings.Join calls for the []string variables.
+// If the joined string is then printed, variable is also marked as printed.
+func (c *errorsJoinSuggestChecker) collectJoins(body *ast.BlockStmt, joined, printed map[types.Object]bool) {
+	// messages maps the joined messages to the []string variables.
+	messages := make(map[types.Object]types.Object)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				break
+			}
+			if obj := c.joinedVar(n.Rhs[0]); obj != nil {
+				if msg := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(n.Lhs[0])); msg != nil {
+					messages[msg] = obj
+				}
+			}
+		case *ast.CallExpr:
+			if obj := c.joinedVar(n); obj != nil {
+				joined[obj] = true
+			}
+			if !c.isUserOutput(n) {
+				break
+			}
+			for _, arg := range n.Args {
+				// Joined message can be passed directly or
+				// via the intermediate string variable.
+				if obj := c.joinedVar(arg); obj != nil {
+					printed[obj] = true
+				}
+				if obj := messages[c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(arg))]; obj != nil {
+					printed[obj] = true
+				}
+			}
+		}
+		return true
+	})
+}
+
+// joinedVar returns the []string variable for the strings.Join(xs, sep) call.
+func (c *errorsJoinSuggestChecker) joinedVar(x ast.Expr) types.Object {
+	call := astcast.ToCallExpr(x)
+	if calledFuncName(c.ctx.TypesInfo, call) != "strings.Join" || len(call.Args) != 2 {
+		return nil
+	}
+	return c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(call.Args[0]))
+}
+
+// isUserOutput reports whether call prints its arguments for a user.
+func (c *errorsJoinSuggestChecker) isUserOutput(call *ast.CallExpr) bool {
+	switch name := calledFuncName(c.ctx.TypesInfo, call); name {
+	case "net/http.Error", "io.WriteString":
+		return true
+	default:
+		return strings.HasPrefix(name, "fmt.Print") || strings.HasPrefix(name, "fmt.Fprint")
+	}
+}
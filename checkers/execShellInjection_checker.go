@@ -0,0 +1,160 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"path"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "execShellInjection"
+	info.Tags = []string{"security", "experimental"}
+	info.Params = linter.CheckerParams{
+		"extraShells": {
+			Value: "",
+			Usage: "comma-separated list of additional shells or wrappers, like /usr/bin/env",
+		},
+	}
+	info.Summary = "Detects shell commands that are built from non-constant strings"
+	info.Before = `exec.Command("sh", "-c", "ls "+dir)`
+	info.After = `exec.Command("ls", dir)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		shells := map[string]bool{
+			"sh":      true,
+			"bash":    true,
+			"zsh":     true,
+			"dash":    true,
+			"ksh":     true,
+			"cmd":     true,
+			"cmd.exe": true,
+		}
+		for _, shell := range strings.Split(info.Params.String("extraShells"), ",") {
+			if shell = strings.TrimSpace(shell); shell != "" {
+				shells[shell] = true
+			}
+		}
+		return astwalk.WalkerForExpr(&execShellInjectionChecker{
+			ctx:    ctx,
+			shells: shells,
+		}), nil
+	})
+}
+
+type execShellInjectionChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	shells map[string]bool
+}
+
+func (c *execShellInjectionChecker) VisitExpr(expr ast.Expr) {
+	call := astcast.ToCallExpr(expr)
+	var args []ast.Expr
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "os/exec.Command":
+		args = call.Args
+	case "os/exec.CommandContext":
+		if len(call.Args) > 1 {
+			args = call.Args[1:]
+		}
+	}
+	if len(args) == 0 || call.Ellipsis != token.NoPos {
+		return
+	}
+
+	program, ok := c.constString(args[0])
+	if !ok {
+		if len(args) == 1 && c.isSpaceJoined(args[0]) {
+			c.ctx.Warn(args[0], "%s is passed as a program name, but it's not split into arguments; pass every argument separately",
+				args[0])
+		}
+		return
+	}
+	if len(args) < 3 || (!c.shells[program] && !c.shells[path.Base(program)]) {
+		return
+	}
+	for i, arg := range args[1 : len(args)-1] {
+		flag, ok := c.constString(arg)
+		if !ok {
+			return
+		}
+		if flag == "-c" || strings.EqualFold(flag, "/c") {
+			cmd := args[i+2]
+			if c.isDynamicString(cmd) {
+				c.ctx.Warn(cmd, "shell command built from %s is prone to shell injection; run the program with exec.Command and separate arguments instead",
+					cmd)
+			}
+			return
+		}
+	}
+}
+
+func (c *execShellInjectionChecker) constString(x ast.Expr) (string, bool) {
+	cv := c.ctx.TypesInfo.Types[x].Value
+	if cv == nil || cv.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(cv), true
+}
+
+// isDynamicString reports whether x is a string concatenation or
+// fmt.Sprintf call that has non-constant parts.
+func (c *execShellInjectionChecker) isDynamicString(x ast.Expr) bool {
+	if _, ok := c.constString(x); ok {
+		return false
+	}
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.BinaryExpr:
+		return x.Op == token.ADD
+	case *ast.CallExpr:
+		if calledFuncName(c.ctx.TypesInfo, x) != "fmt.Sprintf" {
+			return false
+		}
+		for _, arg := range x.Args[1:] {
+			if c.ctx.TypesInfo.Types[arg].Value == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSpaceJoined reports whether x is a non-constant string that
+// is built by joining the parts with spaces.
+func (c *execShellInjectionChecker) isSpaceJoined(x ast.Expr) bool {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD {
+			return false
+		}
+		found := false
+		ast.Inspect(x, func(n ast.Node) bool {
+			if e, ok := n.(ast.Expr); ok {
+				if s, ok := c.constString(e); ok && strings.Contains(s, " ") {
+					found = true
+				}
+			}
+			return !found
+		})
+		return found
+	case *ast.CallExpr:
+		switch calledFuncName(c.ctx.TypesInfo, x) {
+		case "strings.Join":
+			sep, ok := c.constString(x.Args[1])
+			return ok && sep == " "
+		case "fmt.Sprintf":
+			format, ok := c.constString(x.Args[0])
+			return ok && strings.Contains(format, " ") && c.isDynamicString(x)
+		}
+	}
+	return false
+}
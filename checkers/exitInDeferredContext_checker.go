@@ -0,0 +1,123 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "exitInDeferredContext"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects process and test termination calls from deferred functions and test goroutines"
+	info.Before = `
+defer func() {
+	if err := recover(); err != nil {
+		log.Fatal(err)
+	}
+}()
+go func() {
+	if err := work(); err != nil {
+		t.Fatal(err)
+	}
+}()`
+	info.After = `
+defer func() {
+	if err := recover(); err != nil {
+		log.Print(err)
+	}
+}()
+go func() {
+	if err := work(); err != nil {
+		t.Error(err)
+	}
+}()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&exitInDeferredContextChecker{ctx: ctx}), nil
+	})
+}
+
+type exitInDeferredContextChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *exitInDeferredContextChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.DeferStmt:
+			if fn, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.walkLocalCalls(fn.Body, func(call *ast.CallExpr) {
+					if c.isExitCall(call) || c.isTestStopCall(call) {
+						c.warnDeferred(call)
+					}
+				})
+			}
+		case *ast.GoStmt:
+			if fn, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.walkLocalCalls(fn.Body, func(call *ast.CallExpr) {
+					if c.isTestStopCall(call) {
+						c.warnGoroutine(call)
+					}
+				})
+			}
+		}
+		return true
+	})
+}
+
+// walkLocalCalls calls visit for every call inside body, including the calls
+// under if, switch and for statements that may be executed by body.
+// Nested function literals are not traversed.
+func (c *exitInDeferredContextChecker) walkLocalCalls(body *ast.BlockStmt, visit func(*ast.CallExpr)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			visit(n)
+		}
+		return true
+	})
+}
+
+func (c *exitInDeferredContextChecker) isExitCall(call *ast.CallExpr) bool {
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "os.Exit", "log.Fatal", "log.Fatalf", "log.Fatalln",
+		"(*log.Logger).Fatal", "(*log.Logger).Fatalf", "(*log.Logger).Fatalln":
+		return true
+	default:
+		return false
+	}
+}
+
+// isTestStopCall reports whether call is one of the testing methods
+// that stop the test by calling runtime.Goexit.
+func (c *exitInDeferredContextChecker) isTestStopCall(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	switch sel.Sel.Name {
+	case "Fatal", "Fatalf", "FailNow", "Skip", "Skipf", "SkipNow":
+	default:
+		return false
+	}
+	switch c.ctx.TypeOf(sel.X).String() {
+	case "*testing.T", "*testing.B", "testing.TB":
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *exitInDeferredContextChecker) warnDeferred(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s inside a deferred function prevents other deferred calls and recover from running", cause.Fun)
+}
+
+func (c *exitInDeferredContextChecker) warnGoroutine(cause *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s must be called from the goroutine running the test, not from a goroutine it spawned", cause.Fun)
+}
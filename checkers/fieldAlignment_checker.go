@@ -0,0 +1,248 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "fieldAlignment"
+	info.Tags = []string{"performance", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"minWastedBytes": {
+			Value: 16,
+			Usage: "report structs that waste at least that many bytes on padding",
+		},
+		"minFields": {
+			Value: 4,
+			Usage: "report structs that have at least that many fields",
+		},
+		"skipGenerated": {
+			Value: true,
+			Usage: "whether to skip files with a 'Code generated ... DO NOT EDIT.' comment",
+		},
+		"skipCgo": {
+			Value: true,
+			Usage: "whether to skip files that import \"C\"",
+		},
+		"skipEncoding": {
+			Value: true,
+			Usage: "whether to skip structs that are passed to encoding/binary or unsafe.Offsetof in the same file",
+		},
+	}
+	info.Summary = "Detects structs that can be made smaller by reordering fields"
+	info.Before = `
+type record struct {
+	a bool
+	b int64
+	c bool
+	d int64
+	e bool
+}`
+	info.After = `
+type record struct {
+	b int64
+	d int64
+	a bool
+	c bool
+	e bool
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &fieldAlignmentChecker{
+			ctx:            ctx,
+			minWastedBytes: int64(info.Params.Int("minWastedBytes")),
+			minFields:      info.Params.Int("minFields"),
+			skipGenerated:  info.Params.Bool("skipGenerated"),
+			skipCgo:        info.Params.Bool("skipCgo"),
+			skipEncoding:   info.Params.Bool("skipEncoding"),
+		}, nil
+	})
+}
+
+type fieldAlignmentChecker struct {
+	ctx *linter.CheckerContext
+
+	minWastedBytes int64
+	minFields      int
+	skipGenerated  bool
+	skipCgo        bool
+	skipEncoding   bool
+
+	// encoded is a set of types which layout may be significant.
+	encoded map[types.Type]bool
+}
+
+func (c *fieldAlignmentChecker) WalkFile(f *ast.File) {
+	if c.skipGenerated && isGeneratedFile(f) {
+		return
+	}
+	if c.skipCgo {
+		for _, imp := range f.Imports {
+			if imp.Path.Value == `"C"` {
+				return
+			}
+		}
+	}
+	c.encoded = make(map[types.Type]bool)
+	if c.skipEncoding {
+		c.collectEncoded(f)
+	}
+
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.TypeSpec)
+			if typeExpr, ok := spec.Type.(*ast.StructType); ok {
+				c.checkStruct(spec, typeExpr)
+			}
+		}
+	}
+}
+
+func (c *fieldAlignmentChecker) collectEncoded(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var arg ast.Expr
+		switch calledFuncName(c.ctx.TypesInfo, call) {
+		case "encoding/binary.Read", "encoding/binary.Write":
+			if len(call.Args) == 3 {
+				arg = call.Args[2]
+			}
+		case "encoding/binary.Size":
+			if len(call.Args) == 1 {
+				arg = call.Args[0]
+			}
+		}
+		if arg == nil {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && len(call.Args) == 1 {
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "unsafe" && sel.Sel.Name == "Offsetof" {
+					if field, ok := call.Args[0].(*ast.SelectorExpr); ok {
+						arg = field.X
+					}
+				}
+			}
+		}
+		if arg == nil {
+			return true
+		}
+		typ := c.ctx.TypeOf(arg)
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		c.encoded[typ] = true
+		return true
+	})
+}
+
+func (c *fieldAlignmentChecker) checkStruct(spec *ast.TypeSpec, typeExpr *ast.StructType) {
+	obj := c.ctx.TypesInfo.ObjectOf(spec.Name)
+	if obj == nil || c.encoded[obj.Type()] {
+		return
+	}
+	typ, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok || typ.NumFields() < c.minFields {
+		return
+	}
+	fields := make([]*types.Var, typ.NumFields())
+	for i := range fields {
+		fields[i] = typ.Field(i)
+		if fields[i].Type() == types.Typ[types.Invalid] || sizeDependsOnTypeParam(fields[i].Type()) {
+			return
+		}
+	}
+
+	optimal := c.optimalOrder(fields)
+	size := c.structSize(fields)
+	optimalSize := c.structSize(optimal)
+	if size-optimalSize < c.minWastedBytes {
+		return
+	}
+
+	if c.hasFieldGroups(typeExpr) {
+		c.ctx.Warn(spec.Name, "struct %s is %d bytes, could be %d bytes with fields reordered",
+			spec.Name, size, optimalSize)
+		return
+	}
+	names := make([]string, len(optimal))
+	for i, field := range optimal {
+		names[i] = field.Name()
+	}
+	c.ctx.Warn(spec.Name, "struct %s is %d bytes, could be %d bytes with fields reordered as: %s",
+		spec.Name, size, optimalSize, strings.Join(names, ", "))
+}
+
+// structSize returns the size of the struct with the given fields layout,
+// including the trailing padding that is added by the gc compiler.
+func (c *fieldAlignmentChecker) structSize(fields []*types.Var) int64 {
+	if len(fields) == 0 {
+		return 0
+	}
+	sizes := c.ctx.SizesInfo
+	offsets := sizes.Offsetsof(fields)
+	last := len(fields) - 1
+	size := offsets[last] + sizes.Sizeof(fields[last].Type())
+	if sizes.Sizeof(fields[last].Type()) == 0 {
+		// Trailing zero-sized field can't point past the struct.
+		size++
+	}
+	align := sizes.Alignof(types.NewStruct(fields, nil))
+	return (size + align - 1) / align * align
+}
+
+// optimalOrder returns fields sorted in the order that minimizes the padding.
+func (c *fieldAlignmentChecker) optimalOrder(fields []*types.Var) []*types.Var {
+	optimal := make([]*types.Var, len(fields))
+	copy(optimal, fields)
+	sizes := c.ctx.SizesInfo
+	sort.SliceStable(optimal, func(i, j int) bool {
+		x := optimal[i].Type()
+		y := optimal[j].Type()
+		// Zero-sized fields go first: when there is a trailing zero-sized
+		// field, the compiler adds padding to avoid pointing past the struct.
+		xZero := sizes.Sizeof(x) == 0
+		yZero := sizes.Sizeof(y) == 0
+		if xZero != yZero {
+			return xZero
+		}
+		if xAlign, yAlign := sizes.Alignof(x), sizes.Alignof(y); xAlign != yAlign {
+			return xAlign > yAlign
+		}
+		return sizes.Sizeof(x) > sizes.Sizeof(y)
+	})
+	return optimal
+}
+
+// hasFieldGroups reports whether struct fields are separated
+// into the groups with blank lines.
+// Fields inside such structs are usually ordered intentionally.
+func (c *fieldAlignmentChecker) hasFieldGroups(typeExpr *ast.StructType) bool {
+	fset := c.ctx.FileSet
+	prevLine := fset.Position(typeExpr.Fields.Opening).Line
+	for _, field := range typeExpr.Fields.List {
+		start := field.Pos()
+		if field.Doc != nil {
+			start = field.Doc.Pos()
+		}
+		if fset.Position(start).Line-prevLine > 1 {
+			return true
+		}
+		prevLine = fset.Position(field.End()).Line
+		if field.Comment != nil {
+			prevLine = fset.Position(field.Comment.End()).Line
+		}
+	}
+	return false
+}
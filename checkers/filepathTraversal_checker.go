@@ -0,0 +1,214 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "filepathTraversal"
+	info.Tags = []string{"security", "experimental"}
+	info.Summary = "Detects file paths that are built from HTTP request values without a containment check"
+	info.Before = `
+name := r.URL.Query().Get("file")
+f, err := os.Open(filepath.Join(baseDir, name))`
+	info.After = `
+name := r.URL.Query().Get("file")
+if !filepath.IsLocal(name) {
+	http.Error(w, "bad file name", http.StatusBadRequest)
+	return
+}
+f, err := os.Open(filepath.Join(baseDir, name))`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&filepathTraversalChecker{ctx: ctx}), nil
+	})
+}
+
+// requestInputs is a set of http.Request fields and methods
+// that return values controlled by a client.
+var requestInputs = map[string]bool{
+	"URL":           true,
+	"Form":          true,
+	"PostForm":      true,
+	"MultipartForm": true,
+	"Header":        true,
+	"FormValue":     true,
+	"PostFormValue": true,
+	"PathValue":     true,
+	"Cookie":        true,
+	"Cookies":       true,
+}
+
+// pathSinks maps the functions that accept file paths
+// to their path argument index.
+// Join functions are handled separately.
+var pathSinks = map[string]int{
+	"os.Open":             0,
+	"os.OpenFile":         0,
+	"os.Create":           0,
+	"os.ReadFile":         0,
+	"os.WriteFile":        0,
+	"os.Remove":           0,
+	"os.RemoveAll":        0,
+	"os.Mkdir":            0,
+	"os.MkdirAll":         0,
+	"os.Stat":             0,
+	"io/ioutil.ReadFile":  0,
+	"io/ioutil.WriteFile": 0,
+	"net/http.ServeFile":  2,
+}
+
+type filepathTraversalChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// tainted is a set of variables that hold HTTP request values.
+	tainted map[types.Object]bool
+	// checked is a set of variables that are validated in the function.
+	checked map[types.Object]bool
+}
+
+func (c *filepathTraversalChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.tainted = make(map[types.Object]bool)
+	c.checked = make(map[types.Object]bool)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch calledFuncName(c.ctx.TypesInfo, call) {
+		case "strings.Contains":
+			if len(call.Args) != 2 {
+				break
+			}
+			if cv := c.ctx.TypesInfo.Types[call.Args[1]].Value; cv == nil || cv.ExactString() != `".."` {
+				break
+			}
+			fallthrough
+		case "path/filepath.IsLocal", "path/filepath.Rel":
+			for _, arg := range call.Args {
+				if id, ok := arg.(*ast.Ident); ok {
+					c.checked[c.ctx.TypesInfo.ObjectOf(id)] = true
+				}
+			}
+		}
+		return true
+	})
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			c.walkAssign(n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			c.walkAssign(lhs, n.Values)
+		case *ast.CallExpr:
+			c.checkCall(n)
+		}
+		return true
+	})
+}
+
+func (c *filepathTraversalChecker) walkAssign(lhs, rhs []ast.Expr) {
+	for i, x := range lhs {
+		var value ast.Expr
+		switch {
+		case len(lhs) == len(rhs):
+			value = rhs[i]
+		case len(rhs) == 1:
+			value = rhs[0]
+		default:
+			return
+		}
+		id, ok := x.(*ast.Ident)
+		if !ok || !c.isTainted(value) {
+			continue
+		}
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			c.tainted[obj] = true
+		}
+	}
+}
+
+func (c *filepathTraversalChecker) checkCall(call *ast.CallExpr) {
+	name := calledFuncName(c.ctx.TypesInfo, call)
+	switch name {
+	case "path/filepath.Join", "path.Join":
+		for _, arg := range call.Args {
+			if c.isTainted(arg) {
+				c.warn(call, arg)
+				return
+			}
+		}
+	default:
+		i, ok := pathSinks[name]
+		if ok && i < len(call.Args) && c.isTainted(call.Args[i]) {
+			c.warn(call, call.Args[i])
+		}
+	}
+}
+
+// isTainted reports whether x contains a value from the HTTP request.
+func (c *filepathTraversalChecker) isTainted(x ast.Expr) bool {
+	found := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			switch calledFuncName(c.ctx.TypesInfo, n) {
+			case "path/filepath.Join", "path.Join":
+				// Reported at the Join call itself.
+				return false
+			case "path/filepath.Base", "path.Base":
+				// Directory part is removed.
+				return false
+			}
+		case *ast.SelectorExpr:
+			if requestInputs[n.Sel.Name] && c.isRequest(c.ctx.TypeOf(n.X)) {
+				found = true
+			}
+		case *ast.Ident:
+			obj := c.ctx.TypesInfo.ObjectOf(n)
+			if c.tainted[obj] && !c.checked[obj] {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *filepathTraversalChecker) isRequest(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "net/http" && named.Obj().Name() == "Request"
+}
+
+func (c *filepathTraversalChecker) warn(call *ast.CallExpr, arg ast.Expr) {
+	v := c.ctx.GoVersion
+	if v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 20}) {
+		c.ctx.Warn(call, "%s uses %s from the HTTP request that may escape the base directory; validate it with filepath.IsLocal",
+			call.Fun, arg)
+		return
+	}
+	c.ctx.Warn(call, "%s uses %s from the HTTP request that may escape the base directory; check that the cleaned path stays inside it",
+		call.Fun, arg)
+}
@@ -0,0 +1,173 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "filepathWalkToWalkDir"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects filepath.Walk calls that can use the more efficient filepath.WalkDir"
+	info.Before = `
+filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+	if info.IsDir() {
+		return nil
+	}
+	return visit(path)
+})`
+	info.After = `
+filepath.WalkDir(root, func(path string, info os.DirEntry, err error) error {
+	if info.IsDir() {
+		return nil
+	}
+	return visit(path)
+})`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&filepathWalkToWalkDirChecker{ctx: ctx}), nil
+	})
+}
+
+type filepathWalkToWalkDirChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *filepathWalkToWalkDirChecker) EnterFile(f *ast.File) bool {
+	// filepath.WalkDir was added in Go 1.16.
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 16})
+}
+
+func (c *filepathWalkToWalkDirChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return
+	}
+	if calledFuncName(c.ctx.TypesInfo, call) != "path/filepath.Walk" {
+		return
+	}
+
+	const format = "%s calls os.Lstat for every visited file; use %sDir instead"
+	lit, ok := call.Args[1].(*ast.FuncLit)
+	if !ok {
+		c.ctx.Warn(call, format, call.Fun, call.Fun)
+		return
+	}
+	infoField, infoObj := c.fileInfoParam(lit)
+	if infoField == nil {
+		c.ctx.Warn(call, format, call.Fun, call.Fun)
+		return
+	}
+
+	use, ok := c.findInfoUse(lit.Body, infoObj)
+	if !ok {
+		if use != nil {
+			c.ctx.Warn(call, "%s calls os.Lstat for every visited file; use %sDir and call d.Info() to get %s",
+				call.Fun, call.Fun, use)
+		} else {
+			c.ctx.Warn(call, format, call.Fun, call.Fun)
+		}
+		return
+	}
+
+	c.ctx.WarnFixable(call, linter.QuickFix{
+		From:        call.Pos(),
+		To:          lit.Type.Params.End(),
+		Replacement: []byte(c.suggestHeader(call, lit, infoField)),
+	}, format, call.Fun, call.Fun)
+}
+
+// fileInfoParam returns the walk function os.FileInfo parameter
+// field along with its object, which is nil for unnamed parameters.
+func (c *filepathWalkToWalkDirChecker) fileInfoParam(lit *ast.FuncLit) (*ast.Field, types.Object) {
+	params := lit.Type.Params.List
+	// The FileInfo param is declared in a separate field, so
+	// it can be replaced without touching the other params.
+	var field *ast.Field
+	for i, p := range params {
+		sel, ok := p.Type.(*ast.SelectorExpr)
+		if ok && sel.Sel.Name == "FileInfo" && len(p.Names) <= 1 && i == len(params)-2 {
+			field = p
+		}
+	}
+	if field == nil {
+		return nil, nil
+	}
+	if len(field.Names) == 0 {
+		return field, nil
+	}
+	return field, c.ctx.TypesInfo.ObjectOf(field.Names[0])
+}
+
+// findInfoUse reports whether info is only used in IsDir and Name calls.
+// If is not, the first unsupported use is returned, if it's a method call.
+func (c *filepathWalkToWalkDirChecker) findInfoUse(body *ast.BlockStmt, info types.Object) (ast.Expr, bool) {
+	if info == nil {
+		return nil, true
+	}
+	// allowed is a set of info identifiers that are used as IsDir or Name receivers.
+	allowed := make(map[*ast.Ident]bool)
+	var badUse ast.Expr
+	ok := true
+	ast.Inspect(body, func(n ast.Node) bool {
+		if !ok {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			sel, isSel := n.Fun.(*ast.SelectorExpr)
+			if !isSel {
+				return true
+			}
+			id, isIdent := sel.X.(*ast.Ident)
+			if !isIdent || c.ctx.TypesInfo.ObjectOf(id) != info {
+				return true
+			}
+			switch sel.Sel.Name {
+			case "IsDir", "Name":
+				allowed[id] = true
+			default:
+				badUse = n
+			}
+		case *ast.Ident:
+			if c.ctx.TypesInfo.ObjectOf(n) == info && !allowed[n] {
+				ok = false
+			}
+		}
+		return true
+	})
+	return badUse, ok
+}
+
+// suggestHeader returns the WalkDir call start with the rewritten
+// walk function parameters, up to the closing parenthesis.
+func (c *filepathWalkToWalkDirChecker) suggestHeader(call *ast.CallExpr, lit *ast.FuncLit, infoField *ast.Field) string {
+	params := make([]string, 0, len(lit.Type.Params.List))
+	for _, p := range lit.Type.Params.List {
+		typ := astfmt.Sprint(p.Type)
+		if p == infoField {
+			// os.FileInfo is replaced with os.DirEntry and fs.FileInfo with fs.DirEntry,
+			// so there is no need to add any imports.
+			typ = astfmt.Sprint(p.Type.(*ast.SelectorExpr).X) + ".DirEntry"
+		}
+		names := make([]string, len(p.Names))
+		for i, name := range p.Names {
+			names[i] = name.Name
+		}
+		if len(names) == 0 {
+			params = append(params, typ)
+		} else {
+			params = append(params, strings.Join(names, ", ")+" "+typ)
+		}
+	}
+	return astfmt.Sprint(call.Fun) + "Dir(" + astfmt.Sprint(call.Args[0]) +
+		", func(" + strings.Join(params, ", ") + ")"
+}
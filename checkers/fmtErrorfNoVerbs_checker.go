@@ -0,0 +1,150 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "fmtErrorfNoVerbs"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects fmt formatting calls that have no formatting directives"
+	info.Before = `
+err := fmt.Errorf("connection closed")
+fmt.Println(fmt.Sprintf("%d items", n))`
+	info.After = `
+err := errors.New("connection closed")
+fmt.Printf("%d items\n", n)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&fmtErrorfNoVerbsChecker{ctx: ctx}), nil
+	})
+}
+
+type fmtErrorfNoVerbsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// importsErrors is true if the current file imports errors package.
+	importsErrors bool
+	// reported is a set of Sprintf calls that are already reported
+	// as a part of the enclosing Println call.
+	reported map[*ast.CallExpr]bool
+}
+
+func (c *fmtErrorfNoVerbsChecker) EnterFile(f *ast.File) bool {
+	c.importsErrors = false
+	for _, imp := range f.Imports {
+		if imp.Path.Value == `"errors"` && imp.Name == nil {
+			c.importsErrors = true
+		}
+	}
+	c.reported = make(map[*ast.CallExpr]bool)
+	return true
+}
+
+func (c *fmtErrorfNoVerbsChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || c.reported[call] {
+		return
+	}
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "fmt.Errorf":
+		c.checkErrorf(call)
+	case "fmt.Sprintf":
+		c.checkSprintf(call)
+	case "fmt.Println":
+		c.checkPrintln(call)
+	}
+}
+
+func (c *fmtErrorfNoVerbsChecker) checkErrorf(call *ast.CallExpr) {
+	s, ok := c.noVerbsFormat(call)
+	if !ok {
+		return
+	}
+	const format = "fmt.Errorf call has no formatting directives; use errors.New"
+	if !c.importsErrors {
+		c.ctx.Warn(call, format)
+		return
+	}
+	c.ctx.WarnFixable(call, linter.QuickFix{
+		From:        call.Pos(),
+		To:          call.End(),
+		Replacement: []byte("errors.New(" + c.formatArg(call.Args[0], s) + ")"),
+	}, format)
+}
+
+func (c *fmtErrorfNoVerbsChecker) checkSprintf(call *ast.CallExpr) {
+	s, ok := c.noVerbsFormat(call)
+	if !ok {
+		return
+	}
+	c.ctx.WarnFixable(call, linter.QuickFix{
+		From:        call.Pos(),
+		To:          call.End(),
+		Replacement: []byte(c.formatArg(call.Args[0], s)),
+	}, "fmt.Sprintf call has no formatting directives; use the string directly")
+}
+
+func (c *fmtErrorfNoVerbsChecker) checkPrintln(call *ast.CallExpr) {
+	if len(call.Args) != 1 {
+		return
+	}
+	sprintf := astcast.ToCallExpr(call.Args[0])
+	if calledFuncName(c.ctx.TypesInfo, sprintf) != "fmt.Sprintf" || len(sprintf.Args) == 0 || sprintf.Ellipsis != token.NoPos {
+		return
+	}
+	c.reported[sprintf] = true
+
+	const format = "fmt.Println(fmt.Sprintf(...)) can be replaced with fmt.Printf with a trailing newline"
+	lit, ok := sprintf.Args[0].(*ast.BasicLit)
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !ok || lit.Kind != token.STRING || lit.Value[0] != '"' || !isSel {
+		c.ctx.Warn(call, format)
+		return
+	}
+	args := []string{lit.Value[:len(lit.Value)-1] + `\n"`}
+	for _, arg := range sprintf.Args[1:] {
+		args = append(args, astfmt.Sprint(arg))
+	}
+	c.ctx.WarnFixable(call, linter.QuickFix{
+		From:        call.Pos(),
+		To:          call.End(),
+		Replacement: []byte(astfmt.Sprint(sel.X) + ".Printf(" + strings.Join(args, ", ") + ")"),
+	}, format)
+}
+
+// noVerbsFormat returns the format string of the single-argument call
+// if it's a constant without formatting directives.
+func (c *fmtErrorfNoVerbsChecker) noVerbsFormat(call *ast.CallExpr) (string, bool) {
+	if len(call.Args) != 1 {
+		return "", false
+	}
+	tv := c.ctx.TypesInfo.Types[call.Args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	s := constant.StringVal(tv.Value)
+	if strings.Contains(strings.ReplaceAll(s, "%%", ""), "%") {
+		return "", false
+	}
+	return s, true
+}
+
+// formatArg returns the format argument source with %% escapes removed.
+func (c *fmtErrorfNoVerbsChecker) formatArg(arg ast.Expr, s string) string {
+	if !strings.Contains(s, "%%") {
+		return astfmt.Sprint(arg)
+	}
+	return strconv.Quote(strings.ReplaceAll(s, "%%", "%"))
+}
@@ -0,0 +1,175 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/checkers/internal/lintutil"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "fmtVerbTypeMismatch"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkErrorV": {
+			Value: false,
+			Usage: "whether to report %v used with errors in the output printed to stdout and stderr",
+		},
+	}
+	info.Summary = "Detects printf verbs applied to the values they don't format as intended"
+	info.Before = `fmt.Printf("request %s failed", req)`
+	info.After = `fmt.Printf("request %s failed", req.URL)`
+	info.Note = "Complements the vet printf check with the struct values and named string types"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&fmtVerbTypeMismatchChecker{
+			ctx:         ctx,
+			checkErrorV: info.Params.Bool("checkErrorV"),
+		}), nil
+	})
+}
+
+type fmtVerbTypeMismatchChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkErrorV bool
+}
+
+// printfFuncs maps the printf-like functions to their format argument indexes.
+var printfFuncs = map[string]int{
+	"fmt.Printf":               0,
+	"fmt.Sprintf":              0,
+	"fmt.Errorf":               0,
+	"fmt.Fprintf":              1,
+	"log.Printf":               0,
+	"log.Fatalf":               0,
+	"log.Panicf":               0,
+	"(*log.Logger).Printf":     0,
+	"(*log.Logger).Fatalf":     0,
+	"(*log.Logger).Panicf":     0,
+	"(*testing.common).Logf":   0,
+	"(*testing.common).Errorf": 0,
+	"(*testing.common).Fatalf": 0,
+	"(*testing.common).Skipf":  0,
+}
+
+// stringerType is the fmt.Stringer interface type.
+var stringerType = types.NewInterfaceType([]*types.Func{
+	types.NewFunc(token.NoPos, nil, "String", types.NewSignature(nil, nil,
+		types.NewTuple(types.NewVar(token.NoPos, nil, "", types.Typ[types.String])), false)),
+}, nil).Complete()
+
+func (c *fmtVerbTypeMismatchChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || call.Ellipsis != token.NoPos {
+		return
+	}
+	name := calledFuncName(c.ctx.TypesInfo, call)
+	index, ok := printfFuncs[name]
+	if !ok || index >= len(call.Args) {
+		return
+	}
+	tv := c.ctx.TypesInfo.Types[call.Args[index]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	verbs, err := lintutil.ParseFormat(constant.StringVal(tv.Value))
+	if err != nil {
+		// Malformed formats are reported by vet.
+		return
+	}
+
+	args := call.Args[index+1:]
+	for _, v := range verbs {
+		if v.Arg >= len(args) {
+			continue
+		}
+		arg := args[v.Arg]
+		typ := c.ctx.TypeOf(arg)
+		if typ == nil || c.hasMethod(typ, "Format") {
+			// Formatters handle the verbs themselves.
+			continue
+		}
+		switch v.Verb {
+		case 's':
+			c.checkString(v.Text, arg, typ)
+		case 'v':
+			if c.checkErrorV && v.Text == "%v" && c.isUserOutput(name, call) && isErrorType(typ) {
+				c.ctx.Warn(arg, "%s verb is used with error %s in the user-facing output; use %s to print the error message",
+					v.Text, arg, "%s")
+			}
+		case 'd':
+			if _, ok := typ.(*types.Named); ok && isStringType(typ.Underlying()) {
+				c.ctx.Warn(arg, "%s verb is used with %s of type %s that has string underlying type",
+					v.Text, arg, c.typeString(typ))
+			}
+		}
+	}
+}
+
+func (c *fmtVerbTypeMismatchChecker) checkString(verb string, arg ast.Expr, typ types.Type) {
+	if types.Implements(typ, stringerType) || c.implementsError(typ) {
+		return
+	}
+	elem := typ
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		elem = ptr.Elem()
+	}
+	if _, ok := elem.Underlying().(*types.Struct); !ok {
+		// Other types are either formatted as intended or reported by vet.
+		return
+	}
+	if ptr := types.NewPointer(typ); types.Implements(ptr, stringerType) || c.implementsError(ptr) {
+		c.ctx.Warn(arg, "%s verb is used with %s of type %s, but its String or Error method has a pointer receiver; pass &%s",
+			verb, arg, c.typeString(typ), arg)
+		return
+	}
+	c.ctx.Warn(arg, "%s verb is used with %s of type %s that implements neither fmt.Stringer nor error, its fields are printed instead",
+		verb, arg, c.typeString(typ))
+}
+
+// isUserOutput reports whether the call prints to stdout or stderr.
+func (c *fmtVerbTypeMismatchChecker) isUserOutput(name string, call *ast.CallExpr) bool {
+	switch name {
+	case "fmt.Printf":
+		return true
+	case "fmt.Fprintf":
+		sel, ok := call.Args[0].(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		switch qualifiedName(sel) {
+		case "os.Stdout", "os.Stderr":
+			return true
+		}
+	}
+	return false
+}
+
+func (c *fmtVerbTypeMismatchChecker) implementsError(typ types.Type) bool {
+	return types.Implements(typ, types.Universe.Lookup("error").Type().Underlying().(*types.Interface))
+}
+
+func (c *fmtVerbTypeMismatchChecker) hasMethod(typ types.Type, name string) bool {
+	if _, ok := typ.Underlying().(*types.Interface); ok {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(typ, false, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+func (c *fmtVerbTypeMismatchChecker) typeString(typ types.Type) string {
+	return types.TypeString(typ, types.RelativeTo(c.ctx.Pkg))
+}
+
+func isStringType(typ types.Type) bool {
+	basic, ok := typ.(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
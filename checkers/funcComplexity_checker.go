@@ -0,0 +1,203 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "funcComplexity"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"maxStatements": {
+			Value: 0,
+			Usage: "max number of statements in a function, 0 disables the check",
+		},
+		"maxCyclomatic": {
+			Value: 0,
+			Usage: "max cyclomatic complexity of a function, 0 disables the check",
+		},
+		"maxNesting": {
+			Value: 0,
+			Usage: "max nesting depth of the function statements, 0 disables the check",
+		},
+	}
+	info.Summary = "Detects functions that exceed the configured complexity limits"
+	info.Before = `
+// With maxNesting=2.
+func f(xs [][]int) {
+	for _, x := range xs {
+		for _, y := range x {
+			if y != 0 {
+				g(y)
+			}
+		}
+	}
+}`
+	info.After = `
+func f(xs [][]int) {
+	for _, x := range xs {
+		visitRow(x)
+	}
+}`
+	info.Note = "Composite literals don't contribute to the metrics and generated files are skipped."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&funcComplexityChecker{
+			ctx:           ctx,
+			maxStatements: info.Params.Int("maxStatements"),
+			maxCyclomatic: info.Params.Int("maxCyclomatic"),
+			maxNesting:    info.Params.Int("maxNesting"),
+		}), nil
+	})
+}
+
+type funcComplexityChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	maxStatements int
+	maxCyclomatic int
+	maxNesting    int
+}
+
+func (c *funcComplexityChecker) EnterFile(f *ast.File) bool {
+	if c.maxStatements <= 0 && c.maxCyclomatic <= 0 && c.maxNesting <= 0 {
+		return false
+	}
+	return !isGeneratedFile(f)
+}
+
+func (c *funcComplexityChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	if c.maxStatements > 0 {
+		if n := countStatements(decl.Body); n > c.maxStatements {
+			c.ctx.Warn(decl.Name, "%s has %d statements, the limit is %d", decl.Name, n, c.maxStatements)
+		}
+	}
+	if c.maxCyclomatic > 0 {
+		if n := cyclomaticComplexity(decl.Body); n > c.maxCyclomatic {
+			c.ctx.Warn(decl.Name, "%s has cyclomatic complexity %d, the limit is %d", decl.Name, n, c.maxCyclomatic)
+		}
+	}
+	if c.maxNesting > 0 {
+		if n := nestingDepth(decl.Body.List); n > c.maxNesting {
+			c.ctx.Warn(decl.Name, "%s has nesting depth %d, the limit is %d", decl.Name, n, c.maxNesting)
+		}
+	}
+}
+
+// countStatements returns the number of statements inside body.
+// Blocks, labels and declarations are not counted.
+func countStatements(body *ast.BlockStmt) int {
+	n := 0
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.CompositeLit:
+			return false
+		case *ast.BlockStmt, *ast.LabeledStmt, *ast.DeclStmt, *ast.EmptyStmt,
+			*ast.CaseClause, *ast.CommClause:
+			return true
+		case ast.Stmt:
+			n++
+		}
+		return true
+	})
+	return n
+}
+
+// cyclomaticComplexity returns 1 plus the number of branch points inside body.
+func cyclomaticComplexity(body *ast.BlockStmt) int {
+	n := 1
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CompositeLit:
+			return false
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			n++
+		case *ast.CaseClause:
+			if node.List != nil {
+				n++
+			}
+		case *ast.CommClause:
+			if node.Comm != nil {
+				n++
+			}
+		case *ast.BinaryExpr:
+			if node.Op == token.LAND || node.Op == token.LOR {
+				n++
+			}
+		}
+		return true
+	})
+	return n
+}
+
+// nestingDepth returns the max nesting level of the control flow statements in list.
+// The else-if chains are considered to be at the same level.
+func nestingDepth(list []ast.Stmt) int {
+	depth := 0
+	for _, stmt := range list {
+		if d := stmtNestingDepth(stmt); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func stmtNestingDepth(stmt ast.Stmt) int {
+	switch stmt := stmt.(type) {
+	case *ast.IfStmt:
+		depth := nestingDepth(stmt.Body.List)
+		switch e := stmt.Else.(type) {
+		case *ast.IfStmt:
+			if d := stmtNestingDepth(e) - 1; d > depth {
+				depth = d
+			}
+		case *ast.BlockStmt:
+			if d := nestingDepth(e.List); d > depth {
+				depth = d
+			}
+		}
+		return depth + 1
+	case *ast.ForStmt:
+		return nestingDepth(stmt.Body.List) + 1
+	case *ast.RangeStmt:
+		return nestingDepth(stmt.Body.List) + 1
+	case *ast.SwitchStmt:
+		return clausesNestingDepth(stmt.Body) + 1
+	case *ast.TypeSwitchStmt:
+		return clausesNestingDepth(stmt.Body) + 1
+	case *ast.SelectStmt:
+		return clausesNestingDepth(stmt.Body) + 1
+	case *ast.BlockStmt:
+		return nestingDepth(stmt.List)
+	case *ast.LabeledStmt:
+		return stmtNestingDepth(stmt.Stmt)
+	default:
+		return 0
+	}
+}
+
+func clausesNestingDepth(body *ast.BlockStmt) int {
+	depth := 0
+	for _, clause := range body.List {
+		var d int
+		switch clause := clause.(type) {
+		case *ast.CaseClause:
+			d = nestingDepth(clause.Body)
+		case *ast.CommClause:
+			d = nestingDepth(clause.Body)
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
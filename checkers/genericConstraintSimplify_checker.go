@@ -0,0 +1,313 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "genericConstraintSimplify"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects type parameter constraints that contain redundant elements or duplicate a named constraint"
+	info.Before = `
+func Max[T interface{ Signed | ~int8 | ~uint }](xs ...T) T
+func Sort[T interface{ ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string }](xs []T)`
+	info.After = `
+func Max[T interface{ Signed | ~uint }](xs ...T) T
+func Sort[T cmp.Ordered](xs []T)`
+	info.Note = "Overlapping non-interface terms are compile errors, so redundant terms come from the constraint interfaces in the union"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &genericConstraintSimplifyChecker{ctx: ctx}, nil
+	})
+}
+
+type genericConstraintSimplifyChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// namedConstraint is a named constraint that is defined by the union of terms.
+type namedConstraint struct {
+	name  string
+	terms string
+	// cmp reports whether the constraint is also available in the cmp package.
+	cmp bool
+}
+
+const (
+	signedTerms   = "~int|~int16|~int32|~int64|~int8"
+	unsignedTerms = "~uint|~uint16|~uint32|~uint64|~uint8|~uintptr"
+	floatTerms    = "~float32|~float64"
+)
+
+// namedConstraints lists the golang.org/x/exp/constraints constraints.
+// Terms are sorted to make them comparable.
+var namedConstraints = []namedConstraint{
+	{name: "Signed", terms: signedTerms},
+	{name: "Unsigned", terms: unsignedTerms},
+	{name: "Integer", terms: sortedTerms(signedTerms + "|" + unsignedTerms)},
+	{name: "Float", terms: floatTerms},
+	{name: "Complex", terms: "~complex128|~complex64"},
+	{name: "Ordered", terms: sortedTerms(signedTerms + "|" + unsignedTerms + "|" + floatTerms + "|~string"), cmp: true},
+}
+
+func sortedTerms(terms string) string {
+	list := strings.Split(terms, "|")
+	sort.Strings(list)
+	return strings.Join(list, "|")
+}
+
+func (c *genericConstraintSimplifyChecker) WalkFile(f *ast.File) {
+	switch c.ctx.Pkg.Path() {
+	case "cmp", "golang.org/x/exp/constraints":
+		// These packages define the suggested constraints.
+		return
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncType:
+			c.checkTypeParams(n.TypeParams)
+		case *ast.TypeSpec:
+			c.checkTypeParams(n.TypeParams)
+			if iface, ok := n.Type.(*ast.InterfaceType); ok {
+				c.checkInterface(n.Name.Name, iface, false)
+			}
+		}
+		return true
+	})
+}
+
+func (c *genericConstraintSimplifyChecker) checkTypeParams(params *ast.FieldList) {
+	if params == nil {
+		return
+	}
+	for _, field := range params.List {
+		switch x := field.Type.(type) {
+		case *ast.InterfaceType:
+			name := "the " + field.Names[0].Name + " constraint"
+			c.checkInterface(name, x, true)
+		case *ast.BinaryExpr:
+			c.checkUnion(x, x, true)
+		}
+	}
+}
+
+// checkInterface checks the interface elements; inline reports whether
+// iface is written in the type parameters list.
+func (c *genericConstraintSimplifyChecker) checkInterface(name string, iface *ast.InterfaceType, inline bool) {
+	embedded := make([]ast.Expr, 0, len(iface.Methods.List))
+	for _, elem := range iface.Methods.List {
+		if len(elem.Names) == 0 {
+			embedded = append(embedded, elem.Type)
+		}
+	}
+
+	for i, elem := range embedded {
+		if c.isAny(elem) {
+			if len(iface.Methods.List) == 1 {
+				c.ctx.Warn(iface, "%s can be simplified to any", name)
+			} else {
+				c.ctx.Warn(elem, "embedded any in %s is redundant", name)
+			}
+			continue
+		}
+		for _, prev := range embedded[:i] {
+			if astequal.Expr(prev, elem) {
+				c.ctx.Warn(elem, "%s embeds %s more than once", name, elem)
+				break
+			}
+		}
+		if union, ok := elem.(*ast.BinaryExpr); ok {
+			var whole ast.Node = union
+			if len(iface.Methods.List) == 1 {
+				whole = iface
+			}
+			c.checkUnion(whole, union, inline)
+		}
+	}
+}
+
+// checkUnion checks the union of terms; whole is the constraint
+// that is replaced by the named constraint suggestion.
+//
+// Named constraints are only suggested for the inline constraints,
+// the type declarations may define them on purpose.
+func (c *genericConstraintSimplifyChecker) checkUnion(whole ast.Node, union *ast.BinaryExpr, inline bool) {
+	terms := c.unionTerms(union)
+	if terms == nil {
+		return
+	}
+	typeSets := make([]map[string]string, len(terms))
+	for i, term := range terms {
+		typeSets[i] = c.termTypeSet(term)
+		if typeSets[i] == nil {
+			return
+		}
+	}
+
+	// Terms are removed one by one starting from the last one,
+	// so the union of the kept terms never changes.
+	kept := make([]bool, len(terms))
+	for i := range kept {
+		kept[i] = true
+	}
+	var redundant ast.Expr
+	for i := len(terms) - 1; i >= 0; i-- {
+		term := terms[i]
+		others := make(map[string]string)
+		for j := range terms {
+			if j != i && kept[j] {
+				for key, under := range typeSets[j] {
+					others[key] = under
+				}
+			}
+		}
+		if c.covers(others, typeSets[i]) {
+			kept[i] = false
+			if redundant == nil {
+				redundant = term
+			}
+		}
+	}
+	if redundant != nil {
+		unique := make([]string, 0, len(terms))
+		for i, term := range terms {
+			if kept[i] {
+				unique = append(unique, astfmt.Sprint(term))
+			}
+		}
+		c.ctx.WarnFixable(union, linter.QuickFix{
+			From:        union.Pos(),
+			To:          union.End(),
+			Replacement: []byte(strings.Join(unique, " | ")),
+		}, "%s contains redundant term %s; remove it", union, redundant)
+		return
+	}
+	if !inline {
+		return
+	}
+
+	var keys []string
+	for _, typeSet := range typeSets {
+		for key := range typeSet {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	joined := strings.Join(keys, "|")
+	for _, named := range namedConstraints {
+		if named.terms != joined {
+			continue
+		}
+		name := "constraints." + named.name
+		v := c.ctx.GoVersion
+		if named.cmp && (v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})) {
+			name = "cmp." + named.name
+		}
+		c.ctx.Warn(whole, "%s is the same type set as %s; use %s instead", whole, name, name)
+		return
+	}
+}
+
+// covers reports whether every type of typeSet is included by the set.
+func (c *genericConstraintSimplifyChecker) covers(set, typeSet map[string]string) bool {
+	for key, under := range typeSet {
+		if _, ok := set[key]; ok {
+			continue
+		}
+		if _, ok := set[under]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// unionTerms returns the terms of the union, or nil if x contains unexpected expressions.
+func (c *genericConstraintSimplifyChecker) unionTerms(x ast.Expr) []ast.Expr {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.BinaryExpr:
+		if x.Op != token.OR {
+			return nil
+		}
+		lhs := c.unionTerms(x.X)
+		rhs := c.unionTerms(x.Y)
+		if lhs == nil || rhs == nil {
+			return nil
+		}
+		return append(lhs, rhs...)
+	default:
+		return []ast.Expr{x}
+	}
+}
+
+// termTypeSet returns the set of the types described by the union term,
+// or nil if these types can't be enumerated.
+//
+// Types are written as ~T for the underlying type terms and as T otherwise,
+// they are mapped to the ~T form of their underlying types.
+func (c *genericConstraintSimplifyChecker) termTypeSet(term ast.Expr) map[string]string {
+	typeSet := make(map[string]string)
+	if u, ok := term.(*ast.UnaryExpr); ok && u.Op == token.TILDE {
+		typ := c.ctx.TypesInfo.TypeOf(u.X)
+		if typ == nil {
+			return nil
+		}
+		c.addTilde(typeSet, typ)
+		return typeSet
+	}
+	typ := c.ctx.TypesInfo.TypeOf(term)
+	if typ == nil {
+		return nil
+	}
+	if !c.collectTypeSet(typeSet, typ) {
+		return nil
+	}
+	return typeSet
+}
+
+func (c *genericConstraintSimplifyChecker) addTilde(typeSet map[string]string, typ types.Type) {
+	key := "~" + types.TypeString(typ, nil)
+	typeSet[key] = key
+}
+
+func (c *genericConstraintSimplifyChecker) collectTypeSet(typeSet map[string]string, typ types.Type) bool {
+	iface, ok := typ.Underlying().(*types.Interface)
+	if !ok {
+		typeSet[types.TypeString(typ, nil)] = "~" + types.TypeString(typ.Underlying(), nil)
+		return true
+	}
+	// Only the interfaces that consist of a single union are enumerable,
+	// several embedded elements make an intersection.
+	if iface.NumMethods() != 0 || iface.NumEmbeddeds() != 1 {
+		return false
+	}
+	union, ok := iface.EmbeddedType(0).(*types.Union)
+	if !ok {
+		return c.collectTypeSet(typeSet, iface.EmbeddedType(0))
+	}
+	for i := 0; i < union.Len(); i++ {
+		term := union.Term(i)
+		if term.Tilde() {
+			c.addTilde(typeSet, term.Type())
+		} else if !c.collectTypeSet(typeSet, term.Type()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *genericConstraintSimplifyChecker) isAny(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && id.Name == "any" && c.ctx.TypesInfo.ObjectOf(id) == types.Universe.Lookup("any")
+}
@@ -0,0 +1,156 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "globalVarMutation"
+	info.Tags = []string{"diagnostic", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowSetters": {
+			Value: true,
+			Usage: "whether to permit writes from Set*, Register* and Init* functions",
+		},
+	}
+	info.Summary = "Detects package-level variables that are modified from exported functions"
+	info.Before = `
+var lastID int
+
+func NextID() int {
+	lastID++
+	return lastID
+}`
+	info.After = `
+type IDGen struct{ lastID int }
+
+func (g *IDGen) NextID() int {
+	g.lastID++
+	return g.lastID
+}`
+	info.Note = "Writes from functions that lock a package-level mutex are permitted."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&globalVarMutationChecker{
+			ctx:          ctx,
+			allowSetters: info.Params.Bool("allowSetters"),
+		}), nil
+	})
+}
+
+type globalVarMutationChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	allowSetters bool
+}
+
+func (c *globalVarMutationChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || !decl.Name.IsExported() {
+		return
+	}
+	if c.allowSetters {
+		for _, prefix := range []string{"Set", "Register", "Init"} {
+			if strings.HasPrefix(decl.Name.Name, prefix) {
+				return
+			}
+		}
+	}
+
+	type write struct {
+		node ast.Node
+		obj  *types.Var
+	}
+	var writes []write
+	locked := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if v := c.globalRoot(lhs); v != nil {
+					writes = append(writes, write{node: lhs, obj: v})
+				}
+			}
+		case *ast.IncDecStmt:
+			if v := c.globalRoot(n.X); v != nil {
+				writes = append(writes, write{node: n.X, obj: v})
+			}
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok {
+				switch sel.Sel.Name {
+				case "Lock", "RLock":
+					locked = locked || c.packageVar(sel.X) != nil
+				}
+			}
+		}
+		return true
+	})
+	if locked {
+		return
+	}
+
+	for _, w := range writes {
+		pos := c.ctx.FileSet.Position(w.obj.Pos())
+		declPos := fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+		c.ctx.Warn(w.node, "exported %s modifies package-level %s declared at %s; pass the state explicitly or guard it with a mutex",
+			decl.Name, w.obj.Name(), declPos)
+	}
+}
+
+// globalRoot returns the package-level variable that is modified by
+// the write to x, unless it's a sync primitive that guards itself.
+func (c *globalVarMutationChecker) globalRoot(x ast.Expr) *types.Var {
+	v := c.packageVar(x)
+	if v == nil || c.isSyncType(v.Type()) {
+		return nil
+	}
+	return v
+}
+
+// packageVar returns the package-level variable that is
+// the root of x, like v in v.f or v[k] expressions.
+func (c *globalVarMutationChecker) packageVar(x ast.Expr) *types.Var {
+	for {
+		switch e := astutil.Unparen(x).(type) {
+		case *ast.SelectorExpr:
+			x = e.X
+		case *ast.IndexExpr:
+			x = e.X
+		case *ast.StarExpr:
+			x = e.X
+		case *ast.Ident:
+			v, ok := c.ctx.TypesInfo.ObjectOf(e).(*types.Var)
+			if !ok || v.Pkg() != c.ctx.Pkg || v.Parent() != c.ctx.Pkg.Scope() {
+				return nil
+			}
+			return v
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *globalVarMutationChecker) isSyncType(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	switch named.Obj().Pkg().Path() {
+	case "sync", "sync/atomic":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,300 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "httpHandlerBodyClose"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects HTTP responses which bodies are never closed"
+	info.Before = `
+resp, err := http.Get(url)
+if err != nil {
+	return err
+}
+return json.NewDecoder(resp.Body).Decode(&v)`
+	info.After = `
+resp, err := http.Get(url)
+if err != nil {
+	return err
+}
+defer resp.Body.Close()
+return json.NewDecoder(resp.Body).Decode(&v)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&httpHandlerBodyCloseChecker{ctx: ctx}), nil
+	})
+}
+
+// bodyReaders is a set of functions that read from the response body,
+// but don't close it.
+var bodyReaders = map[string]bool{
+	"io.ReadAll":               true,
+	"io/ioutil.ReadAll":        true,
+	"io.Copy":                  true,
+	"io.CopyN":                 true,
+	"io.CopyBuffer":            true,
+	"io.LimitReader":           true,
+	"io.TeeReader":             true,
+	"bufio.NewReader":          true,
+	"bufio.NewScanner":         true,
+	"encoding/json.NewDecoder": true,
+	"encoding/xml.NewDecoder":  true,
+	"mime/multipart.NewReader": true,
+	"net/http.MaxBytesReader":  true,
+	"compress/gzip.NewReader":  true,
+	"(*bytes.Buffer).ReadFrom": true,
+}
+
+// httpResponse is a response variable assigned from the client call.
+type httpResponse struct {
+	assign *ast.AssignStmt
+	call   *ast.CallExpr
+	resp   types.Object
+	err    types.Object
+
+	// closes are the resp.Body.Close() calls.
+	closes []bodyClose
+	// escapes is true if the response or its body is passed elsewhere
+	// and can be closed there.
+	escapes bool
+}
+
+type bodyClose struct {
+	call     *ast.CallExpr
+	deferred bool
+}
+
+type httpHandlerBodyCloseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *httpHandlerBodyCloseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.checkBody(decl.Body)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok {
+			c.checkBody(lit.Body)
+		}
+		return true
+	})
+}
+
+// checkBody checks the responses that are received in the function body.
+// Nested function literals are checked separately.
+func (c *httpHandlerBodyCloseChecker) checkBody(body *ast.BlockStmt) {
+	var responses []*httpResponse
+	var returns []*ast.ReturnStmt
+	var ifs []*ast.IfStmt
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns = append(returns, n)
+		case *ast.IfStmt:
+			ifs = append(ifs, n)
+		case *ast.AssignStmt:
+			if resp := c.responseAssign(n); resp != nil {
+				responses = append(responses, resp)
+			}
+		}
+		return true
+	})
+
+	for _, resp := range responses {
+		if resp.resp == nil {
+			c.ctx.Warn(resp.assign, "response of %s is discarded, so its Body can't be closed", resp.call.Fun)
+			continue
+		}
+		c.collectUses(body, resp)
+		c.checkResponse(resp, returns, ifs)
+	}
+}
+
+// responseAssign returns the response info for the `resp, err := client.Do(req)`
+// assignments. Response object is nil if it's assigned to the blank identifier.
+func (c *httpHandlerBodyCloseChecker) responseAssign(assign *ast.AssignStmt) *httpResponse {
+	if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	call := astcast.ToCallExpr(assign.Rhs[0])
+	name := calledFuncName(c.ctx.TypesInfo, call)
+	if !strings.HasPrefix(name, "net/http.") && !strings.HasPrefix(name, "(*net/http.Client).") {
+		return nil
+	}
+	if !resourceOpeners[name] {
+		return nil
+	}
+	resp := &httpResponse{assign: assign, call: call}
+	if id := astcast.ToIdent(assign.Lhs[0]); id.Name != "_" {
+		resp.resp = c.ctx.TypesInfo.ObjectOf(id)
+		if resp.resp == nil {
+			return nil
+		}
+	}
+	if id := astcast.ToIdent(assign.Lhs[1]); id.Name != "_" {
+		resp.err = c.ctx.TypesInfo.ObjectOf(id)
+	}
+	return resp
+}
+
+// collectUses finds the closes and escapes of the response.
+func (c *httpHandlerBodyCloseChecker) collectUses(body *ast.BlockStmt, resp *httpResponse) {
+	var stack []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		id, ok := n.(*ast.Ident)
+		if !ok || c.ctx.TypesInfo.Uses[id] != resp.resp {
+			return true
+		}
+		parents := make([]ast.Node, 0, 3)
+		for i := len(stack) - 2; i >= 0 && len(parents) < 3; i-- {
+			parents = append(parents, stack[i])
+		}
+		c.classifyUse(resp, id, parents, stack)
+		return true
+	})
+}
+
+// classifyUse records the response use at id.
+// Parents are the closest id parents, starting from the immediate one.
+func (c *httpHandlerBodyCloseChecker) classifyUse(resp *httpResponse, id *ast.Ident, parents, stack []ast.Node) {
+	var x ast.Expr = id
+	if len(parents) != 0 {
+		if sel, ok := parents[0].(*ast.SelectorExpr); ok && sel.X == id {
+			if sel.Sel.Name != "Body" {
+				// Other fields and methods like resp.StatusCode.
+				return
+			}
+			if len(parents) == 3 {
+				if closeSel, ok := parents[1].(*ast.SelectorExpr); ok && closeSel.Sel.Name == "Close" {
+					if call, ok := parents[2].(*ast.CallExpr); ok && call.Fun == closeSel {
+						resp.closes = append(resp.closes, bodyClose{call: call, deferred: c.isDeferred(stack)})
+						return
+					}
+				}
+			}
+			x = sel
+			parents = parents[1:]
+		}
+	}
+	if len(parents) == 0 {
+		return
+	}
+
+	switch p := parents[0].(type) {
+	case *ast.CallExpr:
+		if p.Fun == x {
+			return
+		}
+		if !bodyReaders[calledFuncName(c.ctx.TypesInfo, p)] {
+			resp.escapes = true
+		}
+	case *ast.AssignStmt:
+		for _, rhs := range p.Rhs {
+			if rhs == x {
+				resp.escapes = true
+			}
+		}
+	case *ast.ReturnStmt, *ast.CompositeLit, *ast.KeyValueExpr, *ast.SendStmt, *ast.UnaryExpr, *ast.ValueSpec:
+		resp.escapes = true
+	}
+}
+
+func (c *httpHandlerBodyCloseChecker) isDeferred(stack []ast.Node) bool {
+	for _, n := range stack {
+		if _, ok := n.(*ast.DeferStmt); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *httpHandlerBodyCloseChecker) checkResponse(resp *httpResponse, returns []*ast.ReturnStmt, ifs []*ast.IfStmt) {
+	name := resp.resp.Name()
+
+	// The close requirement starts after the error check,
+	// the response is nil when the request fails.
+	start := resp.assign.End()
+	if check := c.errCheck(resp, ifs); check != nil {
+		closedEarly := false
+		for _, cl := range resp.closes {
+			if cl.call.Pos() > start && cl.call.End() < check.Pos() {
+				closedEarly = true
+				c.ctx.Warn(cl.call, "%s.Body.Close() is called before the error check, %s is nil if the request fails",
+					name, name)
+			}
+		}
+		if closedEarly {
+			return
+		}
+		start = check.End()
+	}
+	if resp.escapes {
+		return
+	}
+
+	closes := make([]bodyClose, 0, len(resp.closes))
+	for _, cl := range resp.closes {
+		if cl.call.Pos() > start {
+			if cl.deferred {
+				return
+			}
+			closes = append(closes, cl)
+		}
+	}
+	if len(closes) == 0 {
+		c.ctx.Warn(resp.assign, "%s.Body is never closed, which leaks the connection; add defer %s.Body.Close() after the error check",
+			name, name)
+		return
+	}
+	for _, ret := range returns {
+		if ret.Pos() < start {
+			continue
+		}
+		closed := false
+		for _, cl := range closes {
+			if cl.call.Pos() < ret.Pos() {
+				closed = true
+				break
+			}
+		}
+		if !closed {
+			c.ctx.Warn(ret, "%s.Body is not closed on this return path", name)
+		}
+	}
+}
+
+// errCheck returns the first `if err != nil` statement for the response error.
+func (c *httpHandlerBodyCloseChecker) errCheck(resp *httpResponse, ifs []*ast.IfStmt) *ast.IfStmt {
+	if resp.err == nil {
+		return nil
+	}
+	for _, stmt := range ifs {
+		cond := astcast.ToBinaryExpr(stmt.Cond)
+		if stmt.Cond.Pos() < resp.assign.End() || cond.Op != token.NEQ || !isNil(c.ctx.TypesInfo, cond.Y) {
+			continue
+		}
+		if c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(cond.X)) == resp.err {
+			return stmt
+		}
+	}
+	return nil
+}
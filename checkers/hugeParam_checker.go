@@ -2,9 +2,11 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
 
-	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -16,48 +18,312 @@ func init() {
 			Value: 80,
 			Usage: "size in bytes that makes the warning trigger",
 		},
+		"skipMutated": {
+			Value: false,
+			Usage: "whether to skip params that are modified inside the function",
+		},
 	}
 	info.Summary = "Detects params that incur excessive amount of copying"
 	info.Before = `func f(x [1024]int) {}`
 	info.After = `func f(x *[1024]int) {}`
+	info.Note = `
+Params that are only passed to other calls and params with type parameters sizes are not reported.
+The quick fix is suggested for unexported functions that don't modify the param
+and are only called directly with addressable arguments: the param becomes a pointer,
+its uses are dereferenced and the call sites in the package pass the argument address.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
-		return astwalk.WalkerForFuncDecl(&hugeParamChecker{
+		return &hugeParamChecker{
 			ctx:           ctx,
 			sizeThreshold: int64(info.Params.Int("sizeThreshold")),
-		}), nil
+			skipMutated:   info.Params.Bool("skipMutated"),
+		}, nil
 	})
 }
 
 type hugeParamChecker struct {
-	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
+	// refs maps the package functions to their references.
+	refs map[types.Object][]funcRef
+
 	sizeThreshold int64
+	skipMutated   bool
+}
+
+// funcRef is a reference to a function.
+type funcRef struct {
+	id *ast.Ident
+	// call is nil unless the function is called directly.
+	call *ast.CallExpr
+}
+
+// hugeParam is a param that is heavy to copy.
+type hugeParam struct {
+	field *ast.Field
+	id    *ast.Ident
+	size  int64
+
+	variadic bool
+	mutated  bool
+}
+
+func (c *hugeParamChecker) WalkPackage(files []*ast.File) {
+	c.refs = make(map[types.Object][]funcRef)
+	for _, f := range files {
+		astutil.Apply(f, func(cur *astutil.Cursor) bool {
+			id, ok := cur.Node().(*ast.Ident)
+			if !ok {
+				return true
+			}
+			fn, ok := c.ctx.TypesInfo.Uses[id].(*types.Func)
+			if !ok {
+				return true
+			}
+			ref := funcRef{id: id}
+			if call, ok := cur.Parent().(*ast.CallExpr); ok && cur.Name() == "Fun" {
+				ref.call = call
+			}
+			c.refs[fn] = append(c.refs[fn], ref)
+			return true
+		}, nil)
+	}
+}
+
+func (c *hugeParamChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok {
+			c.checkFunc(decl)
+		}
+	}
 }
 
-func (c *hugeParamChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+func (c *hugeParamChecker) checkFunc(decl *ast.FuncDecl) {
 	// TODO(quasilyte): maybe it's worthwhile to permit skipping
 	// test files for this checker?
+	var params []hugeParam
 	if decl.Recv != nil {
-		c.checkParams(decl.Recv.List)
+		params = c.collectParams(params, decl, decl.Recv.List)
+	}
+	params = c.collectParams(params, decl, decl.Type.Params.List)
+
+	const format = "%s is heavy (%d bytes); consider passing it by pointer"
+	for _, p := range params {
+		if p.variadic {
+			c.ctx.Warn(p.id, "%s elements are heavy (%d bytes); consider passing them by pointer",
+				p.id, p.size)
+			continue
+		}
+		if fix, ok := c.suggestPointer(decl, p); ok {
+			c.ctx.WarnFixable(p.id, fix, format, p.id, p.size)
+			continue
+		}
+		c.ctx.Warn(p.id, format, p.id, p.size)
 	}
-	c.checkParams(decl.Type.Params.List)
 }
 
-func (c *hugeParamChecker) checkParams(params []*ast.Field) {
+func (c *hugeParamChecker) collectParams(dst []hugeParam, decl *ast.FuncDecl, params []*ast.Field) []hugeParam {
 	for _, p := range params {
 		for _, id := range p.Names {
 			typ := c.ctx.TypeOf(id)
+			_, variadic := p.Type.(*ast.Ellipsis)
+			if slice, ok := typ.(*types.Slice); ok && variadic {
+				typ = slice.Elem()
+			}
+			if typ == nil || sizeDependsOnTypeParam(typ) {
+				// The size is unknown until instantiation.
+				continue
+			}
 			size := c.ctx.SizesInfo.Sizeof(typ)
-			if size >= c.sizeThreshold {
-				c.warn(id, size)
+			if size < c.sizeThreshold {
+				continue
 			}
+			obj := c.ctx.TypesInfo.ObjectOf(id)
+			if decl.Body != nil && obj != nil && !variadic && c.isPassedThrough(decl.Body, obj) {
+				// The copy may be elided by the compiler.
+				continue
+			}
+			mutated := decl.Body != nil && obj != nil && c.isMutated(decl.Body, obj)
+			if mutated && c.skipMutated {
+				continue
+			}
+			dst = append(dst, hugeParam{
+				field:    p,
+				id:       id,
+				size:     size,
+				variadic: variadic,
+				mutated:  mutated,
+			})
 		}
 	}
+	return dst
+}
+
+// isPassedThrough reports whether every use of obj in body is an argument of a call.
+func (c *hugeParamChecker) isPassedThrough(body *ast.BlockStmt, obj types.Object) bool {
+	uses := 0
+	passed := true
+	astutil.Apply(body, func(cur *astutil.Cursor) bool {
+		id, ok := cur.Node().(*ast.Ident)
+		if !ok || c.ctx.TypesInfo.Uses[id] != obj {
+			return true
+		}
+		uses++
+		if _, ok := cur.Parent().(*ast.CallExpr); !ok || cur.Name() != "Args" {
+			passed = false
+		}
+		return true
+	}, nil)
+	return uses != 0 && passed
 }
 
-func (c *hugeParamChecker) warn(cause *ast.Ident, size int64) {
-	c.ctx.Warn(cause, "%s is heavy (%d bytes); consider passing it by pointer",
-		cause, size)
+// isMutated reports whether obj or its parts are assigned,
+// have their address taken or have the pointer receiver methods called.
+func (c *hugeParamChecker) isMutated(body *ast.BlockStmt, obj types.Object) bool {
+	isParam := func(x ast.Expr) bool {
+		for {
+			switch e := astutil.Unparen(x).(type) {
+			case *ast.SelectorExpr:
+				x = e.X
+			case *ast.IndexExpr:
+				x = e.X
+			case *ast.Ident:
+				return c.ctx.TypesInfo.ObjectOf(e) == obj
+			default:
+				return false
+			}
+		}
+	}
+	mutated := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if isParam(lhs) {
+					mutated = true
+				}
+			}
+		case *ast.IncDecStmt:
+			mutated = mutated || isParam(n.X)
+		case *ast.RangeStmt:
+			mutated = mutated || (n.Key != nil && isParam(n.Key)) || (n.Value != nil && isParam(n.Value))
+		case *ast.UnaryExpr:
+			mutated = mutated || (n.Op == token.AND && isParam(n.X))
+		case *ast.SliceExpr:
+			// Slicing an array takes its address.
+			mutated = mutated || isParam(n.X)
+		case *ast.SelectorExpr:
+			sel := c.ctx.TypesInfo.Selections[n]
+			if sel != nil && sel.Kind() == types.MethodVal && isParam(n.X) {
+				_, ptrRecv := sel.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer)
+				mutated = mutated || ptrRecv
+			}
+		}
+		return !mutated
+	})
+	return mutated
+}
+
+// suggestPointer returns the fix that turns p into a pointer,
+// if it can be done without changing the function semantics and breaking the callers.
+func (c *hugeParamChecker) suggestPointer(decl *ast.FuncDecl, p hugeParam) (linter.QuickFix, bool) {
+	if decl.Recv != nil || decl.Name.IsExported() || decl.Body == nil || p.mutated || len(p.field.Names) != 1 {
+		return linter.QuickFix{}, false
+	}
+	argIndex := 0
+	for _, field := range decl.Type.Params.List {
+		if field == p.field {
+			break
+		}
+		argIndex += len(field.Names)
+	}
+
+	fix := linter.QuickFix{
+		From:        p.field.Type.Pos(),
+		To:          p.field.Type.Pos(),
+		Replacement: []byte("*"),
+	}
+	for _, ref := range c.refs[c.ctx.TypesInfo.ObjectOf(decl.Name)] {
+		if ref.call == nil || len(ref.call.Args) != decl.Type.Params.NumFields() {
+			return linter.QuickFix{}, false
+		}
+		if ref.id.Pos() >= decl.Pos() && ref.id.Pos() < decl.End() {
+			// Recursive calls would overlap with the param edits.
+			return linter.QuickFix{}, false
+		}
+		edit, ok := c.addrEdit(ref.call.Args[argIndex])
+		if !ok {
+			return linter.QuickFix{}, false
+		}
+		fix.AdditionalEdits = append(fix.AdditionalEdits, edit)
+	}
+
+	// Fields are accessed and arrays are indexed and ranged over
+	// through the pointer implicitly, other uses are dereferenced.
+	obj := c.ctx.TypesInfo.ObjectOf(p.id)
+	_, isArray := obj.Type().Underlying().(*types.Array)
+	astutil.Apply(decl.Body, func(cur *astutil.Cursor) bool {
+		id, ok := cur.Node().(*ast.Ident)
+		if !ok || c.ctx.TypesInfo.Uses[id] != obj {
+			return true
+		}
+		switch cur.Parent().(type) {
+		case *ast.SelectorExpr:
+			if cur.Name() == "X" {
+				return true
+			}
+		case *ast.IndexExpr, *ast.RangeStmt:
+			if cur.Name() == "X" && isArray {
+				return true
+			}
+		}
+		fix.AdditionalEdits = append(fix.AdditionalEdits, linter.TextEdit{
+			From:        id.Pos(),
+			To:          id.Pos(),
+			Replacement: []byte("*"),
+		})
+		return true
+	}, nil)
+
+	return fix, true
+}
+
+// addrEdit returns the edit that makes the call argument x pass its address.
+func (c *hugeParamChecker) addrEdit(x ast.Expr) (linter.TextEdit, bool) {
+	if star, ok := x.(*ast.StarExpr); ok {
+		// Pass the pointer itself instead of &*ptr.
+		return linter.TextEdit{From: star.Pos(), To: star.X.Pos(), Replacement: []byte{}}, true
+	}
+	if !c.isAddressable(x) {
+		return linter.TextEdit{}, false
+	}
+	return linter.TextEdit{From: x.Pos(), To: x.Pos(), Replacement: []byte("&")}, true
+}
+
+// isAddressable reports whether the address of x can be taken.
+// Composite literals are included, as &T{} is permitted too.
+func (c *hugeParamChecker) isAddressable(x ast.Expr) bool {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.Ident:
+		_, ok := c.ctx.TypesInfo.ObjectOf(x).(*types.Var)
+		return ok
+	case *ast.CompositeLit, *ast.StarExpr:
+		return true
+	case *ast.SelectorExpr:
+		sel := c.ctx.TypesInfo.Selections[x]
+		if sel == nil {
+			// A package-qualified variable.
+			_, ok := c.ctx.TypesInfo.ObjectOf(x.Sel).(*types.Var)
+			return ok
+		}
+		return sel.Kind() == types.FieldVal && (sel.Indirect() || c.isAddressable(x.X))
+	case *ast.IndexExpr:
+		switch c.ctx.TypeOf(x.X).Underlying().(type) {
+		case *types.Slice, *types.Pointer:
+			return true
+		case *types.Array:
+			return c.isAddressable(x.X)
+		}
+	}
+	return false
 }
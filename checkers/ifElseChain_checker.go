@@ -2,15 +2,26 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
 	var info linter.CheckerInfo
 	info.Name = "ifElseChain"
 	info.Tags = []string{"style"}
+	info.Params = linter.CheckerParams{
+		"minThreshold": {
+			Value: 3,
+			Usage: "min number of if-else branches that makes the warning trigger",
+		},
+	}
 	info.Summary = "Detects repeated if-else statements and suggests to replace them with switch statement"
 	info.Before = `
 if cond1 {
@@ -32,10 +43,14 @@ default:
 	info.Note = `
 Permits single else or else-if; repeated else-if or else + else-if
 will trigger suggestion to use switch statement.
-See [EffectiveGo#switch](https://golang.org/doc/effective_go.html#switch).`
+See [EffectiveGo#switch](https://golang.org/doc/effective_go.html#switch).
+The quick fix is not suggested for the chains with comments inside.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
-		return astwalk.WalkerForStmt(&ifElseChainChecker{ctx: ctx}), nil
+		return astwalk.WalkerForStmt(&ifElseChainChecker{
+			ctx:          ctx,
+			minThreshold: info.Params.Int("minThreshold"),
+		}), nil
 	})
 }
 
@@ -43,10 +58,19 @@ type ifElseChainChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
+	file *ast.File
+
+	minThreshold int
+
 	cause   *ast.IfStmt
 	visited map[*ast.IfStmt]bool
 }
 
+func (c *ifElseChainChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
 func (c *ifElseChainChecker) EnterFunc(fn *ast.FuncDecl) bool {
 	if fn.Body == nil {
 		return false
@@ -66,34 +90,208 @@ func (c *ifElseChainChecker) VisitStmt(stmt ast.Stmt) {
 }
 
 func (c *ifElseChainChecker) checkIfStmt(stmt *ast.IfStmt) {
-	const minThreshold = 2
-	if c.countIfelseLen(stmt) >= minThreshold {
-		c.warn()
+	chain := c.chainOf(stmt)
+	if chain == nil {
+		return
+	}
+	for _, e := range chain[1:] {
+		c.visited[e] = true
+	}
+	if c.countBranches(chain) >= c.minThreshold {
+		c.warn(chain)
 	}
 }
 
-func (c *ifElseChainChecker) countIfelseLen(stmt *ast.IfStmt) int {
-	count := 0
+// chainOf returns the if-else statements chain that starts with stmt.
+// If some else-if has init statement, nil is returned.
+func (c *ifElseChainChecker) chainOf(stmt *ast.IfStmt) []*ast.IfStmt {
+	chain := []*ast.IfStmt{stmt}
 	for {
-		switch e := stmt.Else.(type) {
-		case *ast.IfStmt:
-			if e.Init != nil {
-				return 0 // Give up
+		e, ok := stmt.Else.(*ast.IfStmt)
+		if !ok {
+			return chain
+		}
+		if e.Init != nil {
+			return nil // Give up
+		}
+		// Else if.
+		stmt = e
+		chain = append(chain, e)
+	}
+}
+
+func (c *ifElseChainChecker) countBranches(chain []*ast.IfStmt) int {
+	count := len(chain)
+	if _, ok := chain[len(chain)-1].Else.(*ast.BlockStmt); ok {
+		// Else branch.
+		count++
+	}
+	return count
+}
+
+// canFix reports whether the chain can be rewritten to switch
+// without changing its meaning or losing the comments.
+func (c *ifElseChainChecker) canFix(chain []*ast.IfStmt) bool {
+	head := chain[0]
+	if hasCommentsIn(c.file, head) {
+		return false
+	}
+	for _, stmt := range chain {
+		if hasUnlabeledBreak(stmt.Body) {
+			return false
+		}
+	}
+	if last, ok := chain[len(chain)-1].Else.(*ast.BlockStmt); ok && hasUnlabeledBreak(last) {
+		return false
+	}
+
+	// Nested chains get their own fixes, the fixes can't overlap.
+	nested := false
+	ast.Inspect(head, func(n ast.Node) bool {
+		if stmt, ok := n.(*ast.IfStmt); ok && !c.visited[stmt] && stmt != head {
+			chain := c.chainOf(stmt)
+			nested = nested || (chain != nil && c.countBranches(chain) >= c.minThreshold)
+		}
+		return !nested
+	})
+	return !nested && (head.Init == nil || c.canHoist(head))
+}
+
+// canHoist reports whether the stmt init can be moved before the switch.
+// It's not possible if the declared names are used elsewhere in the
+// enclosing block, they would refer to the moved declaration.
+func (c *ifElseChainChecker) canHoist(stmt *ast.IfStmt) bool {
+	path, _ := astutil.PathEnclosingInterval(c.file, stmt.Pos(), stmt.End())
+	if len(path) < 2 {
+		return false
+	}
+	var list []ast.Stmt
+	switch parent := path[1].(type) {
+	case *ast.BlockStmt:
+		list = parent.List
+	case *ast.CaseClause:
+		list = parent.Body
+	case *ast.CommClause:
+		list = parent.Body
+	default:
+		return false
+	}
+	assign, ok := stmt.Init.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE {
+		// Nothing is declared.
+		return true
+	}
+	names := make(map[string]bool, len(assign.Lhs))
+	for _, lhs := range assign.Lhs {
+		if id, ok := lhs.(*ast.Ident); ok && id.Name != "_" {
+			names[id.Name] = true
+		}
+	}
+	used := false
+	for _, other := range list {
+		if other == stmt {
+			continue
+		}
+		ast.Inspect(other, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+				used = true
+			}
+			return !used
+		})
+	}
+	return !used
+}
+
+// valueSwitch returns the compared expression and the case lists
+// if every chain condition compares the same expression against constants.
+func (c *ifElseChainChecker) valueSwitch(chain []*ast.IfStmt) (tag ast.Expr, cases [][]ast.Expr) {
+	seen := make(map[string]bool)
+	for _, stmt := range chain {
+		var list []ast.Expr
+		var collect func(x ast.Expr) bool
+		collect = func(x ast.Expr) bool {
+			bin, ok := astutil.Unparen(x).(*ast.BinaryExpr)
+			if !ok {
+				return false
+			}
+			switch bin.Op {
+			case token.LOR:
+				return collect(bin.X) && collect(bin.Y)
+			case token.EQL:
+			default:
+				return false
+			}
+			x, y := bin.X, bin.Y
+			if c.isConst(x) {
+				x, y = y, x
+			}
+			if c.isConst(x) || !c.isConst(y) {
+				return false
 			}
-			// Else if.
-			stmt = e
-			count++
-			c.visited[e] = true
-		case *ast.BlockStmt:
-			// Else branch.
-			return count + 1
-		default:
-			// No else or else if.
-			return count
+			switch {
+			case tag == nil:
+				if !typep.SideEffectFree(c.ctx.TypesInfo, x) {
+					return false
+				}
+				tag = x
+			case !astequal.Expr(tag, x):
+				return false
+			}
+			key := c.ctx.TypesInfo.Types[y].Value.ExactString()
+			if seen[key] {
+				// Duplicated switch cases don't compile.
+				return false
+			}
+			seen[key] = true
+			list = append(list, y)
+			return true
+		}
+		if !collect(stmt.Cond) {
+			return nil, nil
+		}
+		cases = append(cases, list)
+	}
+	return tag, cases
+}
+
+func (c *ifElseChainChecker) isConst(x ast.Expr) bool {
+	return c.ctx.TypesInfo.Types[x].Value != nil
+}
+
+// suggestSwitch returns the switch statement that replaces the chain.
+func (c *ifElseChainChecker) suggestSwitch(chain []*ast.IfStmt) string {
+	tag, cases := c.valueSwitch(chain)
+	clauses := make([]ast.Stmt, 0, len(chain)+1)
+	for i, stmt := range chain {
+		list := []ast.Expr{stmt.Cond}
+		if tag != nil {
+			list = cases[i]
 		}
+		clauses = append(clauses, &ast.CaseClause{List: list, Body: stmt.Body.List})
+	}
+	if last, ok := chain[len(chain)-1].Else.(*ast.BlockStmt); ok {
+		clauses = append(clauses, &ast.CaseClause{Body: last.List})
+	}
+	sw := &ast.SwitchStmt{
+		Tag:  tag,
+		Body: &ast.BlockStmt{List: clauses},
 	}
+
+	s := astfmt.Sprint(sw)
+	if init := chain[0].Init; init != nil {
+		s = astfmt.Sprint(init) + "\n" + s
+	}
+	return reindent(c.ctx.FileSet, chain[0].Pos(), s)
 }
 
-func (c *ifElseChainChecker) warn() {
-	c.ctx.Warn(c.cause, "rewrite if-else to switch statement")
+func (c *ifElseChainChecker) warn(chain []*ast.IfStmt) {
+	if !c.canFix(chain) {
+		c.ctx.Warn(c.cause, "rewrite if-else to switch statement")
+		return
+	}
+	c.ctx.WarnFixable(c.cause, linter.QuickFix{
+		From:        c.cause.Pos(),
+		To:          c.cause.End(),
+		Replacement: []byte(c.suggestSwitch(chain)),
+	}, "rewrite if-else to switch statement")
 }
@@ -0,0 +1,182 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "ignoredErrorResult"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"symbols": {
+			Value: "os.File.Close,encoding/json.Unmarshal," +
+				"strconv.Atoi,strconv.ParseInt,strconv.ParseUint,strconv.ParseFloat,strconv.ParseBool," +
+				"crypto/rand.Read,net/http.ResponseWriter.Write,database/sql.Tx.Rollback",
+			Usage: "comma-separated list of pkg.Func and pkg.Type.Method symbols which errors must be checked",
+		},
+		"flagBlankAssign": {
+			Value: false,
+			Usage: "whether to report errors that are explicitly assigned to the blank identifier",
+		},
+	}
+	info.Summary = "Detects discarded errors of the functions that must be checked"
+	info.Before = `
+json.Unmarshal(data, &cfg)`
+	info.After = `
+if err := json.Unmarshal(data, &cfg); err != nil {
+	return err
+}`
+	info.Note = "Close methods are only reported when the closed value was written to in the same function"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		symbols := make(map[string]bool)
+		for _, sym := range splitPatterns(info.Params.String("symbols")) {
+			symbols[sym] = true
+		}
+		return astwalk.WalkerForFuncDecl(&ignoredErrorResultChecker{
+			ctx:             ctx,
+			symbols:         symbols,
+			flagBlankAssign: info.Params.Bool("flagBlankAssign"),
+		}), nil
+	})
+}
+
+type ignoredErrorResultChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	symbols         map[string]bool
+	flagBlankAssign bool
+
+	// written is a set of variables that are written to in the current function.
+	written map[types.Object]bool
+}
+
+func (c *ignoredErrorResultChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.written = make(map[types.Object]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			c.markWritten(call)
+		}
+		return true
+	})
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			if call, ok := astutil.Unparen(n.X).(*ast.CallExpr); ok {
+				if sym := c.mustCheckSymbol(call); sym != "" {
+					c.ctx.Warn(call, "error result of %s is not checked", sym)
+				}
+			}
+		case *ast.AssignStmt:
+			if c.flagBlankAssign {
+				c.checkAssign(n)
+			}
+		}
+		return true
+	})
+}
+
+func (c *ignoredErrorResultChecker) checkAssign(assign *ast.AssignStmt) {
+	if len(assign.Rhs) != 1 {
+		return
+	}
+	call, ok := astutil.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	// The error is always the last result.
+	errResult, ok := assign.Lhs[len(assign.Lhs)-1].(*ast.Ident)
+	if !ok || errResult.Name != "_" {
+		return
+	}
+	if sym := c.mustCheckSymbol(call); sym != "" {
+		c.ctx.Warn(call, "error result of %s is assigned to the blank identifier", sym)
+	}
+}
+
+// markWritten records the variables that are written to by call,
+// either with a Write method or by passing them as an io.Writer.
+func (c *ignoredErrorResultChecker) markWritten(call *ast.CallExpr) {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && strings.HasPrefix(sel.Sel.Name, "Write") {
+		c.markVar(sel.X)
+	}
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return
+	}
+	for i, arg := range call.Args {
+		if i >= sig.Params().Len() {
+			break
+		}
+		typ := sig.Params().At(i).Type()
+		if !types.IsInterface(typ) {
+			continue
+		}
+		if write, _, _ := types.LookupFieldOrMethod(typ, false, nil, "Write"); write != nil {
+			c.markVar(arg)
+		}
+	}
+}
+
+func (c *ignoredErrorResultChecker) markVar(x ast.Expr) {
+	if id, ok := astutil.Unparen(x).(*ast.Ident); ok {
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			c.written[obj] = true
+		}
+	}
+}
+
+// mustCheckSymbol returns the symbol name of the called function
+// if its error result must be checked, otherwise an empty string.
+func (c *ignoredErrorResultChecker) mustCheckSymbol(call *ast.CallExpr) string {
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil {
+		return ""
+	}
+	sig := fn.Type().(*types.Signature)
+	results := sig.Results()
+	if results.Len() == 0 || !isErrorType(results.At(results.Len()-1).Type()) {
+		return ""
+	}
+
+	sym := fn.Pkg().Path() + "." + fn.Name()
+	if recv := sig.Recv(); recv != nil {
+		typ := recv.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		named, ok := typ.(*types.Named)
+		if !ok {
+			return ""
+		}
+		sym = fn.Pkg().Path() + "." + named.Obj().Name() + "." + fn.Name()
+	}
+	if !c.symbols[sym] {
+		return ""
+	}
+
+	if fn.Name() == "Close" {
+		// Close errors matter only for the written data.
+		sel, ok := astutil.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return ""
+		}
+		id, ok := astutil.Unparen(sel.X).(*ast.Ident)
+		if !ok || !c.written[c.ctx.TypesInfo.ObjectOf(id)] {
+			return ""
+		}
+	}
+	return sym
+}
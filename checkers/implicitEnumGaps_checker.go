@@ -0,0 +1,231 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "implicitEnumGaps"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects iota const blocks with interrupted sequences, duplicate values and gaps"
+	info.Before = `
+const (
+	Pending Status = iota
+	Running
+	Failed = 5
+	Done
+)`
+	info.After = `
+const (
+	Pending Status = iota
+	Running
+	Done
+	Failed = 5
+)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &implicitEnumGapsChecker{ctx: ctx}, nil
+	})
+}
+
+type implicitEnumGapsChecker struct {
+	ctx *linter.CheckerContext
+
+	// persisted is a set of types that are stored in the tagged struct fields,
+	// so their values are likely saved somewhere.
+	persisted map[types.Type]bool
+}
+
+// enumConst is a const spec name with its computed value.
+type enumConst struct {
+	name  *ast.Ident
+	value string
+	// explicit is the spec values expression that defines the constant.
+	explicit *ast.ValueSpec
+}
+
+func (c *implicitEnumGapsChecker) WalkPackage(files []*ast.File) {
+	c.persisted = make(map[types.Type]bool)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			typ, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range typ.Fields.List {
+				if field.Tag == nil {
+					continue
+				}
+				if !strings.Contains(field.Tag.Value, `json:`) && !strings.Contains(field.Tag.Value, `db:`) {
+					continue
+				}
+				c.persisted[c.elemType(c.ctx.TypeOf(field.Type))] = true
+			}
+			return true
+		})
+	}
+}
+
+func (c *implicitEnumGapsChecker) elemType(typ types.Type) types.Type {
+	for {
+		switch t := typ.(type) {
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+func (c *implicitEnumGapsChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST || !decl.Lparen.IsValid() || len(decl.Specs) < 2 {
+			continue
+		}
+		c.checkBlock(decl)
+	}
+}
+
+func (c *implicitEnumGapsChecker) checkBlock(decl *ast.GenDecl) {
+	var consts []enumConst
+	usesIota := false
+	bitFlags := false
+	var explicit *ast.ValueSpec
+	for _, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		if len(spec.Values) != 0 {
+			explicit = spec
+		}
+		if explicit != nil && c.hasIota(explicit) {
+			usesIota = true
+			if c.hasShift(explicit) {
+				bitFlags = true
+			}
+		}
+		for _, name := range spec.Names {
+			obj, ok := c.ctx.TypesInfo.ObjectOf(name).(*types.Const)
+			if !ok {
+				return
+			}
+			consts = append(consts, enumConst{name: name, value: obj.Val().ExactString(), explicit: explicit})
+		}
+	}
+	if !usesIota {
+		return
+	}
+
+	// repeats is a set of constants that implicitly repeat the value
+	// of the explicit constant without iota, they are reported once.
+	repeats := make(map[*ast.Ident]bool)
+	for i, k := range consts {
+		if k.explicit == nil || k.explicit.Names[0] != k.name || c.hasIota(k.explicit) || i == 0 {
+			continue
+		}
+		if !c.afterIota(consts[:i]) {
+			continue
+		}
+		end := i + 1
+		for end < len(consts) && consts[end].explicit == k.explicit {
+			end++
+		}
+		following := make([]string, 0, end-i-1)
+		for _, next := range consts[i+1 : end] {
+			repeats[next.name] = true
+			following = append(following, next.name.Name+" = "+next.value)
+		}
+		if len(following) != 0 {
+			c.ctx.Warn(k.name, "%s = %s interrupts the iota sequence, so the following implicit constants repeat its value: %s",
+				k.name, k.value, strings.Join(following, ", "))
+		}
+	}
+
+	seen := make(map[string]*ast.Ident)
+	for _, k := range consts {
+		if k.name.Name == "_" {
+			continue
+		}
+		if prev := seen[k.value]; prev != nil && !repeats[k.name] && !c.isAlias(k) {
+			c.ctx.Warn(k.name, "%s = %s duplicates the value of %s in the same iota block", k.name, k.value, prev)
+			continue
+		}
+		if seen[k.value] == nil {
+			seen[k.value] = k.name
+		}
+	}
+
+	if bitFlags || len(consts) == 0 {
+		return
+	}
+	typ := c.ctx.TypesInfo.ObjectOf(consts[0].name).Type()
+	if !c.persisted[typ] {
+		return
+	}
+	for i, k := range consts {
+		if i == 0 || k.name.Name != "_" || i == len(consts)-1 {
+			continue
+		}
+		var following []string
+		for _, next := range consts[i+1:] {
+			if next.name.Name != "_" && len(following) < 3 {
+				following = append(following, next.name.Name+" = "+next.value)
+			}
+		}
+		c.ctx.Warn(k.name, "blank identifier in the iota block of persisted type %s shifts the following values (%s); assign them explicitly",
+			types.TypeString(typ, types.RelativeTo(c.ctx.Pkg)), strings.Join(following, ", "))
+	}
+}
+
+// isAlias reports whether k is explicitly defined as another constant, like `Default = Medium`.
+func (c *implicitEnumGapsChecker) isAlias(k enumConst) bool {
+	spec := k.explicit
+	if spec.Names[0] != k.name || len(spec.Values) != 1 {
+		return false
+	}
+	id, ok := spec.Values[0].(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.ctx.TypesInfo.ObjectOf(id).(*types.Const)
+	return ok
+}
+
+// afterIota reports whether the last constant of consts is defined with iota.
+func (c *implicitEnumGapsChecker) afterIota(consts []enumConst) bool {
+	last := consts[len(consts)-1]
+	return last.explicit != nil && c.hasIota(last.explicit)
+}
+
+func (c *implicitEnumGapsChecker) hasIota(spec *ast.ValueSpec) bool {
+	found := false
+	for _, v := range spec.Values {
+		ast.Inspect(v, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == types.Universe.Lookup("iota") {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+func (c *implicitEnumGapsChecker) hasShift(spec *ast.ValueSpec) bool {
+	found := false
+	for _, v := range spec.Values {
+		ast.Inspect(v, func(n ast.Node) bool {
+			if bin, ok := n.(*ast.BinaryExpr); ok && bin.Op == token.SHL {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
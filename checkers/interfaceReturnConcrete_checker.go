@@ -0,0 +1,105 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "interfaceReturnConcrete"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Summary = "Detects exported functions that return a local interface with a single implementation"
+	info.Before = `
+type Store interface { Get(key string) string }
+type MemStore struct{}
+func NewStore() Store { return &MemStore{} }`
+	info.After = `
+type Store interface { Get(key string) string }
+type MemStore struct{}
+func NewStore() *MemStore { return &MemStore{} }`
+	info.Note = "Only exported implementations are suggested, see unexportedReturn"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&interfaceReturnConcreteChecker{
+			ctx:   ctx,
+			impls: make(map[*types.TypeName][]types.Type),
+		}), nil
+	})
+}
+
+type interfaceReturnConcreteChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// impls maps the package interfaces to their implementations.
+	impls map[*types.TypeName][]types.Type
+}
+
+func (c *interfaceReturnConcreteChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Recv != nil || !decl.Name.IsExported() || decl.Type.Results == nil {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	results := fn.Type().(*types.Signature).Results()
+	switch {
+	case results.Len() == 1:
+	case results.Len() == 2 && isErrorType(results.At(1).Type()):
+	default:
+		return
+	}
+	named, ok := results.At(0).Type().(*types.Named)
+	if !ok || named.Obj().Pkg() != c.ctx.Pkg {
+		return
+	}
+	iface, ok := named.Underlying().(*types.Interface)
+	if !ok || iface.NumMethods() == 0 {
+		return
+	}
+
+	impls := c.implementations(named.Obj(), iface)
+	if len(impls) != 1 {
+		return
+	}
+	impl := impls[0]
+	implName := impl
+	if ptr, ok := impl.(*types.Pointer); ok {
+		implName = ptr.Elem()
+	}
+	if !implName.(*types.Named).Obj().Exported() {
+		return
+	}
+	typeString := types.TypeString(impl, types.RelativeTo(c.ctx.Pkg))
+	c.ctx.Warn(decl.Type.Results.List[0].Type, "%s returns interface %s that is only implemented by %s; consider returning %s",
+		decl.Name, named.Obj().Name(), typeString, typeString)
+}
+
+// implementations returns the package types that implement iface.
+// If only a pointer type implements it, pointer is returned.
+func (c *interfaceReturnConcreteChecker) implementations(obj *types.TypeName, iface *types.Interface) []types.Type {
+	if impls, ok := c.impls[obj]; ok {
+		return impls
+	}
+	var impls []types.Type
+	scope := c.ctx.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() || types.IsInterface(tn.Type()) {
+			continue
+		}
+		switch typ := tn.Type(); {
+		case types.Implements(typ, iface):
+			impls = append(impls, typ)
+		case types.Implements(types.NewPointer(typ), iface):
+			impls = append(impls, types.NewPointer(typ))
+		}
+	}
+	c.impls[obj] = impls
+	return impls
+}
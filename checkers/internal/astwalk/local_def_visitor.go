@@ -7,8 +7,9 @@ import (
 // LocalDefVisitor visits every name definitions inside a function.
 //
 // Next elements are considered as name definitions:
-//	- Function parameters (input, output, receiver)
+//	- Function and function literal parameters (input, output, receiver)
 //	- Every LHS of ":=" assignment that defines a new name
+//	- Every ":=" range statement key and value
 //	- Every local var/const declaration.
 //
 // NOTE: this visitor is experimental.
@@ -43,7 +44,7 @@ const (
 	NameParam NameKind = iota
 	// NameVar is var or ":=" declared name.
 	// Initizlizing expression may be nil for var-declared names
-	// without explicit initializing expression.
+	// without explicit initializing expression and range variables.
 	NameVar
 	// NameConst is const-declared name.
 	// Initializing expression is never nil.
@@ -12,6 +12,10 @@ type localDefWalker struct {
 }
 
 func (w *localDefWalker) WalkFile(f *ast.File) {
+	if !w.visitor.EnterFile(f) {
+		return
+	}
+
 	for _, decl := range f.Decls {
 		decl, ok := decl.(*ast.FuncDecl)
 		if !ok || !w.visitor.EnterFunc(decl) {
@@ -31,7 +35,8 @@ func (w *localDefWalker) walkFuncBody(decl *ast.FuncDecl) {
 		switch x := x.(type) {
 		case *ast.AssignStmt:
 			if x.Tok != token.DEFINE {
-				return false
+				// Function literals may define names.
+				return true
 			}
 			if len(x.Lhs) != len(x.Rhs) {
 				// Multi-value assignment.
@@ -55,7 +60,26 @@ func (w *localDefWalker) walkFuncBody(decl *ast.FuncDecl) {
 					w.visitor.VisitLocalDef(def, x.Rhs[i])
 				}
 			}
-			return false
+			// Function literals may define names too.
+			return true
+
+		case *ast.FuncLit:
+			w.walkFuncType(x.Type)
+			return true
+
+		case *ast.RangeStmt:
+			if x.Tok != token.DEFINE {
+				return true
+			}
+			for _, lhs := range []ast.Expr{x.Key, x.Value} {
+				id, ok := lhs.(*ast.Ident)
+				if !ok || w.info.Defs[id] == nil {
+					continue
+				}
+				def := Name{ID: id, Kind: NameVar}
+				w.visitor.VisitLocalDef(def, nil)
+			}
+			return true
 
 		case *ast.GenDecl:
 			// Decls always introduce new names.
@@ -89,7 +113,7 @@ func (w *localDefWalker) walkFuncBody(decl *ast.FuncDecl) {
 					}
 				}
 			}
-			return false
+			return true
 		}
 
 		return true
@@ -97,22 +121,26 @@ func (w *localDefWalker) walkFuncBody(decl *ast.FuncDecl) {
 }
 
 func (w *localDefWalker) walkSignature(decl *ast.FuncDecl) {
-	for _, p := range decl.Type.Params.List {
+	w.walkFuncType(decl.Type)
+	if decl.Recv != nil && len(decl.Recv.List[0].Names) != 0 {
+		def := Name{ID: decl.Recv.List[0].Names[0], Kind: NameParam}
+		w.visitor.VisitLocalDef(def, nil)
+	}
+}
+
+func (w *localDefWalker) walkFuncType(typ *ast.FuncType) {
+	for _, p := range typ.Params.List {
 		for _, id := range p.Names {
 			def := Name{ID: id, Kind: NameParam}
 			w.visitor.VisitLocalDef(def, nil)
 		}
 	}
-	if decl.Type.Results != nil {
-		for _, p := range decl.Type.Results.List {
+	if typ.Results != nil {
+		for _, p := range typ.Results.List {
 			for _, id := range p.Names {
 				def := Name{ID: id, Kind: NameParam}
 				w.visitor.VisitLocalDef(def, nil)
 			}
 		}
 	}
-	if decl.Recv != nil && len(decl.Recv.List[0].Names) != 0 {
-		def := Name{ID: decl.Recv.List[0].Names[0], Kind: NameParam}
-		w.visitor.VisitLocalDef(def, nil)
-	}
 }
@@ -0,0 +1,71 @@
+package astwalk
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+type typeParamWalker struct {
+	visitor TypeParamVisitor
+}
+
+func (w *typeParamWalker) WalkFile(f *ast.File) {
+	if !w.visitor.EnterFile(f) {
+		return
+	}
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !w.visitor.EnterFunc(decl) {
+				continue
+			}
+			if decl.Type.TypeParams != nil {
+				w.visitor.VisitTypeParams(decl, decl.Type.TypeParams)
+			}
+			if params := receiverTypeParams(decl); params != nil {
+				w.visitor.VisitTypeParams(decl, params)
+			}
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				if spec.TypeParams != nil {
+					w.visitor.VisitTypeParams(spec, spec.TypeParams)
+				}
+			}
+		}
+	}
+}
+
+// receiverTypeParams returns the type parameters of the generic method receiver.
+// Receivers can't specify the constraints, so the returned fields have no types.
+func receiverTypeParams(decl *ast.FuncDecl) *ast.FieldList {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return nil
+	}
+	typ := decl.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	var indices []ast.Expr
+	switch typ := typ.(type) {
+	case *ast.IndexExpr:
+		indices = []ast.Expr{typ.Index}
+	case *ast.IndexListExpr:
+		indices = typ.Indices
+	default:
+		return nil
+	}
+	params := &ast.FieldList{}
+	for _, x := range indices {
+		id, ok := x.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		params.List = append(params.List, &ast.Field{Names: []*ast.Ident{id}})
+	}
+	return params
+}
@@ -53,6 +53,16 @@ type (
 		VisitTypeExpr(ast.Expr)
 	}
 
+	// TypeParamVisitor visits every type parameters list of the generic
+	// type and function declarations. For the methods of generic types
+	// it also visits the receiver type parameters, these fields have no types.
+	//
+	// decl is either *ast.FuncDecl or *ast.TypeSpec.
+	TypeParamVisitor interface {
+		walkerEvents
+		VisitTypeParams(decl ast.Node, params *ast.FieldList)
+	}
+
 	// LocalCommentVisitor visits every comment inside function body.
 	LocalCommentVisitor interface {
 		walkerEvents
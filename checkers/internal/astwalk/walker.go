@@ -36,6 +36,11 @@ func WalkerForTypeExpr(v TypeExprVisitor, info *types.Info) linter.FileWalker {
 	return &typeExprWalker{visitor: v, info: info}
 }
 
+// WalkerForTypeParam returns file walker implementation for TypeParamVisitor.
+func WalkerForTypeParam(v TypeParamVisitor) linter.FileWalker {
+	return &typeParamWalker{visitor: v}
+}
+
 // WalkerForLocalComment returns file walker implementation for LocalCommentVisitor.
 func WalkerForLocalComment(v LocalCommentVisitor) linter.FileWalker {
 	return &localCommentWalker{visitor: v}
@@ -0,0 +1,127 @@
+package lintutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatVerb is a printf-style formatting directive, like %-8.2f or %[2]d.
+type FormatVerb struct {
+	// Text is the directive source text.
+	Text string
+
+	// Verb is the verb character, like 'd' or 'v'.
+	Verb rune
+
+	// Arg is the index of the formatted argument, starting with 0.
+	Arg int
+
+	// Indexed reports whether Arg is set by the explicit [n] index.
+	Indexed bool
+
+	// StarArgs are the indexes of the arguments consumed by * width and precision.
+	StarArgs []int
+
+	// Pos and End are the directive bounds offsets in the format string.
+	Pos, End int
+}
+
+// ParseFormat returns the directives of the printf-style format.
+//
+// For malformed formats the directives parsed before the first error are returned.
+func ParseFormat(format string) ([]FormatVerb, error) {
+	p := formatParser{format: format}
+	var verbs []FormatVerb
+	for p.pos < len(format) {
+		if format[p.pos] != '%' {
+			p.pos++
+			continue
+		}
+		if strings.HasPrefix(format[p.pos:], "%%") {
+			p.pos += 2
+			continue
+		}
+		v, err := p.parseVerb()
+		if err != nil {
+			return verbs, err
+		}
+		verbs = append(verbs, v)
+	}
+	return verbs, nil
+}
+
+type formatParser struct {
+	format string
+	pos    int
+	// arg is the index of the argument used by the next verb or *.
+	arg int
+}
+
+func (p *formatParser) parseVerb() (FormatVerb, error) {
+	v := FormatVerb{Pos: p.pos}
+	p.pos++
+	for p.pos < len(p.format) && strings.IndexByte("+-# 0", p.format[p.pos]) != -1 {
+		p.pos++
+	}
+	if err := p.parseNum(&v); err != nil {
+		return v, err
+	}
+	if p.pos < len(p.format) && p.format[p.pos] == '.' {
+		p.pos++
+		if err := p.parseNum(&v); err != nil {
+			return v, err
+		}
+	}
+	if err := p.parseIndex(&v); err != nil {
+		return v, err
+	}
+	if p.pos == len(p.format) {
+		return v, fmt.Errorf("missing verb in %q at the end of the format", p.format[v.Pos:])
+	}
+	r, size := utf8.DecodeRuneInString(p.format[p.pos:])
+	p.pos += size
+	v.Verb = r
+	v.Arg = p.arg
+	p.arg++
+	v.End = p.pos
+	v.Text = p.format[v.Pos:v.End]
+	return v, nil
+}
+
+// parseNum parses the width or precision, which is either a number or *.
+func (p *formatParser) parseNum(v *FormatVerb) error {
+	if err := p.parseIndex(v); err != nil {
+		return err
+	}
+	if p.pos < len(p.format) && p.format[p.pos] == '*' {
+		p.pos++
+		v.StarArgs = append(v.StarArgs, p.arg)
+		p.arg++
+		return nil
+	}
+	for p.pos < len(p.format) && p.format[p.pos] >= '0' && p.format[p.pos] <= '9' {
+		p.pos++
+	}
+	return nil
+}
+
+// parseIndex parses the explicit [n] argument index.
+func (p *formatParser) parseIndex(v *FormatVerb) error {
+	if p.pos == len(p.format) || p.format[p.pos] != '[' {
+		return nil
+	}
+	end := strings.IndexByte(p.format[p.pos:], ']')
+	if end == -1 {
+		return fmt.Errorf("unclosed argument index in %q", p.format[v.Pos:])
+	}
+	n, err := strconv.Atoi(p.format[p.pos+1 : p.pos+end])
+	if err != nil || n < 1 {
+		return fmt.Errorf("bad argument index %s", p.format[p.pos:p.pos+end+1])
+	}
+	p.pos += end + 1
+	p.arg = n - 1
+	v.Indexed = true
+	return nil
+}
@@ -0,0 +1,172 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "ioutilDeprecated"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"onePerImport": {
+			Value: false,
+			Usage: "whether to report a single warning at the io/ioutil import instead of every use",
+		},
+	}
+	info.Summary = "Detects deprecated io/ioutil package usages"
+	info.Before = `ioutil.ReadAll(r)`
+	info.After = `io.ReadAll(r)`
+	info.Note = `
+Quick fixes are suggested only if the replacement package is already imported
+and the io/ioutil import is still used after the fixes.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &ioutilDeprecatedChecker{
+			ctx:          ctx,
+			onePerImport: info.Params.Bool("onePerImport"),
+		}, nil
+	})
+}
+
+// ioutilReplacement describes the io/ioutil symbol replacement.
+type ioutilReplacement struct {
+	pkg  string
+	name string
+	// note is appended to the warning if the replacement is not a drop-in one.
+	note string
+}
+
+var ioutilReplacements = map[string]ioutilReplacement{
+	"ReadAll":   {pkg: "io", name: "ReadAll"},
+	"ReadFile":  {pkg: "os", name: "ReadFile"},
+	"WriteFile": {pkg: "os", name: "WriteFile"},
+	"ReadDir":   {pkg: "os", name: "ReadDir", note: "it returns []os.DirEntry instead of []os.FileInfo"},
+	"TempDir":   {pkg: "os", name: "MkdirTemp"},
+	"TempFile":  {pkg: "os", name: "CreateTemp"},
+	"NopCloser": {pkg: "io", name: "NopCloser"},
+	"Discard":   {pkg: "io", name: "Discard"},
+}
+
+type ioutilDeprecatedChecker struct {
+	ctx *linter.CheckerContext
+
+	onePerImport bool
+}
+
+func (c *ioutilDeprecatedChecker) WalkFile(f *ast.File) {
+	v := c.ctx.GoVersion
+	if !v.IsAny() && !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 16}) {
+		return
+	}
+
+	var spec *ast.ImportSpec
+	// imported is a set of packages that are imported with their default names.
+	imported := make(map[string]bool, len(f.Imports))
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if path == "io/ioutil" {
+			spec = imp
+		}
+		if imp.Name == nil || imp.Name.Name == path {
+			imported[path] = true
+		}
+	}
+	if spec == nil {
+		return
+	}
+	pkgName := c.importedPkgName(spec)
+	if pkgName == nil {
+		return
+	}
+
+	var uses []*ast.SelectorExpr
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); ok && c.ctx.TypesInfo.Uses[id] == pkgName {
+			if _, ok := ioutilReplacements[sel.Sel.Name]; ok {
+				uses = append(uses, sel)
+			}
+		}
+		return true
+	})
+
+	if c.onePerImport {
+		c.warnImport(spec, uses)
+		return
+	}
+	// The fixes would leave the io/ioutil import unused if every use is fixable.
+	keepsImport := false
+	for _, sel := range uses {
+		if !c.isFixable(sel, imported) {
+			keepsImport = true
+			break
+		}
+	}
+	for _, sel := range uses {
+		c.warnUse(sel, keepsImport && c.isFixable(sel, imported))
+	}
+}
+
+// isFixable reports whether sel can be replaced without import changes.
+func (c *ioutilDeprecatedChecker) isFixable(sel *ast.SelectorExpr, imported map[string]bool) bool {
+	r := ioutilReplacements[sel.Sel.Name]
+	return r.note == "" && imported[r.pkg]
+}
+
+func (c *ioutilDeprecatedChecker) importedPkgName(spec *ast.ImportSpec) *types.PkgName {
+	var obj types.Object
+	if spec.Name != nil {
+		obj = c.ctx.TypesInfo.Defs[spec.Name]
+	} else {
+		obj = c.ctx.TypesInfo.Implicits[spec]
+	}
+	pkgName, _ := obj.(*types.PkgName)
+	return pkgName
+}
+
+func (c *ioutilDeprecatedChecker) warnImport(spec *ast.ImportSpec, uses []*ast.SelectorExpr) {
+	if len(uses) == 0 {
+		return
+	}
+	var replacements []string
+	seen := make(map[string]bool)
+	for _, sel := range uses {
+		r := ioutilReplacements[sel.Sel.Name]
+		s := r.pkg + "." + r.name
+		if !seen[s] {
+			seen[s] = true
+			replacements = append(replacements, s)
+		}
+	}
+	c.ctx.Warn(spec, "io/ioutil is deprecated, use %s instead", strings.Join(replacements, ", "))
+}
+
+func (c *ioutilDeprecatedChecker) warnUse(sel *ast.SelectorExpr, fixable bool) {
+	r := ioutilReplacements[sel.Sel.Name]
+	replacement := r.pkg + "." + r.name
+	if r.note != "" {
+		c.ctx.Warn(sel, "%s is deprecated, use %s instead; note that %s", sel, replacement, r.note)
+		return
+	}
+	if !fixable {
+		c.ctx.Warn(sel, "%s is deprecated, use %s instead", sel, replacement)
+		return
+	}
+	c.ctx.WarnFixable(sel, linter.QuickFix{
+		From:        sel.Pos(),
+		To:          sel.End(),
+		Replacement: []byte(replacement),
+	}, "%s is deprecated, use %s instead", sel, replacement)
+}
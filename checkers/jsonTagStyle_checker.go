@@ -0,0 +1,241 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "jsonTagStyle"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"style": {
+			Value: "",
+			Usage: "json tag names style to enforce: snake or camel; if empty, the style used by most of the struct fields is expected",
+		},
+		"requireTags": {
+			Value: false,
+			Usage: "whether to report exported fields without json tags in structs that are marshaled in the package",
+		},
+	}
+	info.Summary = "Detects inconsistent and malformed json struct tags"
+	info.Before = `
+type user struct {
+	FirstName string ` + "`json:\"first_name\"`" + `
+	LastName  string ` + "`json:\"lastName, omitempty\"`" + `
+}`
+	info.After = `
+type user struct {
+	FirstName string ` + "`json:\"first_name\"`" + `
+	LastName  string ` + "`json:\"last_name,omitempty\"`" + `
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		style := info.Params.String("style")
+		switch style {
+		case "", jsonTagSnakeCase, jsonTagCamelCase:
+		default:
+			return nil, fmt.Errorf("style: unexpected value %q, expected snake or camel", style)
+		}
+		return &jsonTagStyleChecker{
+			ctx:         ctx,
+			style:       style,
+			requireTags: info.Params.Bool("requireTags"),
+		}, nil
+	})
+}
+
+const (
+	jsonTagSnakeCase = "snake"
+	jsonTagCamelCase = "camel"
+)
+
+type jsonTagStyleChecker struct {
+	ctx *linter.CheckerContext
+
+	style       string
+	requireTags bool
+
+	// marshaled is a set of types that are passed to json.Marshal in the package.
+	marshaled map[*types.TypeName]bool
+}
+
+// jsonTagField is a struct field with a json tag.
+type jsonTagField struct {
+	field *ast.Field
+	name  *ast.Ident
+	value string
+}
+
+func (c *jsonTagStyleChecker) WalkPackage(files []*ast.File) {
+	c.marshaled = make(map[*types.TypeName]bool)
+	if !c.requireTags {
+		return
+	}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			switch calledFuncName(c.ctx.TypesInfo, call) {
+			case "encoding/json.Marshal", "encoding/json.MarshalIndent", "(*encoding/json.Encoder).Encode":
+				c.markMarshaled(c.ctx.TypeOf(call.Args[0]))
+			}
+			return true
+		})
+	}
+}
+
+func (c *jsonTagStyleChecker) markMarshaled(typ types.Type) {
+	switch typ := typ.(type) {
+	case *types.Pointer:
+		c.markMarshaled(typ.Elem())
+	case *types.Slice:
+		c.markMarshaled(typ.Elem())
+	case *types.Array:
+		c.markMarshaled(typ.Elem())
+	case *types.Map:
+		c.markMarshaled(typ.Elem())
+	case *types.Named:
+		c.marshaled[typ.Obj()] = true
+	}
+}
+
+func (c *jsonTagStyleChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.TypeSpec)
+			if typeExpr, ok := spec.Type.(*ast.StructType); ok {
+				c.checkStruct(spec, typeExpr)
+			}
+		}
+	}
+}
+
+func (c *jsonTagStyleChecker) checkStruct(spec *ast.TypeSpec, typeExpr *ast.StructType) {
+	var fields []jsonTagField
+	for _, field := range typeExpr.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			value, ok := c.jsonTag(field)
+			if !ok {
+				c.checkMissingTag(spec, name)
+				continue
+			}
+			if value != "-" {
+				fields = append(fields, jsonTagField{field: field, name: name, value: value})
+			}
+		}
+	}
+
+	// usedNames maps the json names to the fields that use them.
+	usedNames := make(map[string]*ast.Ident)
+	snakeCount, camelCount := 0, 0
+	for _, f := range fields {
+		if strings.ContainsAny(f.value, " \t") {
+			c.ctx.Warn(f.field.Tag, "json tag %q of field %s contains spaces", f.value, f.name)
+		}
+		parts := strings.Split(f.value, ",")
+		name := strings.TrimSpace(parts[0])
+		for _, opt := range parts[1:] {
+			if strings.TrimSpace(opt) == "omitempty" && c.isStruct(f.name) {
+				c.ctx.Warn(f.field.Tag, "omitempty has no effect on struct field %s; use a pointer to omit it", f.name)
+			}
+		}
+		if name == "" {
+			continue
+		}
+		if prev := usedNames[name]; prev != nil {
+			c.ctx.Warn(f.field.Tag, "json tag name %s of field %s is already used by field %s", name, f.name, prev)
+		} else {
+			usedNames[name] = f.name
+		}
+		switch jsonNameStyle(name) {
+		case jsonTagSnakeCase:
+			snakeCount++
+		case jsonTagCamelCase:
+			camelCount++
+		}
+	}
+
+	style := c.style
+	if style == "" {
+		switch {
+		case snakeCount > camelCount:
+			style = jsonTagSnakeCase
+		case camelCount > snakeCount:
+			style = jsonTagCamelCase
+		default:
+			return
+		}
+	}
+	for _, f := range fields {
+		name := strings.TrimSpace(strings.Split(f.value, ",")[0])
+		if nameStyle := jsonNameStyle(name); nameStyle != "" && nameStyle != style {
+			c.ctx.Warn(f.field.Tag, "json tag name %s of field %s is %s case, while %s case is expected",
+				name, f.name, nameStyle, style)
+		}
+	}
+}
+
+func (c *jsonTagStyleChecker) checkMissingTag(spec *ast.TypeSpec, name *ast.Ident) {
+	if !c.requireTags {
+		return
+	}
+	if obj, ok := c.ctx.TypesInfo.ObjectOf(spec.Name).(*types.TypeName); !ok || !c.marshaled[obj] {
+		return
+	}
+	c.ctx.Warn(name, "exported field %s of marshaled struct %s has no json tag", name, spec.Name)
+}
+
+// jsonTag returns the json tag value of the field.
+func (c *jsonTagStyleChecker) jsonTag(field *ast.Field) (string, bool) {
+	if field.Tag == nil {
+		return "", false
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false
+	}
+	return reflect.StructTag(tag).Lookup("json")
+}
+
+// isStruct reports whether the field has a non-pointer struct type.
+func (c *jsonTagStyleChecker) isStruct(name *ast.Ident) bool {
+	obj := c.ctx.TypesInfo.ObjectOf(name)
+	if obj == nil {
+		return false
+	}
+	_, ok := obj.Type().Underlying().(*types.Struct)
+	return ok
+}
+
+// jsonNameStyle returns the json name case style.
+// Empty string is returned if the style is ambiguous, like for "name" or "ID".
+func jsonNameStyle(name string) string {
+	hasUpper := strings.IndexFunc(name, unicode.IsUpper) != -1
+	switch {
+	case strings.Contains(name, "_") && !hasUpper:
+		return jsonTagSnakeCase
+	case !strings.Contains(name, "_") && hasUpper && unicode.IsLower(rune(name[0])):
+		return jsonTagCamelCase
+	default:
+		return ""
+	}
+}
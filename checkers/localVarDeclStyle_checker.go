@@ -0,0 +1,187 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "localVarDeclStyle"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"style": {
+			Value: "short",
+			Usage: "preferred local variables declaration style, either short for x := v or var for var x = v",
+		},
+	}
+	info.Summary = "Detects local variable declarations that don't follow the configured declaration style"
+	info.Before = `
+var count = len(items)
+var name string = user.Name`
+	info.After = `
+count := len(items)
+name := user.Name`
+	info.Note = "Types that convert untyped constants or widen the value to an interface are not redundant"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		style := info.Params.String("style")
+		if style != "short" && style != "var" {
+			return nil, fmt.Errorf("style: expected short or var, got %q", style)
+		}
+		return astwalk.WalkerForStmtList(&localVarDeclStyleChecker{
+			ctx:        ctx,
+			preferVars: style == "var",
+		}), nil
+	})
+}
+
+type localVarDeclStyleChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	preferVars bool
+}
+
+func (c *localVarDeclStyleChecker) VisitStmtList(list []ast.Stmt) {
+	for i, stmt := range list {
+		var next ast.Stmt
+		if i+1 < len(list) {
+			next = list[i+1]
+		}
+		switch stmt := stmt.(type) {
+		case *ast.DeclStmt:
+			c.checkVarDecl(stmt, next)
+		case *ast.AssignStmt:
+			if c.preferVars {
+				c.checkShortDecl(stmt)
+			}
+		}
+	}
+}
+
+func (c *localVarDeclStyleChecker) checkVarDecl(stmt *ast.DeclStmt, next ast.Stmt) {
+	decl, ok := stmt.Decl.(*ast.GenDecl)
+	if !ok || decl.Tok != token.VAR || decl.Lparen.IsValid() {
+		return
+	}
+	spec := decl.Specs[0].(*ast.ValueSpec)
+	if len(spec.Names) != 1 || len(spec.Values) != 1 {
+		return
+	}
+	name, value := spec.Names[0], spec.Values[0]
+
+	if spec.Type != nil {
+		if !c.redundantType(spec.Type, value) {
+			return
+		}
+		format := "type %s of %s is redundant, the value already has this type; omit the type"
+		fix := linter.QuickFix{From: name.End(), To: value.Pos(), Replacement: []byte(" = ")}
+		if !c.preferVars {
+			format = "type %s of %s is redundant, the value already has this type; declare it with :="
+			fix = linter.QuickFix{From: stmt.Pos(), To: value.Pos(), Replacement: []byte(name.Name + " := ")}
+		}
+		c.ctx.WarnFixable(stmt, fix, format, spec.Type, name)
+		return
+	}
+
+	if c.preferVars || c.widensScope(name, next) {
+		return
+	}
+	c.ctx.WarnFixable(stmt, linter.QuickFix{
+		From:        stmt.Pos(),
+		To:          value.Pos(),
+		Replacement: []byte(name.Name + " := "),
+	}, "declare %s with := instead of var", name)
+}
+
+func (c *localVarDeclStyleChecker) checkShortDecl(assign *ast.AssignStmt) {
+	if assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	name, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || c.ctx.TypesInfo.Defs[name] == nil {
+		return
+	}
+	c.ctx.WarnFixable(assign, linter.QuickFix{
+		From:        assign.Pos(),
+		To:          assign.Rhs[0].Pos(),
+		Replacement: []byte("var " + name.Name + " = "),
+	}, "declare %s with var instead of :=", name)
+}
+
+// redundantType reports whether the variable of typ type declared with
+// the short declaration syntax would have the same type.
+func (c *localVarDeclStyleChecker) redundantType(typ, value ast.Expr) bool {
+	t := c.ctx.TypeOf(typ)
+	if c.isUntyped(value) {
+		// Constants and nil get the type from the declaration.
+		tv := c.ctx.TypesInfo.Types[value]
+		if tv.Value != nil || tv.IsNil() {
+			return false
+		}
+		// Comparisons produce untyped booleans.
+		return types.Identical(t, types.Typ[types.Bool])
+	}
+	return types.Identical(t, c.ctx.TypeOf(value))
+}
+
+// isUntyped reports whether x is an untyped expression.
+func (c *localVarDeclStyleChecker) isUntyped(x ast.Expr) bool {
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		switch obj := c.ctx.TypesInfo.ObjectOf(x).(type) {
+		case *types.Nil:
+			return true
+		case *types.Const:
+			basic, ok := obj.Type().(*types.Basic)
+			return ok && basic.Info()&types.IsUntyped != 0
+		}
+		return false
+	case *ast.UnaryExpr:
+		return x.Op != token.AND && x.Op != token.ARROW && c.isUntyped(x.X)
+	case *ast.BinaryExpr:
+		switch x.Op {
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			return true
+		case token.SHL, token.SHR:
+			return c.isUntyped(x.X)
+		default:
+			return c.isUntyped(x.X) && c.isUntyped(x.Y)
+		}
+	}
+	return false
+}
+
+// widensScope reports whether the variable is declared before the
+// if, for or switch statement to be assigned inside it.
+func (c *localVarDeclStyleChecker) widensScope(name *ast.Ident, next ast.Stmt) bool {
+	switch next.(type) {
+	case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+	default:
+		return false
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(name)
+	assigned := false
+	ast.Inspect(next, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || assign.Tok == token.DEFINE {
+			return !assigned
+		}
+		for _, lhs := range assign.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == obj {
+				assigned = true
+			}
+		}
+		return !assigned
+	})
+	return assigned
+}
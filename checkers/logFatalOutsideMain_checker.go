@@ -0,0 +1,125 @@
+package checkers
+
+import (
+	"go/ast"
+	"path"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "logFatalOutsideMain"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"fatalFuncs": {
+			Value: "log.Fatal*,(*log.Logger).Fatal*,os.Exit," +
+				"github.com/sirupsen/logrus.Fatal*,(*github.com/sirupsen/logrus.*).Fatal*," +
+				"(*go.uber.org/zap.*).Fatal*",
+			Usage: "comma-separated list of glob patterns that match process-terminating functions",
+		},
+		"allowPackages": {
+			Value: "",
+			Usage: "comma-separated list of package path globs, a pattern with /... suffix matches all subpackages",
+		},
+	}
+	info.Summary = "Detects process-terminating calls outside of the main package"
+	info.Before = `
+func LoadConfig(path string) *Config {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return parseConfig(data)
+}`
+	info.After = `
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseConfig(data), nil
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&logFatalOutsideMainChecker{
+			ctx:           ctx,
+			fatalFuncs:    splitPatterns(info.Params.String("fatalFuncs")),
+			allowPackages: splitPatterns(info.Params.String("allowPackages")),
+		}), nil
+	})
+}
+
+type logFatalOutsideMainChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	fatalFuncs    []string
+	allowPackages []string
+}
+
+func (c *logFatalOutsideMainChecker) EnterFile(f *ast.File) bool {
+	if c.ctx.Pkg.Name() == "main" {
+		return false
+	}
+	pkgPath := c.ctx.Pkg.Path()
+	for _, pattern := range c.allowPackages {
+		if strings.HasSuffix(pattern, "/...") {
+			prefix := strings.TrimSuffix(pattern, "/...")
+			if pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/") {
+				return false
+			}
+			continue
+		}
+		if ok, _ := path.Match(pattern, pkgPath); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *logFatalOutsideMainChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	if decl.Recv == nil {
+		switch decl.Name.Name {
+		case "main", "init", "TestMain":
+			return
+		}
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if ok && c.isFatalCall(call) {
+			c.ctx.Warn(call, "%s terminates the process from package %s and skips the deferred calls of its callers; return an error instead",
+				call.Fun, c.ctx.Pkg.Name())
+		}
+		return true
+	})
+}
+
+func (c *logFatalOutsideMainChecker) isFatalCall(call *ast.CallExpr) bool {
+	name := calledFuncName(c.ctx.TypesInfo, call)
+	if name == "" {
+		return false
+	}
+	for _, pattern := range c.fatalFuncs {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// splitPatterns returns the non-empty elements of comma-separated list s.
+func splitPatterns(s string) []string {
+	var patterns []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
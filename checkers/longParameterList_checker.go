@@ -0,0 +1,80 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "longParameterList"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"maxParams": {
+			Value: 6,
+			Usage: "max number of function parameters",
+		},
+		"skipIfaceImpls": {
+			Value: true,
+			Usage: "whether to skip methods that implement interfaces from the imported packages",
+		},
+	}
+	info.Summary = "Detects functions with too many parameters or several bool parameters"
+	info.Before = `func render(w io.Writer, tmpl string, data interface{}, escape, indent bool)`
+	info.After = `func render(w io.Writer, tmpl string, data interface{}, opts renderOptions)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&longParameterListChecker{
+			ctx:            ctx,
+			maxParams:      info.Params.Int("maxParams"),
+			skipIfaceImpls: info.Params.Bool("skipIfaceImpls"),
+		}), nil
+	})
+}
+
+type longParameterListChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	maxParams      int
+	skipIfaceImpls bool
+}
+
+func (c *longParameterListChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	params := fn.Type().(*types.Signature).Params()
+	if params.Len() < 2 {
+		return
+	}
+	if decl.Recv != nil && c.skipIfaceImpls && c.implementsImportedIface(fn) {
+		return
+	}
+
+	if params.Len() > c.maxParams {
+		c.ctx.Warn(decl.Name, "%s has %d parameters, the limit is %d; consider grouping them into an options struct",
+			decl.Name, params.Len(), c.maxParams)
+	}
+
+	bools := 0
+	for i := 0; i < params.Len(); i++ {
+		if types.Identical(params.At(i).Type(), types.Typ[types.Bool]) {
+			bools++
+		}
+	}
+	if bools >= 2 {
+		c.ctx.Warn(decl.Name, "%s has %d bool parameters that make call sites like %s(true, false) unreadable; consider an options struct",
+			decl.Name, bools, decl.Name)
+	}
+}
+
+// implementsImportedIface reports whether method fn is required
+// by some interface from the directly imported packages.
+func (c *longParameterListChecker) implementsImportedIface(fn *types.Func) bool {
+	return isIfaceMethod(fn, c.ctx.Pkg.Imports(), (*types.TypeName).Exported)
+}
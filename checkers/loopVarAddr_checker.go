@@ -0,0 +1,182 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "loopVarAddr"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects range variable addresses that outlive the loop iteration"
+	info.Before = `
+for _, v := range values {
+	ptrs = append(ptrs, &v)
+}`
+	info.After = `
+for i := range values {
+	ptrs = append(ptrs, &values[i])
+}`
+	info.Note = "Only reported for Go versions before 1.22 that share range variables between iterations"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&loopVarAddrChecker{ctx: ctx}), nil
+	})
+}
+
+type loopVarAddrChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	vars map[types.Object]bool
+}
+
+func (c *loopVarAddrChecker) EnterFile(f *ast.File) bool {
+	// Since Go 1.22 every iteration has its own copy of loop variables.
+	v := c.ctx.GoVersion
+	return v.IsAny() || !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 22})
+}
+
+func (c *loopVarAddrChecker) VisitStmt(stmt ast.Stmt) {
+	rng, ok := stmt.(*ast.RangeStmt)
+	if !ok || rng.Tok != token.DEFINE {
+		return
+	}
+
+	c.vars = loopVars(c.ctx.TypesInfo, []ast.Node{rng})
+	if len(c.vars) == 0 {
+		return
+	}
+
+	c.walkBody(rng, rng.Body, false)
+}
+
+func (c *loopVarAddrChecker) walkBody(rng *ast.RangeStmt, body ast.Node, nested bool) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			if n != body {
+				// Deferred calls inside function literals are
+				// executed when the literal returns.
+				c.walkBody(rng, n, true)
+				return false
+			}
+		case *ast.CallExpr:
+			if isBuiltinCall(c.ctx.TypesInfo, n, "append") && len(n.Args) > 1 {
+				c.checkStored(n.Args[1:]...)
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if c.outlivesIteration(rng, lhs) {
+					c.checkStored(n.Rhs[i])
+				}
+			}
+		case *ast.SendStmt:
+			c.checkStored(n.Value)
+		case *ast.GoStmt:
+			c.checkDelayedCall(n.Call, "go")
+		case *ast.DeferStmt:
+			if !nested {
+				c.checkDelayedCall(n.Call, "defer")
+			}
+		}
+		return true
+	})
+}
+
+// outlivesIteration reports whether a value assigned to lhs
+// is visible after the current loop iteration ends.
+func (c *loopVarAddrChecker) outlivesIteration(rng *ast.RangeStmt, lhs ast.Expr) bool {
+	switch lhs := lhs.(type) {
+	case *ast.IndexExpr, *ast.SelectorExpr, *ast.StarExpr:
+		return true
+	case *ast.Ident:
+		obj := c.ctx.TypesInfo.ObjectOf(lhs)
+		return obj != nil && obj.Pos() < rng.Pos()
+	default:
+		return false
+	}
+}
+
+func (c *loopVarAddrChecker) checkStored(list ...ast.Expr) {
+	for _, x := range list {
+		if v := c.addrOfLoopVar(x); v != nil {
+			c.warnAddr(x, v)
+		}
+	}
+}
+
+func (c *loopVarAddrChecker) checkDelayedCall(call *ast.CallExpr, keyword string) {
+	c.checkStored(call.Args...)
+
+	fn, ok := call.Fun.(*ast.FuncLit)
+	if !ok {
+		return
+	}
+	reported := make(map[*types.Var]bool)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		v := c.loopVarOf(id)
+		if v != nil && !reported[v] {
+			reported[v] = true
+			c.warnCapture(id, v, keyword)
+		}
+		return true
+	})
+}
+
+// addrOfLoopVar returns a loop variable which memory is referenced by
+// the &v or &v.field expression x. Returns nil for other expressions.
+func (c *loopVarAddrChecker) addrOfLoopVar(x ast.Expr) *types.Var {
+	addr, ok := x.(*ast.UnaryExpr)
+	if !ok || addr.Op != token.AND {
+		return nil
+	}
+	e := addr.X
+	for {
+		sel, ok := e.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		if typep.IsPointer(c.ctx.TypeOf(sel.X).Underlying()) {
+			return nil // Points to some other memory
+		}
+		e = sel.X
+	}
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return c.loopVarOf(id)
+}
+
+func (c *loopVarAddrChecker) loopVarOf(id *ast.Ident) *types.Var {
+	obj := c.ctx.TypesInfo.Uses[id]
+	if !c.vars[obj] {
+		return nil
+	}
+	v, _ := obj.(*types.Var)
+	return v
+}
+
+func (c *loopVarAddrChecker) warnAddr(cause ast.Expr, v *types.Var) {
+	c.ctx.Warn(cause, "%s outlives the iteration, but range variable %s declared at line %d is reused by all iterations",
+		cause, v.Name(), c.ctx.FileSet.Position(v.Pos()).Line)
+}
+
+func (c *loopVarAddrChecker) warnCapture(cause *ast.Ident, v *types.Var, keyword string) {
+	c.ctx.Warn(cause, "%s closure captures range variable %s declared at line %d that is reused by all iterations",
+		keyword, v.Name(), c.ctx.FileSet.Position(v.Pos()).Line)
+}
@@ -0,0 +1,294 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "magicNumber"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowedNumbers": {
+			Value: "0,1,-1,2,3,10,100,1024",
+			Usage: "comma-separated list of numbers that are never reported",
+		},
+		"minValue": {
+			Value: 0,
+			Usage: "report only numbers with the absolute value above this one",
+		},
+		"skipTests": {
+			Value: true,
+			Usage: "whether to skip _test.go files",
+		},
+	}
+	info.Summary = "Detects unexplained numeric literals"
+	info.Before = `
+if retries > 5 {
+	return errTooManyRetries
+}`
+	info.After = `
+const maxRetries = 5
+if retries > maxRetries {
+	return errTooManyRetries
+}`
+	info.Note = `Constant declarations, array lengths, make sizes, index and slice bounds,
+bit shifts and masks, unkeyed struct literals, values returned as named
+results, durations, file modes and strconv bases and bit sizes are not checked.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		c := &magicNumberChecker{
+			ctx:       ctx,
+			minValue:  constant.MakeInt64(int64(info.Params.Int("minValue"))),
+			skipTests: info.Params.Bool("skipTests"),
+		}
+		for _, s := range strings.Split(info.Params.String("allowedNumbers"), ",") {
+			if x := parseNumber(strings.TrimSpace(s)); x.Kind() != constant.Unknown {
+				c.allowed = append(c.allowed, x)
+			}
+		}
+		return c, nil
+	})
+}
+
+type magicNumberChecker struct {
+	ctx *linter.CheckerContext
+
+	allowed   []constant.Value
+	minValue  constant.Value
+	skipTests bool
+}
+
+func (c *magicNumberChecker) WalkFile(f *ast.File) {
+	if c.skipTests && strings.HasSuffix(c.ctx.Filename, "_test.go") {
+		return
+	}
+	for _, decl := range f.Decls {
+		ast.Inspect(decl, c.visit)
+	}
+}
+
+func (c *magicNumberChecker) visit(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.GenDecl:
+		return n.Tok != token.CONST
+	case *ast.FuncDecl:
+		c.walkFunc(n.Type, n.Body)
+		return false
+	case *ast.FuncLit:
+		c.walkFunc(n.Type, n.Body)
+		return false
+	case *ast.ArrayType:
+		c.walk(n.Elt)
+		return false
+	case *ast.IndexExpr:
+		// Offsets into fixed-layout data, like in `buf[4]`.
+		c.walk(n.X)
+		c.walkSkipLit(n.Index)
+		return false
+	case *ast.SliceExpr:
+		c.walk(n.X)
+		c.walkSkipLit(n.Low, n.High, n.Max)
+		return false
+	case *ast.CompositeLit:
+		// Unkeyed struct literals, like in `version{1, 21}`.
+		if _, ok := c.ctx.TypeOf(n).Underlying().(*types.Struct); ok {
+			c.walkSkipLit(n.Type)
+			c.walkSkipLit(n.Elts...)
+			return false
+		}
+	case *ast.ValueSpec:
+		// Values that are assigned to a variable are already named.
+		for _, v := range n.Values {
+			if !isNumberLit(v) {
+				c.walk(v)
+			}
+		}
+		return false
+	case *ast.KeyValueExpr:
+		// Struct field names explain the values, like in `Timeout: 30`.
+		if _, ok := n.Key.(*ast.Ident); ok && isNumberLit(n.Value) {
+			return false
+		}
+	case *ast.AssignStmt:
+		if isBitwiseOp(n.Tok) {
+			c.walkSkipLit(n.Rhs...)
+			c.walk(n.Lhs...)
+			return false
+		}
+	case *ast.BinaryExpr:
+		switch {
+		case isBitwiseOp(n.Op):
+			c.walkSkipLit(n.X, n.Y)
+			return false
+		case n.Op == token.MUL && c.isDuration(c.ctx.TypeOf(n)):
+			c.walkSkipLit(n.X, n.Y)
+			return false
+		}
+	case *ast.CallExpr:
+		c.walkCall(n)
+		return false
+	case *ast.UnaryExpr:
+		if lit, ok := n.X.(*ast.BasicLit); ok && (n.Op == token.SUB || n.Op == token.ADD) {
+			c.checkLit(n, n.Op.String()+lit.Value)
+			return false
+		}
+	case *ast.BasicLit:
+		c.checkLit(n, n.Value)
+	}
+	return true
+}
+
+func (c *magicNumberChecker) walk(nodes ...ast.Expr) {
+	for _, n := range nodes {
+		ast.Inspect(n, c.visit)
+	}
+}
+
+func (c *magicNumberChecker) walkSkipLit(nodes ...ast.Expr) {
+	for _, n := range nodes {
+		if n != nil && !isNumberLit(n) {
+			ast.Inspect(n, c.visit)
+		}
+	}
+}
+
+// walkFunc walks the function body. The names of the function results
+// explain the returned values, like in `return 4, true` for `(threshold int, ok bool)`.
+func (c *magicNumberChecker) walkFunc(typ *ast.FuncType, body *ast.BlockStmt) {
+	c.walk(typ)
+	if body == nil {
+		return
+	}
+	results := typ.Results
+	namedResults := results != nil && len(results.List) != 0 && len(results.List[0].Names) != 0
+	ast.Inspect(body, func(n ast.Node) bool {
+		if ret, ok := n.(*ast.ReturnStmt); ok && namedResults {
+			c.walkSkipLit(ret.Results...)
+			return false
+		}
+		return c.visit(n)
+	})
+}
+
+// walkCall walks the call arguments, skipping the literals passed
+// as make sizes, strconv bases, time.Duration and fs.FileMode parameters.
+func (c *magicNumberChecker) walkCall(call *ast.CallExpr) {
+	c.walk(call.Fun)
+	if isBuiltinCall(c.ctx.TypesInfo, call, "make") || magicNumberFreeFuncs[calledFuncName(c.ctx.TypesInfo, call)] {
+		c.walkSkipLit(call.Args...)
+		return
+	}
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		c.walk(call.Args...)
+		return
+	}
+	params := sig.Params()
+	for i, arg := range call.Args {
+		var typ types.Type
+		switch {
+		case sig.Variadic() && i >= params.Len()-1:
+			if slice, ok := params.At(params.Len() - 1).Type().(*types.Slice); ok {
+				typ = slice.Elem()
+			}
+		case i < params.Len():
+			typ = params.At(i).Type()
+		}
+		if typ != nil && (c.isDuration(typ) || c.isFileMode(typ)) {
+			c.walkSkipLit(arg)
+		} else {
+			c.walk(arg)
+		}
+	}
+}
+
+func (c *magicNumberChecker) checkLit(n ast.Node, text string) {
+	x := parseNumber(text)
+	if x.Kind() == constant.Unknown {
+		return
+	}
+	for _, allowed := range c.allowed {
+		if constant.Compare(x, token.EQL, allowed) {
+			return
+		}
+	}
+	abs := x
+	if constant.Sign(x) < 0 {
+		abs = constant.UnaryOp(token.SUB, x, 0)
+	}
+	if !constant.Compare(abs, token.GTR, c.minValue) {
+		return
+	}
+	c.ctx.Warn(n, "magic number %s, consider extracting it into a named constant", text)
+}
+
+func (c *magicNumberChecker) isDuration(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration"
+}
+
+func (c *magicNumberChecker) isFileMode(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.Obj().Pkg() != nil &&
+		named.Obj().Pkg().Path() == "io/fs" && named.Obj().Name() == "FileMode"
+}
+
+// magicNumberFreeFuncs lists the functions whose numeric arguments,
+// like bases and bit sizes, are well known and need no names.
+var magicNumberFreeFuncs = map[string]bool{
+	"strconv.AppendFloat": true,
+	"strconv.AppendInt":   true,
+	"strconv.AppendUint":  true,
+	"strconv.FormatFloat": true,
+	"strconv.FormatInt":   true,
+	"strconv.FormatUint":  true,
+	"strconv.ParseFloat":  true,
+	"strconv.ParseInt":    true,
+	"strconv.ParseUint":   true,
+}
+
+// isBitwiseOp reports whether op is a shift or a bit mask operation,
+// including the assignment forms like `<<=` and `&=`.
+func isBitwiseOp(op token.Token) bool {
+	switch op {
+	case token.SHL, token.SHR, token.AND, token.OR, token.XOR, token.AND_NOT,
+		token.SHL_ASSIGN, token.SHR_ASSIGN, token.AND_ASSIGN, token.OR_ASSIGN,
+		token.XOR_ASSIGN, token.AND_NOT_ASSIGN:
+		return true
+	}
+	return false
+}
+
+// isNumberLit reports whether x is an integer or float literal, possibly with a sign.
+func isNumberLit(x ast.Expr) bool {
+	if u, ok := x.(*ast.UnaryExpr); ok && (u.Op == token.SUB || u.Op == token.ADD) {
+		x = u.X
+	}
+	lit, ok := x.(*ast.BasicLit)
+	return ok && (lit.Kind == token.INT || lit.Kind == token.FLOAT)
+}
+
+// parseNumber returns the value of the integer or float literal text
+// with optional sign, or an unknown value if text is not a number.
+func parseNumber(text string) constant.Value {
+	neg := strings.HasPrefix(text, "-")
+	text = strings.TrimLeft(text, "+-")
+	kind := token.INT
+	x := constant.MakeFromLiteral(text, kind, 0)
+	if x.Kind() == constant.Unknown {
+		kind = token.FLOAT
+		x = constant.MakeFromLiteral(text, kind, 0)
+	}
+	if neg && x.Kind() != constant.Unknown {
+		x = constant.UnaryOp(token.SUB, x, 0)
+	}
+	return x
+}
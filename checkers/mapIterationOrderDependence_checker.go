@@ -0,0 +1,263 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "mapIterationOrderDependence"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"funcNames": {
+			Value: "Marshal,String,Hash",
+			Usage: "comma-separated name parts of the functions that need a deterministic output",
+		},
+		"aggressive": {
+			Value: false,
+			Usage: "whether to report map iteration output writes in all functions",
+		},
+	}
+	info.Summary = "Detects code that depends on the random map iteration order"
+	info.Before = `
+var keys []string
+for k := range m {
+	keys = append(keys, k)
+}
+return keys`
+	info.After = `
+var keys []string
+for k := range m {
+	keys = append(keys, k)
+}
+sort.Strings(keys)
+return keys`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&mapIterationOrderDependenceChecker{
+			ctx:        ctx,
+			funcNames:  splitPatterns(info.Params.String("funcNames")),
+			aggressive: info.Params.Bool("aggressive"),
+		}), nil
+	})
+}
+
+type mapIterationOrderDependenceChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	funcNames  []string
+	aggressive bool
+}
+
+func (c *mapIterationOrderDependenceChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	checkWrites := c.aggressive || c.needsDeterministicOutput(decl.Name.Name)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		rng, ok := n.(*ast.RangeStmt)
+		if !ok {
+			return true
+		}
+		if _, ok := c.ctx.TypeOf(rng.X).Underlying().(*types.Map); !ok {
+			return true
+		}
+		c.checkAppends(decl, rng)
+		if checkWrites {
+			if call := c.findWrite(rng.Body); call != nil {
+				c.ctx.Warn(rng, "%s writes entries of map %s in random iteration order; sort the keys first",
+					call.Fun, rng.X)
+			}
+		}
+		return true
+	})
+}
+
+func (c *mapIterationOrderDependenceChecker) needsDeterministicOutput(name string) bool {
+	for _, part := range c.funcNames {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAppends reports slices that are filled inside the map range loop
+// and then returned or compared without sorting.
+func (c *mapIterationOrderDependenceChecker) checkAppends(decl *ast.FuncDecl, rng *ast.RangeStmt) {
+	var slices []types.Object
+	seen := make(map[types.Object]bool)
+	ast.Inspect(rng.Body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		call := astcast.ToCallExpr(assign.Rhs[0])
+		if !isBuiltinCall(c.ctx.TypesInfo, call, "append") {
+			return true
+		}
+		id, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok {
+			return true
+		}
+		obj := c.ctx.TypesInfo.ObjectOf(id)
+		if obj == nil || seen[obj] || obj.Pos() < decl.Pos() || obj.Pos() > rng.Pos() {
+			// Only check the local slices declared before the loop.
+			return true
+		}
+		seen[obj] = true
+		slices = append(slices, obj)
+		return true
+	})
+
+	for _, obj := range slices {
+		if c.isSortedAfter(decl, rng, obj) {
+			continue
+		}
+		if use := c.orderedUse(decl, rng, obj); use != "" {
+			c.ctx.Warn(rng, "%s is filled while ranging over map %s and %s unsorted; map iteration order is random, sort %s first",
+				obj.Name(), rng.X, use, obj.Name())
+		}
+	}
+}
+
+func (c *mapIterationOrderDependenceChecker) isSortedAfter(decl *ast.FuncDecl, rng *ast.RangeStmt, obj types.Object) bool {
+	sorted := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || call.Pos() < rng.End() {
+			return !sorted
+		}
+		fn := calledFunc(c.ctx.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil {
+			return !sorted
+		}
+		isSortFunc := fn.Pkg().Path() == "sort" ||
+			(fn.Pkg().Path() == "slices" && strings.HasPrefix(fn.Name(), "Sort"))
+		if isSortFunc && len(call.Args) != 0 && c.mentions(call.Args[0], obj) {
+			sorted = true
+		}
+		return !sorted
+	})
+	return sorted
+}
+
+// orderedUse returns a description of the first use of obj
+// that depends on the elements order or an empty string.
+func (c *mapIterationOrderDependenceChecker) orderedUse(decl *ast.FuncDecl, rng *ast.RangeStmt, obj types.Object) string {
+	use := ""
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if use != "" || (n != nil && n.End() < rng.End()) {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				if c.isSliceOf(result, obj) {
+					use = "returned"
+				}
+			}
+			if len(n.Results) == 0 && c.isNamedResult(decl, obj) {
+				use = "returned"
+			}
+		case *ast.CallExpr:
+			switch calledFuncName(c.ctx.TypesInfo, n) {
+			case "reflect.DeepEqual", "slices.Equal", "bytes.Equal":
+				for _, arg := range n.Args {
+					if c.isSliceOf(arg, obj) {
+						use = "compared"
+					}
+				}
+			}
+		}
+		return use == ""
+	})
+	return use
+}
+
+func (c *mapIterationOrderDependenceChecker) isNamedResult(decl *ast.FuncDecl, obj types.Object) bool {
+	results := decl.Type.Results
+	if results == nil {
+		return false
+	}
+	for _, field := range results.List {
+		for _, name := range field.Names {
+			if c.ctx.TypesInfo.ObjectOf(name) == obj {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *mapIterationOrderDependenceChecker) isSliceOf(x ast.Expr, obj types.Object) bool {
+	for {
+		switch e := x.(type) {
+		case *ast.ParenExpr:
+			x = e.X
+		case *ast.SliceExpr:
+			x = e.X
+		case *ast.Ident:
+			return c.ctx.TypesInfo.ObjectOf(e) == obj
+		default:
+			return false
+		}
+	}
+}
+
+func (c *mapIterationOrderDependenceChecker) mentions(x ast.Expr, obj types.Object) bool {
+	found := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// findWrite returns the first call that writes the output inside body.
+func (c *mapIterationOrderDependenceChecker) findWrite(body *ast.BlockStmt) *ast.CallExpr {
+	var write *ast.CallExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || write != nil {
+			return write == nil
+		}
+		fn := calledFunc(c.ctx.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil {
+			return true
+		}
+		if fn.Type().(*types.Signature).Recv() != nil {
+			switch fn.Name() {
+			case "Write", "WriteString", "WriteByte", "WriteRune", "Encode":
+				write = call
+			}
+			return write == nil
+		}
+		switch fn.Pkg().Path() + "." + fn.Name() {
+		case "fmt.Fprint", "fmt.Fprintf", "fmt.Fprintln",
+			"fmt.Print", "fmt.Printf", "fmt.Println",
+			"io.WriteString":
+			write = call
+		}
+		return write == nil
+	})
+	return write
+}
@@ -0,0 +1,197 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "mapLookupTwice"
+	info.Tags = []string{"performance", "experimental"}
+	info.Summary = "Detects repeated identical map lookups"
+	info.Before = `
+if m[key].Active {
+	use(m[key].Name)
+}`
+	info.After = `
+if v := m[key]; v.Active {
+	use(v.Name)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&mapLookupTwiceChecker{ctx: ctx}), nil
+	})
+}
+
+type mapLookupTwiceChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// lookups maps a lookup expression text to its first occurrence.
+	lookups map[string]mapLookup
+	// reported holds the lookups that were already reported.
+	reported map[string]bool
+	// collecting is false when new lookups should not be remembered.
+	collecting bool
+
+	// warned holds the reported lookup expressions of the current file.
+	// The if statement bodies are walked twice: as a part of the if
+	// statement and then statement by statement, so a lookup that
+	// repeats an already reported one is not reported again.
+	warned map[*ast.IndexExpr]bool
+}
+
+type mapLookup struct {
+	expr *ast.IndexExpr
+	// deps are the names the lookup depends on.
+	deps []string
+}
+
+func (c *mapLookupTwiceChecker) EnterFile(f *ast.File) bool {
+	c.warned = make(map[*ast.IndexExpr]bool)
+	return !isRuleguardFile(f)
+}
+
+func (c *mapLookupTwiceChecker) VisitStmt(stmt ast.Stmt) {
+	c.lookups = make(map[string]mapLookup)
+	c.reported = make(map[string]bool)
+	c.collecting = true
+
+	switch stmt := stmt.(type) {
+	case *ast.IfStmt:
+		// Only report body lookups that repeat the condition lookups.
+		if stmt.Init != nil {
+			c.walk(stmt.Init)
+		}
+		c.walk(stmt.Cond)
+		c.collecting = false
+		c.walk(stmt.Body)
+	case *ast.ExprStmt, *ast.AssignStmt, *ast.ReturnStmt, *ast.SendStmt,
+		*ast.IncDecStmt, *ast.DeclStmt, *ast.GoStmt, *ast.DeferStmt:
+		c.walk(stmt)
+	}
+}
+
+func (c *mapLookupTwiceChecker) walk(root ast.Node) {
+	ast.Inspect(root, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			c.walkAssign(n)
+			return false
+		case *ast.IncDecStmt:
+			c.walkWrite(n.X)
+			return false
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				c.walkWrite(n.X)
+				return false
+			}
+		case *ast.CallExpr:
+			for _, arg := range n.Args {
+				c.walk(arg)
+			}
+			c.walk(n.Fun)
+			// Maps passed to a function can be modified by it.
+			for _, arg := range n.Args {
+				if id, ok := arg.(*ast.Ident); ok && typep.IsMap(c.ctx.TypeOf(id).Underlying()) {
+					c.invalidate(id.Name)
+				}
+			}
+			return false
+		case *ast.IndexExpr:
+			c.visitLookup(n)
+		}
+		return true
+	})
+}
+
+func (c *mapLookupTwiceChecker) walkAssign(assign *ast.AssignStmt) {
+	commaOk := len(assign.Lhs) == 2 && len(assign.Rhs) == 1
+	for _, rhs := range assign.Rhs {
+		if index, ok := rhs.(*ast.IndexExpr); ok && commaOk {
+			// v, ok := m[k] is not the same thing as m[k].
+			c.walk(index.X)
+			c.walk(index.Index)
+			continue
+		}
+		c.walk(rhs)
+	}
+	for _, lhs := range assign.Lhs {
+		c.walkWrite(lhs)
+	}
+}
+
+// walkWrite handles x that is being modified.
+func (c *mapLookupTwiceChecker) walkWrite(x ast.Expr) {
+	switch x := x.(type) {
+	case *ast.Ident:
+		c.invalidate(x.Name)
+	case *ast.IndexExpr:
+		c.walk(x.Index)
+		c.walkWrite(x.X)
+	case *ast.SelectorExpr:
+		// m[k].f = v is not permitted for maps, so the
+		// selector base is a value that can't be a map element.
+		c.walk(x.X)
+		c.walkWrite(x.X)
+	case *ast.StarExpr:
+		c.walk(x.X)
+	default:
+		c.walk(x)
+	}
+}
+
+func (c *mapLookupTwiceChecker) invalidate(name string) {
+	for key, lookup := range c.lookups {
+		for _, dep := range lookup.deps {
+			if dep == name {
+				delete(c.lookups, key)
+				break
+			}
+		}
+	}
+}
+
+func (c *mapLookupTwiceChecker) visitLookup(index *ast.IndexExpr) {
+	if _, ok := c.ctx.TypeOf(index.X).Underlying().(*types.Map); !ok {
+		return
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, index.X) || !typep.SideEffectFree(c.ctx.TypesInfo, index.Index) {
+		return
+	}
+	key := astfmt.Sprint(index)
+	if prev, ok := c.lookups[key]; ok {
+		if !c.reported[key] {
+			c.reported[key] = true
+			if !c.warned[prev.expr] && !c.warned[index] {
+				c.warned[index] = true
+				c.warn(index)
+			}
+		}
+		return
+	}
+	if !c.collecting {
+		return
+	}
+	var deps []string
+	ast.Inspect(index, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			deps = append(deps, id.Name)
+		}
+		return true
+	})
+	c.lookups[key] = mapLookup{expr: index, deps: deps}
+}
+
+func (c *mapLookupTwiceChecker) warn(cause *ast.IndexExpr) {
+	c.ctx.Warn(cause, "%s lookup is repeated; store the result in a local variable", cause)
+}
@@ -0,0 +1,171 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "mathDivisionTruncation"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowedDivisors": {
+			Value: "2,1000,1024",
+			Usage: "comma-separated list of constant divisors that are never reported",
+		},
+	}
+	info.Summary = "Detects integer divisions that truncate the result where the float one was likely intended"
+	info.Before = `ratio := float64(done / total)`
+	info.After = `ratio := float64(done) / float64(total)`
+	info.Note = "Integer literal divisions like 1/2 are reported in the float contexts and the products"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		c := &mathDivisionTruncationChecker{ctx: ctx}
+		for _, s := range strings.Split(info.Params.String("allowedDivisors"), ",") {
+			if x := parseNumber(strings.TrimSpace(s)); x.Kind() == constant.Int {
+				c.allowed = append(c.allowed, x)
+			}
+		}
+		return astwalk.WalkerForExpr(c), nil
+	})
+}
+
+type mathDivisionTruncationChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	allowed []constant.Value
+}
+
+func (c *mathDivisionTruncationChecker) VisitExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.CallExpr:
+		c.checkConversion(expr)
+	case *ast.BinaryExpr:
+		switch expr.Op {
+		case token.QUO:
+			if q, ok := c.truncatedQuotient(expr); ok && isFloatType(c.ctx.TypeOf(expr)) {
+				c.ctx.Warn(expr, "%s constant-folds to %s with the integer division; use %s.0 / %s",
+					expr, q, expr.X, expr.Y)
+			}
+		case token.MUL:
+			c.checkFactor(expr.X)
+			c.checkFactor(expr.Y)
+		}
+	}
+}
+
+// checkConversion reports float conversions of the integer division.
+func (c *mathDivisionTruncationChecker) checkConversion(call *ast.CallExpr) {
+	if len(call.Args) != 1 || !c.ctx.TypesInfo.Types[call.Fun].IsType() || !isFloatType(c.ctx.TypeOf(call)) {
+		return
+	}
+	div, ok := astutil.Unparen(call.Args[0]).(*ast.BinaryExpr)
+	if !ok || div.Op != token.QUO || c.ctx.TypesInfo.Types[div].Value != nil {
+		return
+	}
+	if !isIntegerType(c.ctx.TypeOf(div)) || c.isAllowedDivisor(div.Y) {
+		return
+	}
+
+	conv := astfmt.Sprint(call.Fun)
+	if mul, ok := astutil.Unparen(div.X).(*ast.BinaryExpr); ok && mul.Op == token.MUL && c.isHundred(mul.Y) {
+		c.ctx.Warn(call, "%s truncates the percentage before the conversion; use %s(%s) * 100 / %s(%s)",
+			call, conv, astutil.Unparen(mul.X), conv, astutil.Unparen(div.Y))
+		return
+	}
+	c.ctx.Warn(call, "%s truncates the integer division before the conversion; use %s(%s) / %s(%s)",
+		call, conv, astutil.Unparen(div.X), conv, astutil.Unparen(div.Y))
+}
+
+// checkFactor reports the integer literals division used as a factor.
+func (c *mathDivisionTruncationChecker) checkFactor(x ast.Expr) {
+	div, ok := astutil.Unparen(x).(*ast.BinaryExpr)
+	if !ok || isFloatType(c.ctx.TypeOf(div)) {
+		// Float typed divisions are reported on their own.
+		return
+	}
+	if q, ok := c.truncatedQuotient(div); ok {
+		c.ctx.Warn(div, "%s constant-folds to %s, so the product is truncated; multiply first or use the float constant",
+			div, q)
+	}
+}
+
+// truncatedQuotient returns the quotient of the integer literals division
+// if it has a non-zero remainder.
+func (c *mathDivisionTruncationChecker) truncatedQuotient(div *ast.BinaryExpr) (constant.Value, bool) {
+	if div.Op != token.QUO {
+		return nil, false
+	}
+	a, ok := c.intLiteral(div.X)
+	if !ok {
+		return nil, false
+	}
+	b, ok := c.intLiteral(div.Y)
+	if !ok || constant.Sign(b) == 0 || constant.Sign(constant.BinaryOp(a, token.REM, b)) == 0 {
+		return nil, false
+	}
+	return constant.BinaryOp(a, token.QUO_ASSIGN, b), true
+}
+
+// intLiteral returns the value of the integer literal x with optional sign.
+func (c *mathDivisionTruncationChecker) intLiteral(x ast.Expr) (constant.Value, bool) {
+	x = astutil.Unparen(x)
+	neg := false
+	if u, ok := x.(*ast.UnaryExpr); ok && (u.Op == token.SUB || u.Op == token.ADD) {
+		neg = u.Op == token.SUB
+		x = astutil.Unparen(u.X)
+	}
+	lit, ok := x.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return nil, false
+	}
+	v := constant.MakeFromLiteral(lit.Value, token.INT, 0)
+	if neg {
+		v = constant.UnaryOp(token.SUB, v, 0)
+	}
+	return v, v.Kind() == constant.Int
+}
+
+func (c *mathDivisionTruncationChecker) isAllowedDivisor(x ast.Expr) bool {
+	v := c.ctx.TypesInfo.Types[x].Value
+	if v == nil || v.Kind() != constant.Int {
+		return false
+	}
+	for _, allowed := range c.allowed {
+		if constant.Compare(v, token.EQL, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *mathDivisionTruncationChecker) isHundred(x ast.Expr) bool {
+	v := c.ctx.TypesInfo.Types[x].Value
+	return v != nil && constant.Compare(constant.ToInt(v), token.EQL, constant.MakeInt64(100))
+}
+
+func isFloatType(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsFloat != 0
+}
+
+func isIntegerType(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
@@ -0,0 +1,111 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "namedReturnShadow"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects named results shadowed in nested scopes of functions with bare returns"
+	info.Before = `
+func load() (data []byte, err error) {
+	if cached {
+		data, err := read()
+		_ = data
+	}
+	return
+}`
+	info.After = `
+func load() (data []byte, err error) {
+	if cached {
+		data, err = read()
+	}
+	return
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&namedReturnShadowChecker{ctx: ctx}), nil
+	})
+}
+
+type namedReturnShadowChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *namedReturnShadowChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || decl.Type.Results == nil {
+		return
+	}
+	results := make(map[types.Object]bool)
+	for _, field := range decl.Type.Results.List {
+		for _, name := range field.Names {
+			if obj := c.ctx.TypesInfo.ObjectOf(name); obj != nil && name.Name != "_" {
+				results[obj] = true
+			}
+		}
+	}
+	if len(results) == 0 {
+		return
+	}
+
+	var bareReturns []*ast.ReturnStmt
+	var defines []*ast.AssignStmt
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Function literals have their own results.
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 {
+				bareReturns = append(bareReturns, n)
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				defines = append(defines, n)
+			}
+		}
+		return true
+	})
+	if len(bareReturns) == 0 {
+		return
+	}
+
+	for _, assign := range defines {
+		c.checkDefine(assign, results, bareReturns)
+	}
+}
+
+// checkDefine reports the first named result that is shadowed by assign.
+func (c *namedReturnShadowChecker) checkDefine(assign *ast.AssignStmt, results map[types.Object]bool, bareReturns []*ast.ReturnStmt) {
+	for _, lhs := range assign.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		obj := c.ctx.TypesInfo.Defs[id]
+		if obj == nil || obj.Parent() == nil || obj.Parent().Parent() == nil {
+			continue
+		}
+		_, outer := obj.Parent().Parent().LookupParent(id.Name, id.Pos())
+		if !results[outer] {
+			continue
+		}
+		scopeEnd := obj.Parent().End()
+		for _, ret := range bareReturns {
+			if ret.Pos() > scopeEnd {
+				line := c.ctx.FileSet.Position(ret.Pos()).Line
+				c.ctx.Warn(assign, "%s shadows the named result, the bare return at line %d returns the outer %s; use = to assign it",
+					id, line, id)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "noCopyReturnValue"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"cloneMethods": {
+			Value: "Clone,Copy",
+			Usage: "comma-separated list of methods that make the type copying explicit, such types are not reported",
+		},
+		"skipAtomicWrappers": {
+			Value: true,
+			Usage: "whether to skip the types with a single sync/atomic field",
+		},
+	}
+	info.Summary = "Detects exported constructors that return the types with sync primitives by value"
+	info.Before = `
+type Cache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+func NewCache() Cache { return Cache{items: map[string]string{}} }`
+	info.After = `
+type Cache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+func NewCache() *Cache { return &Cache{items: map[string]string{}} }`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		c := &noCopyReturnValueChecker{
+			ctx:                ctx,
+			cloneMethods:       make(map[string]bool),
+			skipAtomicWrappers: info.Params.Bool("skipAtomicWrappers"),
+		}
+		for _, name := range strings.Split(info.Params.String("cloneMethods"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				c.cloneMethods[name] = true
+			}
+		}
+		return astwalk.WalkerForFuncDecl(c), nil
+	})
+}
+
+type noCopyReturnValueChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	cloneMethods       map[string]bool
+	skipAtomicWrappers bool
+}
+
+func (c *noCopyReturnValueChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Recv != nil || !decl.Name.IsExported() || !strings.HasPrefix(decl.Name.Name, "New") {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	results := fn.Type().(*types.Signature).Results()
+	for i := 0; i < results.Len(); i++ {
+		named, ok := results.At(i).Type().(*types.Named)
+		if !ok || c.isExempt(named) {
+			continue
+		}
+		path, lock := c.findLock(named, nil, map[types.Type]bool{})
+		if lock == nil {
+			continue
+		}
+		typeName := types.TypeString(named, types.RelativeTo(c.ctx.Pkg))
+		c.ctx.Warn(decl.Name, "%s returns %s by value, but its %s field is %s that must not be copied; return *%s instead",
+			decl.Name, typeName, strings.Join(path, "."), types.TypeString(lock, (*types.Package).Name), typeName)
+	}
+}
+
+// isExempt reports whether the named type copying is made explicit
+// or if it's a tiny wrapper around an atomic value.
+func (c *noCopyReturnValueChecker) isExempt(named *types.Named) bool {
+	methods := types.NewMethodSet(types.NewPointer(named))
+	for i := 0; i < methods.Len(); i++ {
+		if c.cloneMethods[methods.At(i).Obj().Name()] {
+			return true
+		}
+	}
+	if !c.skipAtomicWrappers {
+		return false
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || st.NumFields() != 1 {
+		return false
+	}
+	field, ok := st.Field(0).Type().(*types.Named)
+	return ok && field.Obj().Pkg() != nil && field.Obj().Pkg().Path() == "sync/atomic"
+}
+
+// noCopySyncTypes lists the sync types that must not be copied after the first use.
+var noCopySyncTypes = map[string]bool{
+	"Mutex":     true,
+	"RWMutex":   true,
+	"WaitGroup": true,
+	"Cond":      true,
+	"Once":      true,
+	"Map":       true,
+	"Pool":      true,
+}
+
+// findLock returns the path to the first field of typ that has
+// a sync or sync/atomic type and the type of that field.
+//
+// The sync and sync/atomic types are never descended into,
+// neither are the unexported fields of other packages types.
+func (c *noCopyReturnValueChecker) findLock(typ types.Type, prefix []string, visited map[types.Type]bool) (path []string, lock types.Type) {
+	if visited[typ] {
+		return nil, nil
+	}
+	visited[typ] = true
+
+	if named, ok := typ.(*types.Named); ok {
+		if pkg := named.Obj().Pkg(); pkg != nil && (pkg.Path() == "sync" || pkg.Path() == "sync/atomic") {
+			if len(prefix) != 0 && c.isLockType(named) {
+				return prefix, named
+			}
+			return nil, nil
+		}
+	}
+	switch u := typ.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if !field.Exported() && field.Pkg() != c.ctx.Pkg {
+				continue
+			}
+			if path, lock := c.findLock(field.Type(), append(prefix, field.Name()), visited); lock != nil {
+				return path, lock
+			}
+		}
+	case *types.Array:
+		return c.findLock(u.Elem(), prefix, visited)
+	}
+	return nil, nil
+}
+
+// isLockType reports whether named is a sync or sync/atomic type
+// that must not be copied.
+func (c *noCopyReturnValueChecker) isLockType(named *types.Named) bool {
+	if named.Obj().Pkg().Path() == "sync" {
+		return noCopySyncTypes[named.Obj().Name()]
+	}
+	_, ok := named.Underlying().(*types.Struct)
+	return ok
+}
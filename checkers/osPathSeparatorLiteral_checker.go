@@ -0,0 +1,220 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "osPathSeparatorLiteral"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects file paths built with hardcoded separators that are passed to the os and path/filepath functions"
+	info.Before = `f, err := os.Open(dir + "/" + name)`
+	info.After = `f, err := os.Open(filepath.Join(dir, name))`
+	info.Note = "Paths used with io/fs, embed, net/http and path packages require forward slashes and are not reported"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&osPathSeparatorLiteralChecker{ctx: ctx}), nil
+	})
+}
+
+type osPathSeparatorLiteralChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// defs maps the local variables assigned exactly once to their values.
+	defs map[types.Object]ast.Expr
+	// slashed is a set of variables that are also used as slash-separated paths.
+	slashed map[types.Object]bool
+	// reported is a set of already reported path expressions.
+	reported map[ast.Expr]bool
+}
+
+// osPathFuncs lists the functions that expect the OS-specific file paths.
+var osPathFuncs = map[string]bool{
+	"os.Open":                    true,
+	"os.OpenFile":                true,
+	"os.Create":                  true,
+	"os.Stat":                    true,
+	"os.Lstat":                   true,
+	"os.Mkdir":                   true,
+	"os.MkdirAll":                true,
+	"os.Remove":                  true,
+	"os.RemoveAll":               true,
+	"os.Rename":                  true,
+	"os.ReadFile":                true,
+	"os.WriteFile":               true,
+	"os.ReadDir":                 true,
+	"os.Chdir":                   true,
+	"os.Chmod":                   true,
+	"io/ioutil.ReadFile":         true,
+	"io/ioutil.WriteFile":        true,
+	"io/ioutil.ReadDir":          true,
+	"path/filepath.Abs":          true,
+	"path/filepath.Base":         true,
+	"path/filepath.Clean":        true,
+	"path/filepath.Dir":          true,
+	"path/filepath.EvalSymlinks": true,
+	"path/filepath.Ext":          true,
+	"path/filepath.Glob":         true,
+	"path/filepath.Join":         true,
+	"path/filepath.Rel":          true,
+	"path/filepath.Split":        true,
+	"path/filepath.Walk":         true,
+	"path/filepath.WalkDir":      true,
+}
+
+// slashPathPkgs lists the packages that require forward slashes in paths.
+var slashPathPkgs = map[string]bool{
+	"io/fs":    true,
+	"embed":    true,
+	"path":     true,
+	"net/http": true,
+	"net/url":  true,
+}
+
+func (c *osPathSeparatorLiteralChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.defs = make(map[types.Object]ast.Expr)
+	c.slashed = make(map[types.Object]bool)
+	c.reported = make(map[ast.Expr]bool)
+
+	assigned := make(map[types.Object]int)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				id, ok := lhs.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				obj := c.ctx.TypesInfo.ObjectOf(id)
+				if obj == nil {
+					continue
+				}
+				assigned[obj]++
+				if len(n.Lhs) == len(n.Rhs) {
+					c.defs[obj] = n.Rhs[i]
+				}
+			}
+		case *ast.CallExpr:
+			fn := calledFunc(c.ctx.TypesInfo, n)
+			if fn == nil || fn.Pkg() == nil || !slashPathPkgs[fn.Pkg().Path()] {
+				return true
+			}
+			for _, arg := range n.Args {
+				if id, ok := astutil.Unparen(arg).(*ast.Ident); ok {
+					c.slashed[c.ctx.TypesInfo.ObjectOf(id)] = true
+				}
+			}
+		}
+		return true
+	})
+	for obj, n := range assigned {
+		if n != 1 {
+			delete(c.defs, obj)
+		}
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fn := calledFunc(c.ctx.TypesInfo, call)
+		if fn == nil || !osPathFuncs[fn.FullName()] {
+			return true
+		}
+		for _, arg := range call.Args {
+			c.checkPath(fn, arg)
+		}
+		return true
+	})
+}
+
+func (c *osPathSeparatorLiteralChecker) checkPath(fn *types.Func, arg ast.Expr) {
+	x := astutil.Unparen(arg)
+	if id, ok := x.(*ast.Ident); ok {
+		obj := c.ctx.TypesInfo.ObjectOf(id)
+		if c.slashed[obj] || c.defs[obj] == nil {
+			return
+		}
+		x = astutil.Unparen(c.defs[obj])
+	}
+	if c.reported[x] {
+		return
+	}
+	sep := c.separator(x)
+	if sep == "" {
+		return
+	}
+	c.reported[x] = true
+	c.ctx.Warn(x, "%s builds a file path for %s.%s with the hardcoded %q separator; use filepath.Join instead",
+		x, fn.Pkg().Name(), fn.Name(), sep)
+}
+
+// separator returns the path separator hardcoded into the x path construction,
+// or an empty string if x is not a path construction.
+func (c *osPathSeparatorLiteralChecker) separator(x ast.Expr) string {
+	var parts []string
+	switch x := x.(type) {
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD || c.ctx.TypesInfo.Types[x].Value != nil {
+			return ""
+		}
+		for _, operand := range c.concatOperands(x) {
+			if s, ok := c.stringConst(operand); ok {
+				parts = append(parts, s)
+			}
+		}
+	case *ast.CallExpr:
+		if calledFuncName(c.ctx.TypesInfo, x) != "fmt.Sprintf" || len(x.Args) < 2 {
+			return ""
+		}
+		if s, ok := c.stringConst(x.Args[0]); ok {
+			parts = append(parts, s)
+		}
+	}
+
+	sep := ""
+	for _, s := range parts {
+		if strings.Contains(s, "://") {
+			// URLs always use forward slashes.
+			return ""
+		}
+		switch {
+		case strings.Contains(s, "/"):
+			sep = "/"
+		case strings.Contains(s, `\`) && sep == "" && !strings.HasSuffix(c.ctx.Filename, "_windows.go"):
+			sep = `\`
+		}
+	}
+	return sep
+}
+
+// concatOperands returns the operands of the x string concatenation chain.
+func (c *osPathSeparatorLiteralChecker) concatOperands(x ast.Expr) []ast.Expr {
+	bin, ok := astutil.Unparen(x).(*ast.BinaryExpr)
+	if !ok || bin.Op != token.ADD {
+		return []ast.Expr{x}
+	}
+	return append(c.concatOperands(bin.X), c.concatOperands(bin.Y)...)
+}
+
+func (c *osPathSeparatorLiteralChecker) stringConst(x ast.Expr) (string, bool) {
+	tv := c.ctx.TypesInfo.Types[x]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
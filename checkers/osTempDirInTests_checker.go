@@ -0,0 +1,186 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astequal"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "osTempDirInTests"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects temporary directories in tests that could use t.TempDir"
+	info.Before = `
+dir, err := os.MkdirTemp("", "test")
+if err != nil {
+	t.Fatal(err)
+}
+defer os.RemoveAll(dir)`
+	info.After = `dir := t.TempDir()`
+	info.Note = "Only _test.go files are checked."
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&osTempDirInTestsChecker{ctx: ctx}), nil
+	})
+}
+
+type osTempDirInTestsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	fixes map[*ast.CallExpr]linter.QuickFix
+}
+
+func (c *osTempDirInTestsChecker) EnterFile(f *ast.File) bool {
+	return strings.HasSuffix(c.ctx.Filename, "_test.go")
+}
+
+func (c *osTempDirInTestsChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || decl.Recv != nil || decl.Name.Name == "TestMain" {
+		return
+	}
+	t := testingParam(c.ctx.TypesInfo, decl.Type.Params)
+	if t == nil {
+		return
+	}
+	c.fixes = make(map[*ast.CallExpr]linter.QuickFix)
+	c.checkBody(decl.Body, t)
+}
+
+func (c *osTempDirInTestsChecker) checkBody(body *ast.BlockStmt, t *ast.Ident) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Subtests and other closures may have their own t.
+			if inner := testingParam(c.ctx.TypesInfo, n.Type.Params); inner != nil {
+				c.checkBody(n.Body, inner)
+				return false
+			}
+		case *ast.BlockStmt:
+			c.collectFixes(n.List, t)
+		case *ast.CaseClause:
+			c.collectFixes(n.Body, t)
+		case *ast.CommClause:
+			c.collectFixes(n.Body, t)
+		case *ast.CallExpr:
+			c.checkCall(n, t)
+		}
+		return true
+	})
+}
+
+func (c *osTempDirInTestsChecker) checkCall(call *ast.CallExpr, t *ast.Ident) {
+	fn := calledFuncName(c.ctx.TypesInfo, call)
+	switch fn {
+	case "io/ioutil.TempDir", "os.MkdirTemp", "io/ioutil.TempFile", "os.CreateTemp":
+	default:
+		return
+	}
+	if len(call.Args) != 2 || !c.isDefaultTempDir(call.Args[0]) {
+		return
+	}
+
+	if fn == "io/ioutil.TempFile" || fn == "os.CreateTemp" {
+		c.ctx.Warn(call, "%s can use %s.TempDir() as its directory, which is removed automatically when the test ends",
+			call, t)
+		return
+	}
+	const format = "%s can be replaced with %s.TempDir(), which is removed automatically when the test ends"
+	if fix, ok := c.fixes[call]; ok {
+		c.ctx.WarnFixable(call, fix, format, call, t)
+		return
+	}
+	c.ctx.Warn(call, format, call, t)
+}
+
+// collectFixes finds the statements sequences like
+//
+//	dir, err := os.MkdirTemp("", "x")
+//	if err != nil { ... }
+//	defer os.RemoveAll(dir)
+//
+// that can be replaced by a single `dir := t.TempDir()` statement.
+func (c *osTempDirInTestsChecker) collectFixes(list []ast.Stmt, t *ast.Ident) {
+	for i := 0; i+2 < len(list); i++ {
+		assign := astcast.ToAssignStmt(list[i])
+		if assign.Tok != token.DEFINE || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			continue
+		}
+		call := astcast.ToCallExpr(assign.Rhs[0])
+		switch calledFuncName(c.ctx.TypesInfo, call) {
+		case "io/ioutil.TempDir", "os.MkdirTemp":
+		default:
+			continue
+		}
+		dir := astcast.ToIdent(assign.Lhs[0])
+		errVar := astcast.ToIdent(assign.Lhs[1])
+		if dir.Name == "_" || errVar.Name == "_" {
+			continue
+		}
+		if !c.isErrCheck(list[i+1], errVar) || !c.isRemoveAll(list[i+2], dir) {
+			continue
+		}
+		// Both variables must be declared by this statement,
+		// so dir can be redeclared and err is not used outside of the list.
+		defs := c.ctx.TypesInfo.Defs
+		errObj := defs[errVar]
+		if defs[dir] == nil || errObj == nil || c.usesObject(list[i+3:], errObj) {
+			continue
+		}
+		c.fixes[call] = linter.QuickFix{
+			From:        assign.Pos(),
+			To:          list[i+2].End(),
+			Replacement: []byte(dir.Name + " := " + t.Name + ".TempDir()"),
+		}
+	}
+}
+
+func (c *osTempDirInTestsChecker) isErrCheck(stmt ast.Stmt, errVar *ast.Ident) bool {
+	ifStmt := astcast.ToIfStmt(stmt)
+	if ifStmt.Init != nil || ifStmt.Else != nil {
+		return false
+	}
+	cond := astcast.ToBinaryExpr(ifStmt.Cond)
+	return cond.Op == token.NEQ &&
+		astequal.Expr(cond.X, errVar) &&
+		isNil(c.ctx.TypesInfo, cond.Y)
+}
+
+func (c *osTempDirInTestsChecker) isRemoveAll(stmt ast.Stmt, dir *ast.Ident) bool {
+	d, ok := stmt.(*ast.DeferStmt)
+	return ok &&
+		calledFuncName(c.ctx.TypesInfo, d.Call) == "os.RemoveAll" &&
+		len(d.Call.Args) == 1 &&
+		astequal.Expr(d.Call.Args[0], dir)
+}
+
+func (c *osTempDirInTestsChecker) usesObject(list []ast.Stmt, obj types.Object) bool {
+	found := false
+	for _, stmt := range list {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == obj {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// isDefaultTempDir reports whether x selects the default temporary directory.
+func (c *osTempDirInTestsChecker) isDefaultTempDir(x ast.Expr) bool {
+	x = astutil.Unparen(x)
+	if call, ok := x.(*ast.CallExpr); ok {
+		return calledFuncName(c.ctx.TypesInfo, call) == "os.TempDir"
+	}
+	lit, ok := x.(*ast.BasicLit)
+	return ok && lit.Value == `""`
+}
@@ -0,0 +1,218 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "panicInLibrary"
+	info.Tags = []string{"diagnostic", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowFuncs": {
+			Value: "",
+			Usage: "regexp that matches the names of functions that are permitted to panic",
+		},
+	}
+	info.Summary = "Detects panics reachable from the exported API of non-main packages"
+	info.Before = `
+func Parse(s string) *Config {
+	if s == "" {
+		panic(errors.New("empty config"))
+	}
+	return parse(s)
+}`
+	info.After = `
+func Parse(s string) (*Config, error) {
+	if s == "" {
+		return nil, errors.New("empty config")
+	}
+	return parse(s), nil
+}`
+	info.Note = `Functions with Must prefix, init functions, panics that are recovered in the package
+and panics with a constant message, like panic("unreachable"), are permitted.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		c := &panicInLibraryChecker{ctx: ctx}
+		if pattern := info.Params.String("allowFuncs"); pattern != "" {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, err
+			}
+			c.allowFuncsRE = re
+		}
+		return c, nil
+	})
+}
+
+type panicInLibraryChecker struct {
+	ctx *linter.CheckerContext
+
+	allowFuncsRE *regexp.Regexp
+
+	// callers maps unexported package functions to the
+	// names of the exported functions that call them.
+	callers map[*types.Func]string
+
+	// recovered is a set of objects that are referenced by the
+	// functions that call recover, like sentinel panic values and types.
+	recovered map[types.Object]bool
+}
+
+func (c *panicInLibraryChecker) WalkPackage(files []*ast.File) {
+	c.callers = make(map[*types.Func]string)
+	c.recovered = make(map[types.Object]bool)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Body == nil {
+				continue
+			}
+			if decl.Name.IsExported() {
+				c.collectCallees(decl)
+			}
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				c.collectRecovered(n.Body)
+			case *ast.FuncLit:
+				c.collectRecovered(n.Body)
+			}
+			return true
+		})
+	}
+}
+
+func (c *panicInLibraryChecker) collectCallees(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fn := calledFunc(c.ctx.TypesInfo, call)
+		if fn == nil || fn.Pkg() != c.ctx.Pkg || fn.Exported() {
+			return true
+		}
+		if _, ok := c.callers[fn]; !ok {
+			c.callers[fn] = decl.Name.Name
+		}
+		return true
+	})
+}
+
+func (c *panicInLibraryChecker) collectRecovered(body *ast.BlockStmt) {
+	if body == nil || !c.hasRecover(body) {
+		return
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if obj := c.ctx.TypesInfo.Uses[id]; obj != nil && obj.Pkg() == c.ctx.Pkg {
+				c.recovered[obj] = true
+			}
+		}
+		return true
+	})
+}
+
+func (c *panicInLibraryChecker) hasRecover(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Nested function literals are checked separately.
+			return false
+		case *ast.CallExpr:
+			if isBuiltinCall(c.ctx.TypesInfo, n, "recover") {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *panicInLibraryChecker) WalkFile(f *ast.File) {
+	if c.ctx.Pkg.Name() == "main" || strings.HasSuffix(c.ctx.Filename, "_test.go") {
+		return
+	}
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Body == nil || !c.canCheck(decl) {
+			continue
+		}
+		if decl.Name.IsExported() {
+			c.checkBody(decl.Body, func(call *ast.CallExpr) {
+				c.ctx.Warn(call, "panic in exported %s crashes the caller; return an error instead", decl.Name)
+			})
+			continue
+		}
+		fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+		if !ok {
+			continue
+		}
+		if caller, ok := c.callers[fn]; ok {
+			c.checkBody(decl.Body, func(call *ast.CallExpr) {
+				c.ctx.Warn(call, "panic in %s, called from exported %s, crashes the caller; return an error instead",
+					decl.Name, caller)
+			})
+		}
+	}
+}
+
+func (c *panicInLibraryChecker) canCheck(decl *ast.FuncDecl) bool {
+	name := decl.Name.Name
+	switch {
+	case name == "init" && decl.Recv == nil:
+		return false
+	case strings.HasPrefix(name, "Must") || strings.HasPrefix(name, "must"):
+		return false
+	case c.allowFuncsRE != nil && c.allowFuncsRE.MatchString(name):
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *panicInLibraryChecker) checkBody(body *ast.BlockStmt, warn func(*ast.CallExpr)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !isBuiltinCall(c.ctx.TypesInfo, call, "panic") || len(call.Args) != 1 {
+			return true
+		}
+		if !c.isConstMessage(call.Args[0]) && !c.isRecoveredValue(call.Args[0]) {
+			warn(call)
+		}
+		return true
+	})
+}
+
+// isConstMessage reports whether x is a constant string, like in `panic("unreachable")`.
+// Such panics usually assert the invariants instead of reporting the failures.
+func (c *panicInLibraryChecker) isConstMessage(x ast.Expr) bool {
+	tv := c.ctx.TypesInfo.Types[x]
+	return tv.Value != nil && tv.Value.Kind() == constant.String
+}
+
+// isRecoveredValue reports whether x is a package-level sentinel
+// value or a value of the package type that is handled by some recover call.
+func (c *panicInLibraryChecker) isRecoveredValue(x ast.Expr) bool {
+	if id, ok := astutil.Unparen(x).(*ast.Ident); ok {
+		if obj, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var); ok && c.recovered[obj] {
+			return true
+		}
+	}
+	typ := c.ctx.TypeOf(x)
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	return ok && c.recovered[named.Obj()]
+}
@@ -0,0 +1,264 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "parallelTestEnvMutation"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"interprocedural": {
+			Value: false,
+			Usage: "whether to inspect helpers that receive t one call level deep",
+		},
+	}
+	info.Summary = "Detects environment and working directory changes in parallel tests"
+	info.Before = `
+func TestFoo(t *testing.T) {
+	t.Parallel()
+	os.Setenv("MODE", "test")
+}`
+	info.After = `
+func TestFoo(t *testing.T) {
+	t.Setenv("MODE", "test")
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &parallelTestEnvMutationChecker{
+			ctx:             ctx,
+			interprocedural: info.Params.Bool("interprocedural"),
+		}, nil
+	})
+}
+
+// processStateMutators is a set of functions that change
+// the process-global state shared by all running tests.
+var processStateMutators = map[string]bool{
+	"os.Setenv":   true,
+	"os.Unsetenv": true,
+	"os.Clearenv": true,
+	"os.Chdir":    true,
+}
+
+type parallelTestEnvMutationChecker struct {
+	ctx *linter.CheckerContext
+
+	interprocedural bool
+
+	// funcs maps the package functions to their declarations.
+	funcs map[*types.Func]*ast.FuncDecl
+}
+
+// testScope describes a test or a subtest with its own t.
+type testScope struct {
+	parent   *testScope
+	parallel bool
+}
+
+// testEvent is a call that conflicts with a parallel test.
+type testEvent struct {
+	call  *ast.CallExpr
+	scope *testScope
+	// callee is a mutating function name that is called by the helper.
+	// It's empty if the call itself is the mutation.
+	callee string
+}
+
+type testScopeState struct {
+	scopes    map[types.Object]*testScope
+	setenvs   []testEvent
+	mutations []testEvent
+}
+
+func (c *parallelTestEnvMutationChecker) WalkPackage(files []*ast.File) {
+	if !c.interprocedural {
+		return
+	}
+	c.funcs = make(map[*types.Func]*ast.FuncDecl)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Body == nil {
+				continue
+			}
+			if fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func); ok {
+				c.funcs[fn] = decl
+			}
+		}
+	}
+}
+
+func (c *parallelTestEnvMutationChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Body == nil {
+			continue
+		}
+		if t := testingParam(c.ctx.TypesInfo, decl.Type.Params); t != nil {
+			c.checkTest(decl.Body, t)
+		}
+	}
+}
+
+func (c *parallelTestEnvMutationChecker) checkTest(body *ast.BlockStmt, t *ast.Ident) {
+	state := &testScopeState{scopes: make(map[types.Object]*testScope)}
+	root := &testScope{}
+	state.scopes[c.ctx.TypesInfo.ObjectOf(t)] = root
+	c.walkScope(state, body, root)
+
+	anyParallel := false
+	for _, scope := range state.scopes {
+		anyParallel = anyParallel || scope.parallel
+	}
+	if anyParallel {
+		for _, ev := range state.mutations {
+			if ev.callee != "" {
+				c.ctx.Warn(ev.call, "%s calls %s, which mutates process-global state in a parallel test and races with other tests",
+					ev.call, ev.callee)
+			} else {
+				c.ctx.Warn(ev.call, "%s mutates process-global state in a parallel test and races with other tests",
+					ev.call)
+			}
+		}
+	}
+	for _, ev := range state.setenvs {
+		if !c.conflictsWithParallel(state, ev.scope) {
+			continue
+		}
+		if ev.callee != "" {
+			c.ctx.Warn(ev.call, "%s calls %s, which panics at runtime in a parallel test",
+				ev.call, ev.callee)
+		} else {
+			c.ctx.Warn(ev.call, "%s panics at runtime in a parallel test", ev.call)
+		}
+	}
+}
+
+// conflictsWithParallel reports whether scope, one of its
+// ancestors or one of its descendants is a parallel test.
+func (c *parallelTestEnvMutationChecker) conflictsWithParallel(state *testScopeState, scope *testScope) bool {
+	for s := scope; s != nil; s = s.parent {
+		if s.parallel {
+			return true
+		}
+	}
+	for _, s := range state.scopes {
+		if !s.parallel {
+			continue
+		}
+		for p := s.parent; p != nil; p = p.parent {
+			if p == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *parallelTestEnvMutationChecker) walkScope(state *testScopeState, body *ast.BlockStmt, scope *testScope) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Subtests started with t.Run get their own t.
+			if t := testingParam(c.ctx.TypesInfo, n.Type.Params); t != nil {
+				sub := &testScope{parent: scope}
+				state.scopes[c.ctx.TypesInfo.ObjectOf(t)] = sub
+				c.walkScope(state, n.Body, sub)
+				return false
+			}
+		case *ast.CallExpr:
+			c.checkCall(state, n)
+		}
+		return true
+	})
+}
+
+func (c *parallelTestEnvMutationChecker) checkCall(state *testScopeState, call *ast.CallExpr) {
+	if processStateMutators[calledFuncName(c.ctx.TypesInfo, call)] {
+		state.mutations = append(state.mutations, testEvent{call: call})
+		return
+	}
+	if sel, ok := astutil.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+		if scope := c.scopeOf(state, sel.X); scope != nil {
+			switch sel.Sel.Name {
+			case "Parallel":
+				scope.parallel = true
+			case "Setenv":
+				state.setenvs = append(state.setenvs, testEvent{call: call, scope: scope})
+			}
+			return
+		}
+	}
+	if c.interprocedural {
+		c.checkHelperCall(state, call)
+	}
+}
+
+// checkHelperCall inspects the package function called with t argument.
+func (c *parallelTestEnvMutationChecker) checkHelperCall(state *testScopeState, call *ast.CallExpr) {
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil {
+		return
+	}
+	decl := c.funcs[fn]
+	if decl == nil {
+		return
+	}
+	var scope *testScope
+	for _, arg := range call.Args {
+		if scope = c.scopeOf(state, arg); scope != nil {
+			break
+		}
+	}
+	if scope == nil {
+		return
+	}
+	t := testingParam(c.ctx.TypesInfo, decl.Type.Params)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			name := calledFuncName(c.ctx.TypesInfo, n)
+			if processStateMutators[name] {
+				state.mutations = append(state.mutations, testEvent{call: call, callee: name})
+				return true
+			}
+			sel, ok := astutil.Unparen(n.Fun).(*ast.SelectorExpr)
+			if !ok || t == nil || !c.isObject(sel.X, c.ctx.TypesInfo.ObjectOf(t)) {
+				return true
+			}
+			switch sel.Sel.Name {
+			case "Parallel":
+				scope.parallel = true
+			case "Setenv":
+				state.setenvs = append(state.setenvs, testEvent{
+					call:   call,
+					scope:  scope,
+					callee: t.Name + ".Setenv",
+				})
+			}
+		}
+		return true
+	})
+}
+
+func (c *parallelTestEnvMutationChecker) scopeOf(state *testScopeState, x ast.Expr) *testScope {
+	id, ok := astutil.Unparen(x).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return state.scopes[c.ctx.TypesInfo.ObjectOf(id)]
+}
+
+func (c *parallelTestEnvMutationChecker) isObject(x ast.Expr, obj types.Object) bool {
+	id, ok := astutil.Unparen(x).(*ast.Ident)
+	return ok && obj != nil && c.ctx.TypesInfo.ObjectOf(id) == obj
+}
@@ -2,28 +2,56 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
 	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
 )
 
 func init() {
 	var info linter.CheckerInfo
 	info.Name = "paramTypeCombine"
 	info.Tags = []string{"style", "opinionated"}
+	info.Params = linter.CheckerParams{
+		"checkTypeParams": {
+			Value: false,
+			Usage: "whether to check the type parameters of generic functions",
+		},
+		"respectMultiline": {
+			Value: true,
+			Usage: "whether to skip the parameter lists split across several lines",
+		},
+	}
 	info.Summary = "Detects if function parameters could be combined by type and suggest the way to do it"
 	info.Before = `func foo(a, b int, c, d int, e, f int, g int) {}`
 	info.After = `func foo(a, b, c, d, e, f, g int) {}`
+	info.Note = "Parameters separated by comments are never combined"
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
-		return astwalk.WalkerForFuncDecl(&paramTypeCombineChecker{ctx: ctx}), nil
+		return astwalk.WalkerForFuncDecl(&paramTypeCombineChecker{
+			ctx:              ctx,
+			checkTypeParams:  info.Params.Bool("checkTypeParams"),
+			respectMultiline: info.Params.Bool("respectMultiline"),
+		}), nil
 	})
 }
 
 type paramTypeCombineChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
+
+	file *ast.File
+
+	checkTypeParams  bool
+	respectMultiline bool
+}
+
+func (c *paramTypeCombineChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
 }
 
 func (c *paramTypeCombineChecker) EnterFunc(*ast.FuncDecl) bool {
@@ -31,16 +59,51 @@ func (c *paramTypeCombineChecker) EnterFunc(*ast.FuncDecl) bool {
 }
 
 func (c *paramTypeCombineChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if c.checkTypeParams {
+		c.checkTypeParamList(decl.Type.TypeParams)
+	}
+
 	typ := c.optimizeFuncType(decl.Type)
-	if !astequal.Expr(typ, decl.Type) {
+	if astequal.Expr(typ, decl.Type) {
+		return
+	}
+	if !c.canFix(decl.Type.Params.Opening, decl.Type.End()) {
 		c.warn(decl.Type, typ)
+		return
 	}
+	// The type is printed as func(...) (...), the func keyword
+	// is dropped as the fix starts after the function name.
+	signature := &ast.FuncType{Params: typ.Params, Results: typ.Results}
+	c.ctx.WarnFixable(decl.Type, linter.QuickFix{
+		From:        decl.Type.Params.Opening,
+		To:          decl.Type.End(),
+		Replacement: []byte(strings.TrimPrefix(astfmt.Sprint(signature), "func")),
+	}, "%s could be replaced with %s", decl.Type, typ)
+}
+
+func (c *paramTypeCombineChecker) checkTypeParamList(params *ast.FieldList) {
+	optimized := c.optimizeParams(params)
+	if optimized == params || len(optimized.List) == len(params.List) {
+		return
+	}
+	from := c.formatTypeParams(params)
+	to := c.formatTypeParams(optimized)
+	if !c.canFix(params.Opening, params.End()) {
+		c.ctx.Warn(params, "type parameters %s could be replaced with %s", from, to)
+		return
+	}
+	c.ctx.WarnFixable(params, linter.QuickFix{
+		From:        params.Opening,
+		To:          params.End(),
+		Replacement: []byte(to),
+	}, "type parameters %s could be replaced with %s", from, to)
 }
 
 func (c *paramTypeCombineChecker) optimizeFuncType(f *ast.FuncType) *ast.FuncType {
 	return &ast.FuncType{
-		Params:  c.optimizeParams(f.Params),
-		Results: c.optimizeParams(f.Results),
+		TypeParams: f.TypeParams,
+		Params:     c.optimizeParams(f.Params),
+		Results:    c.optimizeParams(f.Results),
 	}
 }
 func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.FieldList {
@@ -52,7 +115,7 @@ func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.Fie
 	skip := params == nil ||
 		len(params.List) < 2 ||
 		len(params.List[0].Names) == 0 ||
-		c.paramsAreMultiLine(params)
+		(c.respectMultiline && c.paramsAreMultiLine(params))
 	if skip {
 		return params
 	}
@@ -67,7 +130,10 @@ func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.Fie
 	for i, p := range params.List[1:] {
 		names = make([]*ast.Ident, len(p.Names))
 		copy(names, p.Names)
-		if astequal.Expr(p.Type, params.List[i].Type) {
+		// Combining the params would move the comments
+		// between them to the whole group.
+		separated := hasCommentsBetween(c.file, params.List[i].Pos(), p.End())
+		if !separated && astequal.Expr(p.Type, params.List[i].Type) {
 			list[len(list)-1].Names =
 				append(list[len(list)-1].Names, names...)
 		} else {
@@ -82,6 +148,26 @@ func (c *paramTypeCombineChecker) optimizeParams(params *ast.FieldList) *ast.Fie
 	}
 }
 
+// canFix reports whether the [from, to) parameters span can be
+// replaced without losing comments or the line breaks.
+func (c *paramTypeCombineChecker) canFix(from, to token.Pos) bool {
+	fromLine := c.ctx.FileSet.Position(from).Line
+	toLine := c.ctx.FileSet.Position(to).Line
+	return fromLine == toLine && !hasCommentsBetween(c.file, from, to)
+}
+
+func (c *paramTypeCombineChecker) formatTypeParams(params *ast.FieldList) string {
+	parts := make([]string, len(params.List))
+	for i, p := range params.List {
+		names := make([]string, len(p.Names))
+		for j, id := range p.Names {
+			names[j] = id.Name
+		}
+		parts[i] = strings.Join(names, ", ") + " " + astfmt.Sprint(p.Type)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
 func (c *paramTypeCombineChecker) warn(f1, f2 *ast.FuncType) {
 	c.ctx.Warn(f1, "%s could be replaced with %s", f1, f2)
 }
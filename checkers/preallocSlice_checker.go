@@ -0,0 +1,252 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/checkers/internal/lintutil"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astp"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "preallocSlice"
+	info.Tags = []string{"performance", "experimental"}
+	info.Params = linter.CheckerParams{
+		"exact": {
+			Value: false,
+			Usage: "whether to skip loops where the number of inserted elements can be less than the source length",
+		},
+		"skipTestFuncs": {
+			Value: true,
+			Usage: "whether to skip test functions",
+		},
+	}
+	info.Summary = "Detects slices and maps filled in a loop that can be preallocated"
+	info.Before = `
+var dst []string
+for _, x := range src {
+	dst = append(dst, x.Name)
+}`
+	info.After = `
+dst := make([]string, 0, len(src))
+for _, x := range src {
+	dst = append(dst, x.Name)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmtList(&preallocSliceChecker{
+			ctx:           ctx,
+			exact:         info.Params.Bool("exact"),
+			skipTestFuncs: info.Params.Bool("skipTestFuncs"),
+		}), nil
+	})
+}
+
+type preallocSliceChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	exact         bool
+	skipTestFuncs bool
+}
+
+func (c *preallocSliceChecker) EnterFunc(fn *ast.FuncDecl) bool {
+	return fn.Body != nil &&
+		!(c.skipTestFuncs && isUnitTestFunc(c.ctx, fn))
+}
+
+func (c *preallocSliceChecker) VisitStmtList(list []ast.Stmt) {
+	for i := 0; i+1 < len(list); i++ {
+		rng, ok := list[i+1].(*ast.RangeStmt)
+		if !ok {
+			continue
+		}
+		dst, typ := c.matchEmptyDecl(list[i])
+		if dst == nil {
+			continue
+		}
+		c.checkLoop(dst, typ, rng)
+	}
+}
+
+// matchEmptyDecl matches a declaration of a new empty slice or map.
+// Returns the declared variable and its type expression.
+func (c *preallocSliceChecker) matchEmptyDecl(stmt ast.Stmt) (*ast.Ident, ast.Expr) {
+	var id *ast.Ident
+	var typ, init ast.Expr
+
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		decl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR || len(decl.Specs) != 1 {
+			return nil, nil
+		}
+		spec := decl.Specs[0].(*ast.ValueSpec)
+		if len(spec.Names) != 1 || len(spec.Values) > 1 {
+			return nil, nil
+		}
+		id = spec.Names[0]
+		typ = spec.Type
+		if len(spec.Values) == 1 {
+			init = spec.Values[0]
+		}
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil, nil
+		}
+		id = astcast.ToIdent(stmt.Lhs[0])
+		init = stmt.Rhs[0]
+	default:
+		return nil, nil
+	}
+
+	switch init := init.(type) {
+	case nil:
+		// var dst []T
+		if !astp.IsArrayType(typ) || typ.(*ast.ArrayType).Len != nil {
+			return nil, nil // Nil maps can't be filled
+		}
+	case *ast.CompositeLit:
+		// dst := []T{} or dst := map[K]V{}
+		if len(init.Elts) != 0 || init.Type == nil {
+			return nil, nil
+		}
+		typ = init.Type
+	case *ast.CallExpr:
+		// dst := make([]T, 0) or dst := make(map[K]V)
+		if !isBuiltinCall(c.ctx.TypesInfo, init, "make") || !c.isEmptyMake(init) {
+			return nil, nil
+		}
+		typ = init.Args[0]
+	default:
+		return nil, nil
+	}
+
+	switch c.ctx.TypeOf(typ).Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return id, typ
+	default:
+		return nil, nil
+	}
+}
+
+func (c *preallocSliceChecker) isEmptyMake(call *ast.CallExpr) bool {
+	switch len(call.Args) {
+	case 1:
+		return true
+	case 2:
+		// Only a slice can be made with 0 len and without a capacity.
+		_, isSlice := c.ctx.TypeOf(call.Args[0]).Underlying().(*types.Slice)
+		return isSlice && astcast.ToBasicLit(call.Args[1]).Value == "0"
+	default:
+		return false
+	}
+}
+
+func (c *preallocSliceChecker) checkLoop(dst *ast.Ident, typ ast.Expr, rng *ast.RangeStmt) {
+	srcType := c.ctx.TypeOf(rng.X).Underlying()
+	if ptr, ok := srcType.(*types.Pointer); ok {
+		srcType = ptr.Elem().Underlying()
+	}
+	switch srcType := srcType.(type) {
+	case *types.Slice, *types.Map, *types.Array:
+	case *types.Basic:
+		if srcType.Info()&types.IsString == 0 || c.exact {
+			// For strings, len() is a bytes count, not a runes count.
+			return
+		}
+	default:
+		return
+	}
+	if !typep.SideEffectFree(c.ctx.TypesInfo, rng.X) {
+		return
+	}
+
+	obj := c.ctx.TypesInfo.ObjectOf(dst)
+	isDst := func(x ast.Expr) bool {
+		id, ok := x.(*ast.Ident)
+		return ok && c.ctx.TypesInfo.ObjectOf(id) == obj
+	}
+	_, isMap := c.ctx.TypeOf(typ).Underlying().(*types.Map)
+
+	isInsert := func(assign *ast.AssignStmt) bool {
+		if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || assign.Tok != token.ASSIGN {
+			return false
+		}
+		if isMap {
+			return isDst(astcast.ToIndexExpr(assign.Lhs[0]).X)
+		}
+		call := astcast.ToCallExpr(assign.Rhs[0])
+		return isDst(assign.Lhs[0]) && isBuiltinCall(c.ctx.TypesInfo, call, "append") &&
+			len(call.Args) == 2 && call.Ellipsis == token.NoPos && isDst(call.Args[0])
+	}
+
+	// Inserts inside nested loops and function literals are not
+	// collected, so dst usages there are reported as unexpected below.
+	var inserts []*ast.AssignStmt
+	ast.Inspect(rng.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			if isInsert(n) {
+				inserts = append(inserts, n)
+			}
+		}
+		return true
+	})
+	if len(inserts) != 1 {
+		return
+	}
+	insert := inserts[0]
+
+	// Every other dst usage can make the size unknowable.
+	unexpectedUse := lintutil.ContainsNode(rng.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		return ok && isDst(id) && (id.Pos() < insert.Pos() || id.End() > insert.End())
+	})
+	if unexpectedUse {
+		return
+	}
+	if c.exact && (!c.isTopLevel(rng.Body, insert) || c.hasEarlyExits(rng.Body)) {
+		return
+	}
+
+	if isMap {
+		c.warn(dst, "make(%s, len(%s))", typ, rng.X)
+	} else {
+		c.warn(dst, "make(%s, 0, len(%s))", typ, rng.X)
+	}
+}
+
+func (c *preallocSliceChecker) isTopLevel(body *ast.BlockStmt, stmt ast.Stmt) bool {
+	for _, x := range body.List {
+		if x == stmt {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEarlyExits reports whether body can skip the insertion statement.
+func (c *preallocSliceChecker) hasEarlyExits(body *ast.BlockStmt) bool {
+	return lintutil.ContainsNode(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BranchStmt:
+			return n.Tok != token.FALLTHROUGH
+		case *ast.ReturnStmt:
+			return true
+		}
+		return false
+	})
+}
+
+func (c *preallocSliceChecker) warn(dst *ast.Ident, format string, typ, src ast.Expr) {
+	c.ctx.Warn(dst, "%s can be preallocated with "+format, dst, typ, src)
+}
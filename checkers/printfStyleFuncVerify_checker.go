@@ -0,0 +1,147 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/checkers/internal/lintutil"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "printfStyleFuncVerify"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"funcs": {
+			Value: "",
+			Usage: "comma-separated list of pkg.Func:formatArgIndex printf-like functions to check, methods are written as (*pkg.Type).Method",
+		},
+	}
+	info.Summary = "Detects calls of the configured printf-like functions with mismatched arguments"
+	info.Before = `mylog.Infof("user %s logged in from %s", name)`
+	info.After = `mylog.Infof("user %s logged in from %s", name, addr)`
+	info.Note = "The checker does nothing until the funcs parameter is set"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		funcs := make(map[string]int)
+		for _, entry := range splitPatterns(info.Params.String("funcs")) {
+			i := strings.LastIndexByte(entry, ':')
+			if i == -1 {
+				return nil, fmt.Errorf("funcs: unexpected entry %q, expected pkg.Func:formatArgIndex", entry)
+			}
+			index, err := strconv.Atoi(entry[i+1:])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("funcs: bad format argument index in %q", entry)
+			}
+			funcs[entry[:i]] = index
+		}
+		return astwalk.WalkerForExpr(&printfStyleFuncVerifyChecker{ctx: ctx, funcs: funcs}), nil
+	})
+}
+
+type printfStyleFuncVerifyChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// funcs maps the printf-like function names to their format argument indexes.
+	funcs map[string]int
+}
+
+func (c *printfStyleFuncVerifyChecker) EnterFile(f *ast.File) bool {
+	return len(c.funcs) != 0
+}
+
+func (c *printfStyleFuncVerifyChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || call.Ellipsis != token.NoPos {
+		return
+	}
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil {
+		return
+	}
+	index, ok := c.funcs[fn.FullName()]
+	if !ok {
+		index, ok = c.funcs[c.shortName(fn)]
+	}
+	if !ok || index >= len(call.Args) {
+		return
+	}
+	tv := c.ctx.TypesInfo.Types[call.Args[index]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	c.checkCall(call, fn, constant.StringVal(tv.Value), call.Args[index+1:])
+}
+
+// shortName returns the function name qualified by the package name
+// instead of the import path, like "mylog.Infof" or "(*mylog.Logger).Infof".
+func (c *printfStyleFuncVerifyChecker) shortName(fn *types.Func) string {
+	pkg := fn.Pkg()
+	if fn.Type().(*types.Signature).Recv() == nil {
+		return pkg.Name() + "." + fn.Name()
+	}
+	return strings.Replace(fn.FullName(), pkg.Path()+".", pkg.Name()+".", 1)
+}
+
+func (c *printfStyleFuncVerifyChecker) checkCall(call *ast.CallExpr, fn *types.Func, format string, args []ast.Expr) {
+	verbs, err := lintutil.ParseFormat(format)
+	if err != nil {
+		c.ctx.Warn(call, "%s format is malformed: %v", fn.Name(), err)
+		return
+	}
+
+	indexed := false
+	operands := 0
+	for _, v := range verbs {
+		indexed = indexed || v.Indexed
+		if v.Arg+1 > operands {
+			operands = v.Arg + 1
+		}
+		for _, arg := range v.StarArgs {
+			if arg+1 > operands {
+				operands = arg + 1
+			}
+		}
+	}
+	if !indexed && operands != len(args) {
+		c.ctx.Warn(call, "%s format %q reads %d args, but the call has %d",
+			fn.Name(), format, operands, len(args))
+		return
+	}
+
+	for _, v := range verbs {
+		if v.Arg >= len(args) {
+			c.ctx.Warn(call, "%s verb %s refers to arg %d, but the call has only %d",
+				fn.Name(), v.Text, v.Arg+1, len(args))
+			continue
+		}
+		arg := args[v.Arg]
+		switch v.Verb {
+		case 'd':
+			if typ, ok := c.ctx.TypeOf(arg).Underlying().(*types.Basic); ok && typ.Info()&types.IsString != 0 {
+				c.ctx.Warn(arg, "%s verb %s is used with string argument %s", fn.Name(), v.Text, arg)
+			}
+		case 'w':
+			if !c.isErrorfLike(fn) {
+				c.ctx.Warn(arg, "%s doesn't wrap errors, %s is only supported by fmt.Errorf-like functions; use %s",
+					fn.Name(), v.Text, "%v")
+			}
+		}
+	}
+}
+
+// isErrorfLike reports whether fn wraps fmt.Errorf,
+// so it supports the %w verb.
+func (c *printfStyleFuncVerifyChecker) isErrorfLike(fn *types.Func) bool {
+	results := fn.Type().(*types.Signature).Results()
+	return strings.HasSuffix(fn.Name(), "Errorf") &&
+		results.Len() != 0 && isErrorType(results.At(results.Len()-1).Type())
+}
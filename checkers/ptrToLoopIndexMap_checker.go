@@ -0,0 +1,230 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "ptrToLoopIndexMap"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects buffers reused by loop iterations that are stored into collections without copying"
+	info.Before = `
+buf := make([]byte, 64)
+for _, r := range readers {
+	n, _ := r.Read(buf)
+	chunks = append(chunks, buf[:n])
+}`
+	info.After = `
+buf := make([]byte, 64)
+for _, r := range readers {
+	n, _ := r.Read(buf)
+	chunks = append(chunks, append([]byte(nil), buf[:n]...))
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&ptrToLoopIndexMapChecker{ctx: ctx}), nil
+	})
+}
+
+type ptrToLoopIndexMapChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// reported is a set of stored expressions that are already
+	// reported for the enclosing loop.
+	reported map[ast.Expr]bool
+}
+
+func (c *ptrToLoopIndexMapChecker) EnterFile(f *ast.File) bool {
+	c.reported = make(map[ast.Expr]bool)
+	return true
+}
+
+// reusedVars describes the variables declared before the loop
+// which memory is overwritten by the loop iterations.
+type reusedVars struct {
+	// written is a set of variables which contents are modified by the loop.
+	written map[types.Object]bool
+	// fresh is a set of slices that are reallocated by the loop.
+	fresh map[types.Object]bool
+}
+
+func (c *ptrToLoopIndexMapChecker) VisitStmt(stmt ast.Stmt) {
+	var body *ast.BlockStmt
+	switch loop := stmt.(type) {
+	case *ast.ForStmt:
+		body = loop.Body
+	case *ast.RangeStmt:
+		body = loop.Body
+	default:
+		return
+	}
+	vars := c.collectWrites(stmt, body)
+	if len(vars.written) == 0 {
+		return
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, lhs := range assign.Lhs {
+			switch lhs := lhs.(type) {
+			case *ast.IndexExpr:
+				if c.declaredBefore(stmt, lhs.X) {
+					c.checkStored(stmt, vars, lhs.X, assign.Rhs[i])
+				}
+			case *ast.Ident:
+				call, ok := assign.Rhs[i].(*ast.CallExpr)
+				if !ok || !isBuiltinCall(c.ctx.TypesInfo, call, "append") || !c.declaredBefore(stmt, lhs) {
+					continue
+				}
+				args := call.Args[1:]
+				if call.Ellipsis != token.NoPos {
+					// Elements of the spread slice are copied.
+					args = args[:len(args)-1]
+				}
+				for _, arg := range args {
+					c.checkStored(stmt, vars, lhs, arg)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *ptrToLoopIndexMapChecker) collectWrites(loop ast.Stmt, body *ast.BlockStmt) reusedVars {
+	vars := reusedVars{
+		written: make(map[types.Object]bool),
+		fresh:   make(map[types.Object]bool),
+	}
+	mark := func(x ast.Expr) {
+		if slice, ok := x.(*ast.SliceExpr); ok {
+			x = slice.X
+		}
+		if id, ok := astutil.Unparen(x).(*ast.Ident); ok && c.declaredBefore(loop, id) {
+			vars.written[c.ctx.TypesInfo.ObjectOf(id)] = true
+		}
+	}
+	if rng, ok := loop.(*ast.RangeStmt); ok && rng.Tok == token.ASSIGN && rng.Value != nil {
+		mark(rng.Value)
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				switch lhs := lhs.(type) {
+				case *ast.IndexExpr:
+					mark(lhs.X)
+				case *ast.SelectorExpr:
+					if c.isValue(lhs.X) {
+						mark(lhs.X)
+					}
+				case *ast.Ident:
+					if c.isValue(lhs) {
+						mark(lhs)
+						continue
+					}
+					if len(n.Lhs) != len(n.Rhs) {
+						continue
+					}
+					// Reslicing keeps the same memory, other assignments
+					// are likely to allocate a new slice.
+					if slice, ok := n.Rhs[i].(*ast.SliceExpr); !ok || !c.sameVar(slice.X, lhs) {
+						if obj := c.ctx.TypesInfo.ObjectOf(lhs); obj != nil {
+							vars.fresh[obj] = true
+						}
+					}
+				}
+			}
+		case *ast.CallExpr:
+			if isBuiltinCall(c.ctx.TypesInfo, n, "copy") && len(n.Args) == 2 {
+				mark(n.Args[0])
+				break
+			}
+			isRead := strings.Contains(calledFuncName(c.ctx.TypesInfo, n), "Read")
+			for _, arg := range n.Args {
+				if addr, ok := arg.(*ast.UnaryExpr); ok && addr.Op == token.AND {
+					mark(addr.X)
+				} else if isRead && c.isSlice(arg) {
+					mark(arg)
+				}
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+func (c *ptrToLoopIndexMapChecker) checkStored(loop ast.Stmt, vars reusedVars, dst, x ast.Expr) {
+	var buf ast.Expr
+	switch x := astutil.Unparen(x).(type) {
+	case *ast.Ident:
+		if c.isSlice(x) {
+			buf = x
+		}
+	case *ast.SliceExpr:
+		if c.isSlice(x.X) || c.isValue(x.X) {
+			buf = x.X
+		}
+	case *ast.UnaryExpr:
+		if x.Op == token.AND && c.isValue(x.X) {
+			buf = x.X
+		}
+	}
+	id, ok := astutil.Unparen(buf).(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if !vars.written[obj] || vars.fresh[obj] || c.sameVar(dst, id) || c.reported[x] {
+		return
+	}
+	c.reported[x] = true
+	c.ctx.Warn(x, "%s stored in %s aliases %s that is reused by all iterations, every entry sees the last iteration's data; store a copy instead",
+		x, dst, id)
+}
+
+// declaredBefore reports whether x is a local variable declared before the loop.
+func (c *ptrToLoopIndexMapChecker) declaredBefore(loop ast.Stmt, x ast.Expr) bool {
+	id, ok := astutil.Unparen(x).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	obj, ok := c.ctx.TypesInfo.ObjectOf(id).(*types.Var)
+	return ok && obj.Parent() != c.ctx.Pkg.Scope() && obj.Pos() < loop.Pos()
+}
+
+func (c *ptrToLoopIndexMapChecker) sameVar(x, y ast.Expr) bool {
+	xid, ok := astutil.Unparen(x).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	yid, ok := astutil.Unparen(y).(*ast.Ident)
+	return ok && c.ctx.TypesInfo.ObjectOf(xid) == c.ctx.TypesInfo.ObjectOf(yid)
+}
+
+func (c *ptrToLoopIndexMapChecker) isSlice(x ast.Expr) bool {
+	_, ok := c.ctx.TypeOf(x).Underlying().(*types.Slice)
+	return ok
+}
+
+// isValue reports whether x is a struct or array that has its own memory.
+func (c *ptrToLoopIndexMapChecker) isValue(x ast.Expr) bool {
+	switch c.ctx.TypeOf(x).Underlying().(type) {
+	case *types.Struct, *types.Array:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,10 +1,19 @@
 package checkers
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcopy"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -16,6 +25,10 @@ func init() {
 			Value: 128,
 			Usage: "size in bytes that makes the warning trigger",
 		},
+		"sizeThresholdOverrides": {
+			Value: "",
+			Usage: "comma-separated list of package=size pairs that override sizeThreshold for these packages",
+		},
 		"skipTestFuncs": {
 			Value: true,
 			Usage: "whether to check test functions",
@@ -34,11 +47,30 @@ for i := range xs {
 	x := &xs[i]
 	// Loop body.
 }`
+	info.Note = "Loops that modify the value or use it in goroutines need the copy and are not reported"
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
 		c := &rangeValCopyChecker{ctx: ctx}
 		c.sizeThreshold = int64(info.Params.Int("sizeThreshold"))
 		c.skipTestFuncs = info.Params.Bool("skipTestFuncs")
+		for _, pair := range strings.Split(info.Params.String("sizeThresholdOverrides"), ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			eq := strings.LastIndexByte(pair, '=')
+			if eq == -1 {
+				return nil, fmt.Errorf("sizeThresholdOverrides: expected package=size, got %q", pair)
+			}
+			size, err := strconv.ParseInt(pair[eq+1:], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("sizeThresholdOverrides: %q: %v", pair, err)
+			}
+			if c.overrides == nil {
+				c.overrides = make(map[string]int64)
+			}
+			c.overrides[pair[:eq]] = size
+		}
 		return astwalk.WalkerForStmt(c), nil
 	})
 }
@@ -47,8 +79,25 @@ type rangeValCopyChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
+	file *ast.File
+
 	sizeThreshold int64
 	skipTestFuncs bool
+
+	// overrides maps package paths to their size thresholds.
+	overrides map[string]int64
+}
+
+func (c *rangeValCopyChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *rangeValCopyChecker) threshold() int64 {
+	if size, ok := c.overrides[c.ctx.Pkg.Path()]; ok {
+		return size
+	}
+	return c.sizeThreshold
 }
 
 func (c *rangeValCopyChecker) EnterFunc(fn *ast.FuncDecl) bool {
@@ -62,14 +111,187 @@ func (c *rangeValCopyChecker) VisitStmt(stmt ast.Stmt) {
 		return
 	}
 	typ := c.ctx.TypeOf(rng.Value)
-	if typ == nil {
+	if typ == nil || sizeDependsOnTypeParam(typ) {
 		return
 	}
-	if size := c.ctx.SizesInfo.Sizeof(typ); size >= c.sizeThreshold {
-		c.warn(rng, size)
+	size := c.ctx.SizesInfo.Sizeof(typ)
+	if size < c.threshold() {
+		return
+	}
+	v := c.valueVar(rng)
+	if v != nil && c.needsCopy(rng.Body, v) {
+		return
+	}
+	if v != nil && c.canFix(rng, v) {
+		c.ctx.WarnFixable(rng, linter.QuickFix{
+			From:        rng.Pos(),
+			To:          rng.End(),
+			Replacement: []byte(c.suggestIndexing(rng, v)),
+		}, rangeValCopyFormat, size)
+		return
+	}
+	c.warn(rng, size)
+}
+
+// valueVar returns the variable declared for the range value.
+func (c *rangeValCopyChecker) valueVar(rng *ast.RangeStmt) types.Object {
+	id, ok := rng.Value.(*ast.Ident)
+	if !ok || rng.Tok != token.DEFINE {
+		return nil
+	}
+	return c.ctx.TypesInfo.Defs[id]
+}
+
+// needsCopy reports whether the loop body modifies v
+// or uses it inside a goroutine.
+func (c *rangeValCopyChecker) needsCopy(body *ast.BlockStmt, v types.Object) bool {
+	isValue := func(x ast.Expr) bool {
+		for {
+			switch e := astutil.Unparen(x).(type) {
+			case *ast.SelectorExpr:
+				if typep.IsPointer(c.ctx.TypeOf(e.X)) {
+					return false
+				}
+				x = e.X
+			case *ast.IndexExpr:
+				if _, ok := c.ctx.TypeOf(e.X).Underlying().(*types.Array); !ok {
+					return false
+				}
+				x = e.X
+			case *ast.Ident:
+				return c.ctx.TypesInfo.ObjectOf(e) == v
+			default:
+				return false
+			}
+		}
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				found = found || isValue(lhs)
+			}
+		case *ast.IncDecStmt:
+			found = found || isValue(n.X)
+		case *ast.UnaryExpr:
+			found = found || (n.Op == token.AND && isValue(n.X))
+		case *ast.SliceExpr:
+			// Slicing an array takes its address.
+			found = found || isValue(n.X)
+		case *ast.SelectorExpr:
+			sel := c.ctx.TypesInfo.Selections[n]
+			if sel != nil && sel.Kind() == types.MethodVal && isValue(n.X) {
+				_, ptrRecv := sel.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer)
+				found = found || ptrRecv
+			}
+		case *ast.GoStmt:
+			found = found || c.usesVar(n, v)
+		}
+		return !found
+	})
+	return found
+}
+
+func (c *rangeValCopyChecker) usesVar(n ast.Node, v types.Object) bool {
+	used := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.Uses[id] == v {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
+
+// canFix reports whether the v uses can be replaced with the indexing.
+func (c *rangeValCopyChecker) canFix(rng *ast.RangeStmt, v types.Object) bool {
+	if rng.Key != nil {
+		if _, ok := rng.Key.(*ast.Ident); !ok {
+			return false
+		}
+	}
+	switch typ := c.ctx.TypeOf(rng.X).Underlying().(type) {
+	case *types.Slice:
+	case *types.Array:
+	case *types.Pointer:
+		if _, ok := typ.Elem().Underlying().(*types.Array); !ok {
+			return false
+		}
+	default:
+		return false
 	}
+	root := identOf(rng.X)
+	if root == nil || !typep.SideEffectFree(c.ctx.TypesInfo, rng.X) || hasCommentsIn(c.file, rng) {
+		return false
+	}
+	if obj := c.ctx.TypesInfo.ObjectOf(root); obj == nil || c.usesVar(rng.Body, obj) {
+		// The indexing could see the elements updated by the body.
+		return false
+	}
+
+	closure := false
+	ast.Inspect(rng.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok && c.usesVar(lit, v) {
+			closure = true
+		}
+		return !closure
+	})
+	return !closure
 }
 
+// suggestIndexing returns the range statement that indexes
+// the range expression instead of copying the values.
+func (c *rangeValCopyChecker) suggestIndexing(rng *ast.RangeStmt, v types.Object) string {
+	fixed := astcopy.RangeStmt(rng)
+	key, ok := fixed.Key.(*ast.Ident)
+	if !ok || key.Name == "_" {
+		key = &ast.Ident{Name: c.indexName(rng)}
+		fixed.Key = key
+	}
+	fixed.Value = nil
+	fixed.Tok = token.DEFINE
+
+	uses := make(map[token.Pos]bool)
+	ast.Inspect(rng.Body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.Uses[id] == v {
+			uses[id.Pos()] = true
+		}
+		return true
+	})
+	astutil.Apply(fixed.Body, func(cur *astutil.Cursor) bool {
+		if id, ok := cur.Node().(*ast.Ident); ok && uses[id.Pos()] {
+			cur.Replace(&ast.IndexExpr{
+				X:     astcopy.Expr(rng.X),
+				Index: &ast.Ident{Name: key.Name},
+			})
+		}
+		return true
+	}, nil)
+
+	return reindent(c.ctx.FileSet, rng.Pos(), astfmt.Sprint(fixed))
+}
+
+// indexName returns the name for the index variable
+// that is not used by the range statement.
+func (c *rangeValCopyChecker) indexName(rng *ast.RangeStmt) string {
+	used := make(map[string]bool)
+	ast.Inspect(rng, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			used[id.Name] = true
+		}
+		return true
+	})
+	for _, name := range []string{"i", "j", "k", "idx"} {
+		if !used[name] {
+			return name
+		}
+	}
+	return "index"
+}
+
+const rangeValCopyFormat = "each iteration copies %d bytes (consider pointers or indexing)"
+
 func (c *rangeValCopyChecker) warn(n ast.Node, size int64) {
-	c.ctx.Warn(n, "each iteration copies %d bytes (consider pointers or indexing)", size)
+	c.ctx.Warn(n, rangeValCopyFormat, size)
 }
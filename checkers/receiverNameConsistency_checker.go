@@ -0,0 +1,119 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "receiverNameConsistency"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects methods that use different receiver names for the same type"
+	info.Before = `
+func (s *Server) Start() {}
+func (srv *Server) Stop() {}`
+	info.After = `
+func (s *Server) Start() {}
+func (s *Server) Stop() {}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &receiverNameConsistencyChecker{ctx: ctx}, nil
+	})
+}
+
+type receiverNameConsistencyChecker struct {
+	ctx *linter.CheckerContext
+
+	// names maps the receiver base types to the
+	// most frequently used receiver names.
+	names map[types.Object]string
+}
+
+func (c *receiverNameConsistencyChecker) WalkPackage(files []*ast.File) {
+	type nameCount struct {
+		name  string
+		count int
+	}
+	// counts preserves the order of the first name occurrence,
+	// so the first name wins if the counts are equal.
+	counts := make(map[types.Object][]nameCount)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			recv, typ := c.receiver(decl)
+			// Generic names are reported separately.
+			if recv == nil || recv.Name == "this" || recv.Name == "self" {
+				continue
+			}
+			list := counts[typ]
+			found := false
+			for i := range list {
+				if list[i].name == recv.Name {
+					list[i].count++
+					found = true
+				}
+			}
+			if !found {
+				list = append(list, nameCount{name: recv.Name, count: 1})
+			}
+			counts[typ] = list
+		}
+	}
+
+	c.names = make(map[types.Object]string, len(counts))
+	for typ, list := range counts {
+		best := list[0]
+		for _, nc := range list[1:] {
+			if nc.count > best.count {
+				best = nc
+			}
+		}
+		c.names[typ] = best.name
+	}
+}
+
+func (c *receiverNameConsistencyChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		recv, typ := c.receiver(decl)
+		if recv == nil {
+			continue
+		}
+		switch name := c.names[typ]; {
+		case recv.Name == "this" || recv.Name == "self":
+			c.ctx.Warn(recv, "receiver name %s should reflect the receiver type; don't use generic names like this or self", recv)
+		case name != "" && recv.Name != name:
+			c.ctx.Warn(recv, "method %s.%s uses receiver name %s, while other methods use %s",
+				typ.Name(), decl.Name, recv, name)
+		}
+	}
+}
+
+// receiver returns the method named receiver along with its base type.
+func (c *receiverNameConsistencyChecker) receiver(decl *ast.FuncDecl) (*ast.Ident, types.Object) {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return nil, nil
+	}
+	field := decl.Recv.List[0]
+	if len(field.Names) != 1 || field.Names[0].Name == "_" {
+		return nil, nil
+	}
+	typ := c.ctx.TypeOf(field.Type)
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+	return field.Names[0], named.Obj()
+}
@@ -0,0 +1,87 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "redundantTypeInCompositeLit"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects element types in slice, array and map literals that can be elided"
+	info.Before = `
+points := []Point{Point{1, 2}, Point{3, 4}}`
+	info.After = `
+points := []Point{{1, 2}, {3, 4}}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&redundantTypeInCompositeLitChecker{ctx: ctx}), nil
+	})
+}
+
+type redundantTypeInCompositeLitChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	file *ast.File
+}
+
+func (c *redundantTypeInCompositeLitChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *redundantTypeInCompositeLitChecker) VisitExpr(expr ast.Expr) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+
+	var keyType, elemType types.Type
+	switch typ := c.ctx.TypeOf(lit).Underlying().(type) {
+	case *types.Slice:
+		elemType = typ.Elem()
+	case *types.Array:
+		elemType = typ.Elem()
+	case *types.Map:
+		keyType = typ.Key()
+		elemType = typ.Elem()
+	default:
+		return
+	}
+
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if keyType != nil {
+				c.checkElem(kv.Key, keyType)
+			}
+			elt = kv.Value
+		}
+		c.checkElem(elt, elemType)
+	}
+}
+
+func (c *redundantTypeInCompositeLitChecker) checkElem(elt ast.Expr, typ types.Type) {
+	// Only the literals of the exactly matching types can
+	// be elided: interface elements need the concrete type and
+	// pointer elements are written as &T{}, which is skipped too.
+	lit, ok := elt.(*ast.CompositeLit)
+	if !ok || lit.Type == nil || !types.Identical(c.ctx.TypeOf(lit), typ) {
+		return
+	}
+
+	const format = "redundant type %s in composite literal element can be elided"
+	if hasCommentsBetween(c.file, lit.Type.Pos(), lit.Lbrace) {
+		c.ctx.Warn(lit.Type, format, lit.Type)
+		return
+	}
+	c.ctx.WarnFixable(lit.Type, linter.QuickFix{
+		From:        lit.Type.Pos(),
+		To:          lit.Lbrace,
+		Replacement: []byte{},
+	}, format, lit.Type)
+}
@@ -0,0 +1,123 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "reflectDeepEqualMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects reflect.DeepEqual calls that are always true, always false or can be replaced with typed helpers"
+	info.Before = `
+if reflect.DeepEqual(err, io.EOF) { /* ... */ }
+if reflect.DeepEqual(got, want) { /* got and want are []byte */ }`
+	info.After = `
+if errors.Is(err, io.EOF) { /* ... */ }
+if bytes.Equal(got, want) { /* ... */ }`
+	info.Note = "slices.Equal and maps.Equal are only suggested for Go 1.21 and later"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&reflectDeepEqualMisuseChecker{ctx: ctx}), nil
+	})
+}
+
+type reflectDeepEqualMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *reflectDeepEqualMisuseChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return
+	}
+	if calledFuncName(c.ctx.TypesInfo, call) != "reflect.DeepEqual" {
+		return
+	}
+	x, y := call.Args[0], call.Args[1]
+
+	if astequal.Expr(x, y) && typep.SideEffectFree(c.ctx.TypesInfo, x) {
+		c.ctx.Warn(call, "reflect.DeepEqual compares %s with itself, the result is always true", x)
+		return
+	}
+
+	for _, arg := range call.Args {
+		if isErrorType(c.ctx.TypeOf(arg)) {
+			c.ctx.Warn(call, "reflect.DeepEqual on error %s compares the error internals; use errors.Is or compare the messages explicitly", arg)
+			return
+		}
+	}
+
+	for _, arg := range call.Args {
+		typ := c.ctx.TypeOf(arg)
+		if _, ok := typ.Underlying().(*types.Signature); ok {
+			c.ctx.Warn(call, "%s is a func, reflect.DeepEqual on funcs is only true if both are nil", arg)
+			return
+		}
+		if field := c.funcField(typ, make(map[types.Type]bool)); field != "" {
+			c.ctx.Warn(call, "%s contains func field %s, reflect.DeepEqual is false unless it is nil in both values", arg, field)
+			return
+		}
+	}
+
+	typ := c.ctx.TypeOf(x)
+	if !types.Identical(typ, c.ctx.TypeOf(y)) {
+		return
+	}
+	switch typ := typ.Underlying().(type) {
+	case *types.Slice:
+		if types.Identical(typ.Elem(), types.Typ[types.Byte]) {
+			c.ctx.Warn(call, "use bytes.Equal(%s, %s) to compare byte slices", x, y)
+			return
+		}
+		if c.canUseGenericHelpers() && types.Comparable(typ.Elem()) {
+			c.ctx.Warn(call, "use slices.Equal(%s, %s) to compare slices of comparable elements", x, y)
+		}
+	case *types.Map:
+		if c.canUseGenericHelpers() && types.Comparable(typ.Elem()) {
+			c.ctx.Warn(call, "use maps.Equal(%s, %s) to compare maps of comparable values", x, y)
+		}
+	}
+}
+
+// funcField returns the name of the first func-typed field that
+// reflect.DeepEqual visits inside typ, or an empty string.
+func (c *reflectDeepEqualMisuseChecker) funcField(typ types.Type, visited map[types.Type]bool) string {
+	if visited[typ] {
+		return ""
+	}
+	visited[typ] = true
+
+	switch typ := typ.Underlying().(type) {
+	case *types.Pointer:
+		return c.funcField(typ.Elem(), visited)
+	case *types.Array:
+		return c.funcField(typ.Elem(), visited)
+	case *types.Slice:
+		return c.funcField(typ.Elem(), visited)
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			field := typ.Field(i)
+			if _, ok := field.Type().Underlying().(*types.Signature); ok {
+				return field.Name()
+			}
+			if name := c.funcField(field.Type(), visited); name != "" {
+				return field.Name() + "." + name
+			}
+		}
+	}
+	return ""
+}
+
+func (c *reflectDeepEqualMisuseChecker) canUseGenericHelpers() bool {
+	// slices and maps packages were added in Go 1.21.
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})
+}
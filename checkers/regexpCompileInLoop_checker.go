@@ -0,0 +1,138 @@
+package checkers
+
+import (
+	"go/ast"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "regexpCompileInLoop"
+	info.Tags = []string{"performance", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkHandlers": {
+			Value: false,
+			Usage: "whether to also report compilations inside HTTP handlers",
+		},
+	}
+	info.Summary = "Detects regexp compilation of constant patterns inside loops"
+	info.Before = `
+for _, s := range lines {
+	if regexp.MustCompile(` + "`^\\d+$`" + `).MatchString(s) {
+		n++
+	}
+}`
+	info.After = `
+var digitsRE = regexp.MustCompile(` + "`^\\d+$`" + `)
+
+for _, s := range lines {
+	if digitsRE.MatchString(s) {
+		n++
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&regexpCompileInLoopChecker{
+			ctx:           ctx,
+			checkHandlers: info.Params.Bool("checkHandlers"),
+		}), nil
+	})
+}
+
+type regexpCompileInLoopChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkHandlers bool
+}
+
+// regexpWalkState describes the context of the currently walked code.
+type regexpWalkState struct {
+	inLoop    bool
+	inHandler bool
+}
+
+func (c *regexpCompileInLoopChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	state := regexpWalkState{
+		inHandler: c.checkHandlers && (decl.Name.Name == "ServeHTTP" || c.hasResponseWriterParam(decl.Type)),
+	}
+	c.walk(decl.Body, state)
+}
+
+func (c *regexpCompileInLoopChecker) walk(root ast.Node, state regexpWalkState) {
+	ast.Inspect(root, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt:
+			loopState := state
+			loopState.inLoop = true
+			if n.Init != nil {
+				c.walk(n.Init, state)
+			}
+			for _, x := range []ast.Node{n.Cond, n.Post, n.Body} {
+				if x != nil {
+					c.walk(x, loopState)
+				}
+			}
+			return false
+		case *ast.RangeStmt:
+			// Range expression is evaluated only once.
+			c.walk(n.X, state)
+			loopState := state
+			loopState.inLoop = true
+			c.walk(n.Body, loopState)
+			return false
+		case *ast.FuncLit:
+			// Literals that are not called immediately are executed
+			// at some unknown point, so loop context is lost.
+			litState := regexpWalkState{
+				inHandler: c.checkHandlers && c.hasResponseWriterParam(n.Type),
+			}
+			c.walk(n.Body, litState)
+			return false
+		case *ast.CallExpr:
+			if lit, ok := n.Fun.(*ast.FuncLit); ok {
+				c.walk(lit.Body, state)
+				for _, arg := range n.Args {
+					c.walk(arg, state)
+				}
+				return false
+			}
+			c.checkCall(n, state)
+		}
+		return true
+	})
+}
+
+func (c *regexpCompileInLoopChecker) checkCall(call *ast.CallExpr, state regexpWalkState) {
+	if !state.inLoop && !state.inHandler {
+		return
+	}
+	if len(call.Args) == 0 || c.ctx.TypesInfo.Types[call.Args[0]].Value == nil {
+		return
+	}
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "regexp.Compile", "regexp.MustCompile", "regexp.CompilePOSIX", "regexp.MustCompilePOSIX",
+		"regexp.MatchString", "regexp.Match", "regexp.MatchReader":
+		where := "inside a loop"
+		if !state.inLoop {
+			where = "on every request"
+		}
+		c.warn(call, where)
+	}
+}
+
+func (c *regexpCompileInLoopChecker) hasResponseWriterParam(typ *ast.FuncType) bool {
+	for _, field := range typ.Params.List {
+		if c.ctx.TypeOf(field.Type).String() == "net/http.ResponseWriter" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *regexpCompileInLoopChecker) warn(cause *ast.CallExpr, where string) {
+	c.ctx.Warn(cause, "%s compiles a constant pattern %s; move it to a package-level regexp.MustCompile variable",
+		cause.Fun, where)
+}
@@ -63,8 +63,9 @@ func (c *regexpSimplifyChecker) VisitExpr(x ast.Expr) {
 			return
 		}
 		pat := constant.StringVal(cv)
-		if len(pat) > 60 {
-			// Skip scary regexp patterns for now.
+		// Skip scary regexp patterns for now.
+		const maxPatternLen = 60
+		if len(pat) > maxPatternLen {
 			break
 		}
 
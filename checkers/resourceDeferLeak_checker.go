@@ -0,0 +1,187 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "resourceDeferLeak"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"smallLoopBound": {
+			Value: 4,
+			Usage: "loops with a constant number of iterations not exceeding this value are ignored",
+		},
+	}
+	info.Summary = "Detects deferred Close calls for resources that are opened on every loop iteration"
+	info.Before = `
+for _, filename := range files {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	process(f)
+}`
+	info.After = `
+for _, filename := range files {
+	err := func() error {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return process(f)
+	}()
+	if err != nil {
+		return err
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&resourceDeferLeakChecker{
+			ctx:            ctx,
+			smallLoopBound: int64(info.Params.Int("smallLoopBound")),
+		}), nil
+	})
+}
+
+// resourceOpeners is a set of functions that return a resource
+// that should be closed as its first result.
+var resourceOpeners = map[string]bool{
+	"os.Open":     true,
+	"os.OpenFile": true,
+	"os.Create":   true,
+
+	"net/http.Get":                true,
+	"net/http.Head":               true,
+	"net/http.Post":               true,
+	"net/http.PostForm":           true,
+	"(*net/http.Client).Do":       true,
+	"(*net/http.Client).Get":      true,
+	"(*net/http.Client).Head":     true,
+	"(*net/http.Client).Post":     true,
+	"(*net/http.Client).PostForm": true,
+
+	"(*database/sql.DB).Query":          true,
+	"(*database/sql.DB).QueryContext":   true,
+	"(*database/sql.Tx).Query":          true,
+	"(*database/sql.Tx).QueryContext":   true,
+	"(*database/sql.Conn).QueryContext": true,
+	"(*database/sql.Stmt).Query":        true,
+	"(*database/sql.Stmt).QueryContext": true,
+}
+
+type resourceDeferLeakChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	smallLoopBound int64
+}
+
+func (c *resourceDeferLeakChecker) VisitStmt(stmt ast.Stmt) {
+	var body *ast.BlockStmt
+	switch loop := stmt.(type) {
+	case *ast.ForStmt:
+		if n, ok := constTripCount(c.ctx.TypesInfo, loop); ok && n <= c.smallLoopBound {
+			return
+		}
+		body = loop.Body
+	case *ast.RangeStmt:
+		if n, ok := c.rangeLen(loop.X); ok && n <= c.smallLoopBound {
+			return
+		}
+		body = loop.Body
+	default:
+		return
+	}
+
+	// resources maps the variables that are assigned to
+	// a newly opened resource to the opening call.
+	resources := make(map[types.Object]*ast.CallExpr)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			// Nested loops are checked separately and
+			// function literals have their own defer scope.
+			return false
+		case *ast.AssignStmt:
+			c.collectResource(resources, n)
+		case *ast.DeferStmt:
+			if obj := c.closedResource(n.Call); obj != nil {
+				if call, ok := resources[obj]; ok {
+					c.warn(n, obj, call)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *resourceDeferLeakChecker) collectResource(resources map[types.Object]*ast.CallExpr, assign *ast.AssignStmt) {
+	if len(assign.Rhs) != 1 {
+		return
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || !resourceOpeners[calledFuncName(c.ctx.TypesInfo, call)] {
+		return
+	}
+	if id, ok := assign.Lhs[0].(*ast.Ident); ok {
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			resources[obj] = call
+		}
+	}
+}
+
+// closedResource returns the variable that is closed by
+// x.Close() or x.Body.Close() deferred call.
+// For deferred function literals, their body is inspected.
+func (c *resourceDeferLeakChecker) closedResource(call *ast.CallExpr) types.Object {
+	if lit, ok := call.Fun.(*ast.FuncLit); ok {
+		var obj types.Object
+		ast.Inspect(lit.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && obj == nil {
+				obj = c.closedResource(call)
+			}
+			return obj == nil
+		})
+		return obj
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Close" || len(call.Args) != 0 {
+		return nil
+	}
+	x := sel.X
+	if body, ok := x.(*ast.SelectorExpr); ok && body.Sel.Name == "Body" {
+		x = body.X
+	}
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return c.ctx.TypesInfo.ObjectOf(id)
+}
+
+// rangeLen returns the number of range loop iterations if it's known.
+func (c *resourceDeferLeakChecker) rangeLen(x ast.Expr) (int64, bool) {
+	if lit, ok := x.(*ast.CompositeLit); ok {
+		if _, ok := c.ctx.TypeOf(lit).Underlying().(*types.Slice); ok {
+			return int64(len(lit.Elts)), true
+		}
+	}
+	if arr, ok := c.ctx.TypeOf(x).Underlying().(*types.Array); ok {
+		return arr.Len(), true
+	}
+	return 0, false
+}
+
+func (c *resourceDeferLeakChecker) warn(cause *ast.DeferStmt, obj types.Object, opener *ast.CallExpr) {
+	c.ctx.Warn(cause, "%s from %s stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function",
+		obj.Name(), opener.Fun)
+}
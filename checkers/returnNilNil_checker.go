@@ -0,0 +1,113 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "returnNilNil"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkMaps": {
+			Value: false,
+			Usage: "whether to check functions that return maps",
+		},
+		"checkSlices": {
+			Value: false,
+			Usage: "whether to check functions that return slices",
+		},
+		"checkFuncs": {
+			Value: false,
+			Usage: "whether to check functions that return funcs",
+		},
+		"honorDocs": {
+			Value: true,
+			Usage: "whether to skip functions that mention nil in their doc comments",
+		},
+	}
+	info.Summary = "Detects return statements that return nil value together with nil error"
+	info.Before = `
+func find(id int) (*User, error) {
+	// ...
+	return nil, nil
+}`
+	info.After = `
+func find(id int) (*User, error) {
+	// ...
+	return nil, errNotFound
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&returnNilNilChecker{
+			ctx:         ctx,
+			checkMaps:   info.Params.Bool("checkMaps"),
+			checkSlices: info.Params.Bool("checkSlices"),
+			checkFuncs:  info.Params.Bool("checkFuncs"),
+			honorDocs:   info.Params.Bool("honorDocs"),
+		}), nil
+	})
+}
+
+type returnNilNilChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkMaps   bool
+	checkSlices bool
+	checkFuncs  bool
+	honorDocs   bool
+}
+
+func (c *returnNilNilChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if c.honorDocs && decl.Doc != nil && strings.Contains(decl.Doc.Text(), "nil") {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	results := fn.Type().(*types.Signature).Results()
+	if results.Len() != 2 {
+		return
+	}
+	if !isErrorType(results.At(1).Type()) || !c.isCheckedType(results.At(0).Type()) {
+		return
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // Has its own results list
+		case *ast.ReturnStmt:
+			if len(n.Results) == 2 && isNil(c.ctx.TypesInfo, n.Results[0]) && isNil(c.ctx.TypesInfo, n.Results[1]) {
+				c.warn(n, decl.Type.Results.List[0].Type)
+			}
+		}
+		return true
+	})
+}
+
+func (c *returnNilNilChecker) isCheckedType(typ types.Type) bool {
+	switch typ.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+		return true
+	case *types.Map:
+		return c.checkMaps
+	case *types.Slice:
+		return c.checkSlices
+	case *types.Signature:
+		return c.checkFuncs
+	default:
+		return false
+	}
+}
+
+func (c *returnNilNilChecker) warn(cause ast.Node, typ ast.Expr) {
+	c.ctx.Warn(cause, "returning nil %s and nil error; return a sentinel error or a non-nil value instead", typ)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -63,6 +62,7 @@ func newRuleguardChecker(info *linter.CheckerInfo, ctx *linter.CheckerContext) (
 	// with an empty rules set.
 
 	engine := ruleguard.NewEngine()
+	const initErrorFormat = "ruleguard init error: %+v"
 	fset := token.NewFileSet()
 	filePatterns := strings.Split(rulesFlag, ",")
 
@@ -75,23 +75,23 @@ func newRuleguardChecker(info *linter.CheckerInfo, ctx *linter.CheckerContext) (
 		filenames, err := filepath.Glob(strings.TrimSpace(filePattern))
 		if err != nil {
 			// The only possible returned error is ErrBadPattern, when pattern is malformed.
-			log.Printf("ruleguard init error: %+v", err)
+			log.Printf(initErrorFormat, err)
 			continue
 		}
 		for _, filename := range filenames {
-			data, err := ioutil.ReadFile(filename)
+			data, err := os.ReadFile(filename)
 			if err != nil {
 				if failOnErrorFlag {
-					return nil, fmt.Errorf("ruleguard init error: %+v", err)
+					return nil, fmt.Errorf(initErrorFormat, err)
 				}
-				log.Printf("ruleguard init error: %+v", err)
+				log.Printf(initErrorFormat, err)
 				continue
 			}
 			if err := engine.Load(parseContext, filename, bytes.NewReader(data)); err != nil {
 				if failOnErrorFlag {
-					return nil, fmt.Errorf("ruleguard init error: %+v", err)
+					return nil, fmt.Errorf(initErrorFormat, err)
 				}
-				log.Printf("ruleguard init error: %+v", err)
+				log.Printf(initErrorFormat, err)
 				continue
 			}
 			loaded++
@@ -132,16 +132,39 @@ func runRuleguardEngine(ctx *linter.CheckerContext, f *ast.File, e *ruleguard.En
 	type ruleguardReport struct {
 		node    ast.Node
 		message string
+		fix     linter.QuickFix
 	}
 	var reports []ruleguardReport
 
-	runCtx.Report = func(_ ruleguard.GoRuleInfo, n ast.Node, msg string, _ *ruleguard.Suggestion) {
+	// The if statement report already covers its condition,
+	// so the condition is not reported by the same rule group again.
+	reportedConds := make(map[ast.Expr]string)
+
+	runCtx.Report = func(info ruleguard.GoRuleInfo, n ast.Node, msg string, s *ruleguard.Suggestion) {
+		if cond, ok := n.(ast.Expr); ok && reportedConds[cond] == info.Group.Name {
+			return
+		}
+		ifStmt, isIf := n.(*ast.IfStmt)
+		if isIf {
+			reportedConds[ifStmt.Cond] = info.Group.Name
+		}
 		// TODO(quasilyte): investigate whether we should add a rule name as
 		// a message prefix here.
-		reports = append(reports, ruleguardReport{
+		r := ruleguardReport{
 			node:    n,
 			message: msg,
-		})
+		}
+		if s != nil {
+			r.fix = linter.QuickFix{
+				From:        s.From,
+				To:          s.To,
+				Replacement: s.Replacement,
+			}
+			if isIf {
+				r.fix.Replacement = unindentBody(s.Replacement)
+			}
+		}
+		reports = append(reports, r)
 	}
 
 	if err := e.Run(runCtx, f); err != nil {
@@ -155,6 +178,25 @@ func runRuleguardEngine(ctx *linter.CheckerContext, f *ast.File, e *ruleguard.En
 		return reports[i].message < reports[j].message
 	})
 	for _, report := range reports {
-		ctx.Warn(report.node, "%s", report.message)
+		if report.fix.Replacement != nil {
+			ctx.WarnFixable(report.node, report.fix, "%s", report.message)
+		} else {
+			ctx.Warn(report.node, "%s", report.message)
+		}
+	}
+}
+
+// unindentBody moves the lines of the if statement body replacement
+// one level left, the body statements are indented deeper than the if itself.
+func unindentBody(body []byte) []byte {
+	lines := bytes.Split(body, []byte("\n"))
+	for i := 1; i < len(lines); i++ {
+		if !bytes.HasPrefix(lines[i], []byte("\t")) && len(lines[i]) != 0 {
+			return body
+		}
+	}
+	for i := 1; i < len(lines); i++ {
+		lines[i] = bytes.TrimPrefix(lines[i], []byte("\t"))
 	}
+	return bytes.Join(lines, []byte("\n"))
 }
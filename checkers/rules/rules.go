@@ -18,30 +18,6 @@ func deferUnlambda(m dsl.Matcher) {
 		Report("can rewrite as `defer $pkg.$f($args)`")
 }
 
-//doc:summary Detects deprecated io/ioutil package usages
-//doc:tags    style experimental
-//doc:before  ioutil.ReadAll(r)
-//doc:after   io.ReadAll(r)
-func ioutilDeprecated(m dsl.Matcher) {
-	m.Match(`ioutil.ReadAll($_)`).
-		Report(`ioutil.ReadAll is deprecated, use io.ReadAll instead`)
-
-	m.Match(`ioutil.ReadFile($_)`).
-		Report(`ioutil.ReadFile is deprecated, use os.ReadFile instead`)
-
-	m.Match(`ioutil.WriteFile($_, $_, $_)`).
-		Report(`ioutil.WriteFile is deprecated, use os.WriteFile instead`)
-
-	m.Match(`ioutil.ReadDir($_)`).
-		Report(`ioutil.ReadDir is deprecated, use os.ReadDir instead`)
-
-	m.Match(`ioutil.NopCloser($_)`).
-		Report(`ioutil.NopCloser is deprecated, use io.NopCloser instead`)
-
-	m.Match(`ioutil.Discard`).
-		Report(`ioutil.Discard is deprecated, use io.Discard instead`)
-}
-
 //doc:summary Detects suspicious mutex lock/unlock operations
 //doc:tags    diagnostic experimental
 //doc:before  mu.Lock(); mu.Unlock()
@@ -110,9 +86,29 @@ func preferDecodeRune(m dsl.Matcher) {
 //doc:before  len(arr) <= 0
 //doc:after   len(arr) == 0
 func sloppyLen(m dsl.Matcher) {
+	m.Match(`if len($x) >= 0 { $*body }`).
+		Suggest(`$body`).
+		Report(`the if statement can be unwrapped, len($x) >= 0 is always true`)
+	m.Match(`if 0 <= len($x) { $*body }`).
+		Suggest(`$body`).
+		Report(`the if statement can be unwrapped, 0 <= len($x) is always true`)
+
 	m.Match(`len($_) >= 0`).Report(`$$ is always true`)
+	m.Match(`0 <= len($x)`).
+		Suggest(`len($x) >= 0`).
+		Report(`$$ is always true`)
 	m.Match(`len($_) < 0`).Report(`$$ is always false`)
-	m.Match(`len($x) <= 0`).Report(`$$ can be len($x) == 0`)
+	m.Match(`0 > len($x)`).
+		Suggest(`len($x) < 0`).
+		Report(`$$ is always false`)
+	m.Match(`len($x) <= 0`, `0 >= len($x)`).
+		Suggest(`len($x) == 0`).
+		Report(`$$ can be len($x) == 0`)
+
+	m.Match(`$n := len($_); if $n < 0 { $*_ }`, `if $n := len($_); $n < 0 { $*_ }`).
+		Report(`$n < 0 is always false`)
+	m.Match(`$n := len($_); if $n >= 0 { $*_ }`, `if $n := len($_); $n >= 0 { $*_ }`).
+		Report(`$n >= 0 is always true`)
 }
 
 //doc:summary Detects value swapping code that are not using parallel assignment
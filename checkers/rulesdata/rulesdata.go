@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _bindataRulesRulesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x61\x73\xdb\x36\x93\xfe\x1c\xff\x8a\x2d\x87\x4d\x28\x45\xa6\x1c\x4f\xdb\xe9\xc4\x51\x6e\xd2\xf8\xd2\xf3\x4c\x92\x66\x1c\xa7\xed\x4c\xea\x86\x10\xb9\xa2\x71\x06\x01\x16\x00\x2d\xf1\x52\xff\xf7\x1b\x00\x14\x4d\xd2\x22\x23\xeb\x8d\xdf\xbe\x1f\x3a\xb5\x88\xc5\xf3\x2c\x76\xb1\x8b\x5d\x20\x39\x89\x2f\x49\x8a\x90\x0a\x59\x30\x54\x7b\x7b\x34\xcb\x85\xd4\x10\xec\x3d\xf0\x52\xaa\x2f\x8a\x79\x18\x8b\x6c\xfa\x57\x41\x14\x65\xa5\xc6\x69\x2a\xf6\x8d\x64\x5a\x10\x99\x4c\x13\xc5\xbc\xbd\xd1\xde\xde\x74\x9a\x88\xf8\xa9\x2a\xb2\x8c\xc8\x12\x8e\x51\x63\xac\x15\x24\xb8\x40\x29\x31\x81\x45\xc1\x63\x4d\x05\x07\x46\x35\x4a\xc2\x14\xe8\x0b\xa2\x21\x26\x1c\xe6\x08\x8a\x66\x39\xa3\x0b\x8a\x49\x85\xa3\x49\xaa\x00\x00\x94\x2e\x19\x02\xae\x72\x94\x34\x43\xae\x09\xab\x04\xe6\xb8\x10\x12\xc1\x11\x58\xf4\x60\x04\x9f\x61\x11\x8c\xe0\x3a\x18\x55\x42\x64\xa1\x51\x42\x2d\x14\x8c\xf6\x8c\xa0\xfb\xf9\x81\x33\x92\xcd\x13\x12\x64\x90\x28\x16\xbe\x21\x3a\xbe\x40\x39\x82\xcf\x7b\x0f\x32\xf7\x2b\x88\x3a\xe0\xfe\x22\xf0\xc7\x44\xa6\xca\x72\x44\xa3\x70\xef\xc1\x83\xdf\x2e\x50\x62\x90\x7d\xf4\x16\xde\x79\xf8\x56\x24\x18\x9e\xa8\x20\x3a\x49\x90\xeb\x68\x04\x0f\x1f\x42\x35\x74\x86\x2b\x0d\xdf\xcc\xc0\xcb\x09\xa7\xb1\xb7\x69\x44\x62\x2c\xae\x50\xae\xc7\x0c\x91\x77\x1e\xbe\x14\x5c\x69\x4b\x75\x8a\xc6\x2d\x81\x67\x6c\x26\x71\x29\xa9\x46\x20\x0a\x2a\x2d\x8d\x72\x56\xb7\xc8\x1b\xed\x0d\xac\x21\xbf\x4c\xc3\x5d\x17\xd2\x54\xa9\xfa\x94\x5f\xa6\xde\x79\xf8\xcb\xfc\x7f\x31\xd6\x76\xc6\xbb\xcb\xf4\x2d\xc9\x30\x1a\x6d\xa3\xf3\x5a\x99\x5a\xf1\xeb\xfe\x8d\x94\x4b\x8c\x89\xc6\x04\xa8\x98\x52\x51\x68\xca\x60\xbd\x73\x0b\x45\x52\x54\x77\xdd\x3a\x0e\x24\x3c\x45\x92\xbc\x60\x2c\x90\xdd\x5d\x43\x45\x73\xcc\xee\x1c\x37\xe5\xb8\xd6\x65\x68\xf3\x74\xe0\xfd\x4f\x95\xa1\x2b\x93\x74\xc6\x81\x36\xd7\x38\x81\x42\x61\x43\x01\xa0\x5c\x69\x24\x49\xd4\x72\x6d\x03\xe1\x15\x65\x38\x48\x61\x04\x36\x71\x08\xd5\x18\x1e\x20\xf9\xcd\xb8\xae\x62\x99\x80\xfb\x6f\x33\x5b\x2d\xd9\x43\xd7\x18\xff\xc2\xa2\x8e\xa9\x1c\x5c\xd3\x31\x95\x03\x4b\xb2\xa3\x03\x0c\x6f\x45\xfe\x92\x09\x85\xfd\x1c\xb5\x44\x8f\x73\x1a\xe3\x03\x3c\xc7\x54\xc5\x44\x26\x1b\x19\xaa\xb1\x1e\xfc\x7a\xb4\x46\xef\x0d\x0f\x55\xa8\x9c\xc6\x54\x14\x0a\xb2\x42\xe3\x0a\x98\x88\x2f\xa7\x05\x37\xff\x03\x91\xa3\x24\x26\xf9\x76\x43\x24\xa1\x24\xe5\x42\x69\x1a\x0f\xc5\x49\x56\x84\xaf\x45\x7c\x19\x8c\x8e\xcc\x9f\x1f\x2c\xe6\xad\x14\xdb\x10\x72\xb1\xdd\x14\xb5\xb1\x33\x27\x89\x95\xb8\x1d\x32\xd3\x29\x44\x59\xf1\x24\x02\xc2\x13\xf3\xd7\x61\x04\x44\x22\x90\x24\xc1\x04\xb4\x80\x8c\x5c\x22\xe4\x42\x29\x3a\x67\x08\xd2\x9a\x10\x08\x30\xca\x11\x96\x26\x6d\x41\x94\x1d\x46\xc6\x88\x85\xc2\x04\x82\x25\xd5\x17\x40\x9c\x1e\xc6\x23\xd3\x29\x70\xe1\x7e\x36\xdc\xe3\x67\xc5\x93\x5a\x67\x3f\x2b\x0e\x6b\x7d\x3b\x09\x31\x2b\x9e\xac\xd3\xf4\x6c\x06\xf6\xc3\x61\xf5\xa1\xe5\x53\xb7\x6e\xaa\x20\xa3\x4a\x51\x9e\x4e\x2a\x57\x18\xbd\x2c\xb2\xc9\x5d\x59\x86\x09\x25\x1a\x59\xe9\x58\x5e\xe8\x60\x8d\xd8\xda\x3c\x56\xbb\xd3\x96\x7a\xa7\xff\x01\xfa\x4d\xa7\x90\xd0\xc5\x02\x25\x72\x0d\xdd\xcd\xd5\x63\xdb\x2a\xd7\x7f\x8d\x25\x34\xb6\xb9\x53\x79\x02\x19\x29\xe7\x08\x0e\x17\x96\x44\x01\xe5\x1a\x79\x82\xc9\x7f\xdd\xc5\xc0\x0d\x1d\xef\x4b\xc5\xd3\xad\x75\x34\x46\x16\x85\xd9\xeb\x66\xc2\x36\x76\x7d\xfd\xaf\x68\xec\xd4\xab\xd1\x9e\xd4\x16\xf8\x2a\xe6\x3c\xfd\xba\xba\x9d\x6e\xad\x5c\x6f\xae\xe4\x94\x55\x15\x03\x50\x0e\x17\x5a\xe7\xe1\x5b\x5c\x9e\xe2\x5f\x05\x2a\x53\x91\x32\xa6\x26\xa0\x8a\x34\x45\xa5\x29\x4f\x2b\x09\xf1\x93\x48\x4a\x53\xbb\x10\x0e\x84\x69\x94\x9c\x68\x7a\x85\x77\x2d\x3a\x3a\x74\x81\xf7\xf3\x7f\x9f\x79\x13\x28\x24\x9b\x18\xc5\xba\x69\x75\x48\xbc\xa1\x57\x95\x62\xcd\x17\xf7\x61\xa0\x30\xf1\xba\x98\x7e\x86\xfa\x42\x24\x13\xf0\x2d\xac\x6f\xd4\xf0\xda\xee\xe2\x94\x35\xdc\x65\x7f\x5a\x81\xf7\xce\x4a\x5f\xc4\x6c\xaa\xea\xb5\x0a\xc3\xa6\x71\xd5\x85\x28\x58\x62\xfa\x81\x5c\xae\xfb\x06\x2d\x40\x5f\xa0\xf5\x99\xac\x3c\x34\x17\x49\x39\x54\x2a\xe2\x2a\x97\xa8\x94\x49\x49\xc0\xe8\x25\xc2\xc7\x73\x59\x70\x0c\xd4\xe8\xe3\xc1\xb9\x6b\x3b\x32\x52\x42\x4c\xcc\x31\x5b\xf0\x25\xe1\xa6\xaa\x34\x22\xa0\x18\x8d\x11\x08\x63\x22\xb6\x39\xad\xe3\xdd\x1c\xe5\x42\xc8\x8c\xf0\x78\xd0\xc7\x12\x9e\xce\x5a\xa4\x1d\xa7\xca\x09\x7c\x32\x22\x85\x5e\xfc\x18\x1e\x63\x2c\x12\x3c\x2d\x38\x9e\xf0\xf7\x5a\x52\x9e\x06\x6a\xbd\x0b\xb8\xd0\x68\x78\xdf\x23\xc2\xcf\x02\xa8\x52\x05\xc2\x42\x48\x48\x50\x13\xca\xd4\x53\x6b\x58\xf5\x74\x3a\x6d\xf4\x69\xa9\x60\x84\xa7\xd3\x54\x4c\xad\xbc\x9a\x7e\xf7\xfd\xe1\x0f\x07\x6e\x83\x38\xbb\xde\x50\x0e\xd5\xaf\xd5\x02\x7c\xbb\x82\x4e\xf8\x9a\x2e\xe0\xac\xcc\x5d\x8f\xa0\xac\xd6\xed\x82\x3f\x8a\x05\x57\x34\x41\x69\x8e\x6b\x46\x62\x13\x47\xbe\x0f\xf6\x64\xee\x5b\xb6\xaf\x46\x43\x25\x8e\x0d\x59\x10\x0b\x88\x18\xf2\xc8\x1c\xfc\xa6\xa5\x50\x05\xd3\xe6\x04\x13\xf3\x2b\x9b\x73\x8d\x71\x04\x2a\xfe\x48\xbb\xb2\x41\x21\x57\x1b\x83\xb4\xe3\x33\x86\x3c\x20\x52\x8e\xe0\xd9\x0c\x0e\x3a\xfe\xaa\xc7\x66\x66\xcc\x1a\x52\x31\x91\xe7\xe5\x6b\xe4\x43\x16\x34\xf3\xfc\x4f\x23\x78\x3e\x83\x83\x68\x14\xae\x4d\xe3\xfb\x46\x61\xc2\x96\xa4\x54\xa0\x65\x81\xd1\x68\xc3\xa4\x67\xfd\x73\x16\x84\xa9\x0d\x93\x56\x4e\xf9\xf6\xac\xaa\xc1\x5e\x0b\xcc\xac\x40\xbf\x91\xaf\x08\x2b\x10\xd4\x92\xe4\xb9\x71\x99\xf1\x91\x8b\x18\x53\x90\x71\xa1\xa1\x30\x95\x03\xe4\x44\x12\xc6\x90\x01\x51\x8a\xa6\xdc\x44\xc1\x16\x36\x1e\xeb\x2c\x87\x19\x8c\x57\x47\x30\x5e\x99\x3f\xca\x23\x18\x97\xe6\x0f\x9d\xe5\x1d\x9b\x8f\x57\x93\x6a\xac\x9c\xc0\x78\xe5\xac\x7e\x45\xd8\xfb\x25\xc9\x87\x6c\xee\x1b\x8e\xa7\x33\xf0\xcb\x23\xf0\xcd\x7c\x7f\x75\x04\xbe\x61\x33\x23\xd1\x86\xa6\x74\xff\xa6\x2b\xf5\xcb\x49\x25\xbb\x9a\x80\x5f\x0e\x36\xa4\x6a\x49\x75\x7c\xb1\x6f\x3a\xf6\xfd\xb9\x10\x0c\x94\x26\x1a\x8d\x25\xaa\xab\x0d\x93\x5d\x70\x95\x33\x1a\x53\x0d\x91\xf5\x33\x68\x92\x3a\x13\x6f\x61\x2d\x47\x60\x37\x08\x7c\x0e\xc3\xf0\xba\x63\xa1\x6a\xdc\x0d\xb9\x4d\x69\xbf\x9c\xc9\x62\x30\xae\x5b\xb8\xe0\x8f\x3f\xc1\x75\xbb\x37\x71\x11\x8b\xf0\xa8\x25\x79\xfd\xc8\x45\xef\xfa\xeb\xe7\xeb\x47\xad\x2d\x58\x7d\x36\xe6\xde\x1e\xfa\x46\xba\x0b\x6f\x46\x1c\x45\xaf\x07\xea\x5a\x15\x12\xb4\x79\x0d\xb9\xcd\x33\x26\x45\x2c\x18\x49\xa3\xfa\x7e\x20\x17\xa6\x50\x90\xfd\xed\x4f\xc7\xf4\x73\xb3\x83\xc6\x06\x23\xfc\x49\x08\x16\x78\x73\x6f\xe2\xa2\x6e\x02\xde\x1c\x12\x11\x2b\xaf\x7b\x52\x5f\x11\x09\x73\x30\x3b\xe1\x08\xea\x99\xbf\x12\x19\x3c\x9c\x9b\x49\x9b\x00\xac\xd3\x8c\xec\xb1\xd1\x7f\xc8\x67\x0d\x5d\xfc\xb1\xed\x56\xeb\x46\xb2\x6d\x04\x53\xd1\xb8\x5c\x91\x09\xa5\xed\xf1\xc7\x4a\x53\xb2\xa0\x94\x42\x1e\x41\x9d\x96\x5d\x1c\x37\x15\x6d\x79\xd3\x11\x1e\x17\xae\xb4\xbf\x07\xd2\x35\xf4\x66\xe2\x57\x4c\x10\xfd\xc3\x77\xf7\xc0\x5b\x21\x6f\xa6\x3d\xe1\xfa\x1e\x28\x4f\xb8\xee\xa5\xbb\x97\x35\x5a\xdc\xcd\x94\xeb\xa3\xf6\xab\x73\x3a\xe0\xcd\xa4\x1f\xe8\xbd\xd8\xd5\xc0\xf6\x13\xde\x8b\x65\x1d\xb0\x23\xed\xaf\x3f\xb3\x5c\x97\xe0\xaa\x22\x88\x2f\x30\xbe\x6c\x5f\x76\x9b\xf3\x46\x23\x87\xcc\xe4\x1a\x9a\x50\x91\x11\x4d\x4d\xdb\x51\xde\xb5\x99\x30\xa7\xba\xaa\xca\x92\xce\xe1\x60\xeb\x74\xcf\xa5\x18\xab\x90\x73\xd0\x99\x29\xce\xbf\x54\xb2\xa8\x11\x7c\xe3\x0a\x89\x3b\xd5\x7c\xde\x3a\xbb\x47\xbe\x1f\xb9\x6c\x1e\xf9\xca\x40\xfd\xe1\xfd\xe1\x75\xae\xa5\xd7\x44\xb3\xaf\x47\x34\xbb\x21\xea\xf5\x8d\xc4\xa4\xe0\x09\xe1\xda\xb8\xf7\x0a\xa5\xeb\x12\xe6\xa8\x97\x88\x7c\xed\x33\xc2\x13\xf8\x78\x3e\x2f\xf5\x36\xc7\x74\x2c\xf2\x32\x98\x4f\xaa\x09\x81\x1a\x75\x0f\x86\xb5\x80\xaa\x12\xbe\x23\xf9\xdd\x48\xab\x21\x57\xd8\x79\xfe\xa7\x1a\xd9\x57\xa3\xc6\x6e\xb6\xc5\x4b\xf5\x6c\x52\x42\x74\x23\x13\x99\x96\x29\xf2\xd5\x70\xf1\x62\x75\x50\xe1\x09\x4f\x70\xe5\x5a\xde\xde\xc6\xc8\x76\x43\xdd\xa3\xb3\xd1\x09\x75\xcb\x96\x26\x74\xe0\x7e\x05\xab\xd1\x04\xca\xae\x61\xac\x05\x2a\xb9\x55\xbd\xcc\x72\xf4\x75\xbb\x9f\xc3\xef\x7f\xfc\xe1\xbb\xea\xf6\xde\x50\xbd\x30\xcb\x19\xac\x8f\x36\x2d\xc0\x37\x2b\xf0\xcb\xee\x35\xc6\xca\x3b\x0f\xdf\x15\x12\xab\xc7\x90\xb2\xfa\xb9\x6d\x27\xd4\xb4\x80\x7f\x63\x02\xbf\x1c\x0d\x76\x42\xf5\x5b\x5a\xc3\x71\x55\x6e\xa9\x22\x23\x71\xf8\x76\x8f\x73\x8a\xa6\x5f\x5d\x4a\x92\xe7\xb7\x6b\xa0\x4d\x5b\x7a\x99\x86\x2f\x92\x24\xd8\x7f\xd2\xf5\xd8\x32\x0d\x8f\x05\xc7\xf5\x8d\x6e\x05\xf9\xaa\xe0\x83\x06\xf5\x6f\xf0\x3a\xe6\x5b\xa6\xad\x50\x2f\x79\x1c\xfe\x46\xa8\xfe\x59\x8a\x22\xef\x84\xbc\xd9\x92\xf5\x98\xd5\x02\xdc\x15\x83\x49\xe3\x26\x0f\xb4\xd3\x8b\x3f\x2f\x16\xe1\x99\x2c\x78\x4c\x34\x06\x07\x5d\xe2\x79\xb1\x68\x32\x3b\x3f\xfc\x54\x2c\x16\x28\x37\xf0\xba\x81\xf0\x14\x15\xea\x41\x56\x7b\x99\xf1\x3f\x84\x27\xac\xb2\x4a\x75\xbb\xa1\x5f\x89\x82\x27\xcd\xe8\x35\xb0\xad\xc1\x6a\xd6\x20\xfc\x7a\x67\xbe\xcf\x19\xd5\x6f\xeb\x87\x18\x67\xd7\x26\x72\x4b\xf0\x36\xe4\x6d\xc4\x53\xb7\x6d\x1a\x6f\x3b\x43\xb0\x95\xf4\x0b\xc6\xb6\xc1\x7e\x43\xf2\xa0\xe0\xd4\xb4\x92\xe1\x99\x38\xa3\x9a\xe1\xfa\xf1\x68\x13\x78\x25\x32\x68\x08\xe7\xaf\xca\x0c\x37\xb9\xd7\x0b\xbd\xd1\x26\xbd\x1b\xe2\x43\x0a\x3b\xb1\xed\x4c\xd1\x92\xfd\x82\x21\x9c\x6c\xdb\x0c\x1f\x4c\xe0\x6c\x32\x83\x13\xae\x04\xee\x8a\xfa\x5a\x2c\x87\x51\xad\xc0\x5d\x51\x7b\x5d\xb6\x46\xfd\xb2\xc3\x12\x49\x96\xe1\xb1\x24\xcb\x37\x44\x5d\xb6\x4c\x6b\xfe\xe3\x94\x4d\x80\x66\x24\xc5\xf0\x9d\xe9\xd4\x3e\x5f\xdf\x7e\x5d\xb4\x84\x35\xcc\x6d\xb2\xde\x64\x19\x49\x4c\x71\x95\x87\x2f\x45\x96\x53\x86\xe3\x68\x20\x5f\xae\x65\xdf\x14\x4a\xd7\xf2\x5b\x24\x4c\x89\xd5\x8d\x5e\x9b\x2b\xf0\x62\xfb\x52\x9e\x13\xad\x51\xf2\x5b\x2d\xa3\xc4\xc6\x9c\x06\xe7\xed\x79\x36\xdd\x3a\x41\x23\x37\x94\x6d\x3b\x2a\xf8\x39\xd1\xdd\xec\x97\x13\xbd\xe9\x1f\x16\x44\xe6\x6c\x6d\x31\x57\xf7\xa7\x06\xc3\x3d\x4a\xde\xd6\xb5\xfd\xe4\xd9\x26\x7f\xf7\xcb\xfb\x93\xdf\xef\x5d\x03\xcb\xb2\xe5\xdb\x68\xfb\xe4\xdc\xf6\x3a\xa0\x9b\x25\xd5\x04\x16\x52\x64\x13\xd0\x62\x02\x07\x5d\xaf\x0e\x4a\x9b\x33\x75\xfd\x1a\xfa\x92\x30\xb6\x4d\x21\xb2\x29\x23\xf9\xff\x87\x52\x74\xad\x6a\xbe\x79\xe7\xe1\xaf\x84\x15\x68\x7b\x59\x57\x5e\xf7\x3d\x4f\x11\x99\xc2\xc1\x04\x72\x29\xe6\x64\xce\x4a\xc8\xd0\xd4\xc5\xfb\x4f\xa2\x51\xe8\x1e\x52\x1c\xde\x76\x29\xf2\xdf\xaa\xd0\x97\xcf\xc4\x7f\xd4\x3e\xff\xa4\x2e\x0d\x65\x4c\x71\xc6\x93\xa0\x95\xb7\x23\x2e\xf6\x45\x0e\x6e\xc8\x46\x41\x13\xd4\xbd\x0b\x1b\xb2\xc2\x5e\x64\x0e\x45\xd5\xcd\xcd\x6f\xdf\x3f\xe5\x82\x79\x59\xf5\xce\x37\xb2\xd5\x73\xb1\xd8\xaa\x0e\x5d\xc1\x0c\x56\x30\x86\xc3\x4e\x84\xad\x60\x3c\x83\x43\x17\x47\x0e\xfa\x97\xe1\x3b\x61\x77\xa5\x0b\x8f\xc1\xd8\xeb\x56\xf1\x7e\x73\xa8\x6d\xea\x2b\x57\x8f\x1f\xb7\x8f\xc8\x35\xda\xfe\x4e\x68\xfb\xfb\xdd\x4a\xb5\x56\xce\x2f\x77\xc0\x83\xc7\xb3\xea\xa6\x7a\x93\x86\xbb\x41\xee\xaf\x21\x37\x60\x8e\x77\xc4\x1c\xf7\xab\x39\xdd\x11\x72\xda\x0f\xf9\xed\x8e\x90\xdf\xf6\x43\x3e\xdc\x11\xf2\x61\x3f\xe4\xdf\x3b\x42\xfe\xdd\x0f\xf9\xe7\x8e\x90\x7f\xf6\x43\x3e\x7b\xb6\x23\xe6\xb3\x67\xfd\xa0\xcf\x9f\xef\x08\xfa\xfc\xf9\x80\x8b\x76\x5d\xfd\xc3\x7a\xf9\xd7\x7b\xff\x1f\x00\x00\xff\xff\x6d\x73\x01\x11\x0e\x2b\x00\x00")
+var _bindataRulesRulesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xcd\x5a\x7b\x73\xdb\x36\x12\xff\x3b\xfe\x14\x28\x87\x75\x28\x47\x0f\xc7\x93\x66\x3a\x89\xe5\x9b\x26\xbe\xe4\x32\x93\xd7\xd8\x4e\xdb\x99\x34\x0d\x21\x12\x92\x59\x93\x04\x4b\x80\x91\x78\xa9\xbf\xfb\xed\x02\x20\x45\x52\x24\xad\xe8\xec\xeb\x79\xc6\x36\x05\x2c\x7e\xbb\xd8\x5d\xec\x03\x54\x42\xbd\x2b\xba\x60\x64\xc1\xd3\x2c\x64\x62\x6f\x2f\x88\x12\x9e\x4a\xe2\xec\xdd\xb3\x16\x81\xbc\xcc\x66\x63\x8f\x47\x93\x3f\x33\x2a\x82\x30\x97\x6c\xb2\xe0\x23\xa4\x5c\x64\x34\xf5\x27\xbe\x08\xad\xbd\xc1\xde\xde\x64\xe2\x73\xef\x89\xc8\xa2\x88\xa6\x39\x39\x65\x92\x79\x52\x10\x9f\xcd\x59\x9a\x32\x9f\xcc\xb3\xd8\x93\x01\x8f\x49\x18\x48\x96\xd2\x50\x10\x79\x49\x25\xf1\x68\x4c\x66\x8c\x08\x60\x19\x06\xf3\x80\xf9\x06\x47\xd2\x85\x20\xf0\x23\x64\x1e\x32\xc2\x56\x09\x4b\x83\x88\xc5\x92\x86\x86\x60\xc6\xe6\x3c\x65\x44\x33\x50\xe8\xce\x80\x7c\x25\x73\xf8\x7b\xed\x0c\x0c\x11\x9d\x03\x2f\x52\x12\xc1\x38\x12\xea\x8f\x1f\xe2\x90\x46\x33\x9f\x3a\x11\x81\x2d\x8c\xdf\x50\xe9\x5d\xb2\x14\x30\xf6\xee\x45\xfa\x93\xe3\x36\xc0\xed\xb9\x63\x1f\xd0\x74\x21\x14\x0f\x77\x30\xde\xbb\x77\xef\x17\x58\xc4\x9c\xe8\xa3\x35\xb7\x3e\x8d\xdf\x72\x9f\x8d\x5f\x09\xc7\x7d\xe5\x83\xac\xee\x80\xec\xef\x13\x33\x75\xc1\x56\x92\x7c\x37\x25\x56\x42\xe3\xc0\xb3\xda\x66\x52\xe6\xf1\x2f\x2c\x2d\xe6\x90\x11\x4c\x3f\xe7\xb1\x90\x8a\xd5\x19\x43\xb3\x38\x16\xea\x2c\x65\xcb\x14\x14\x49\xa8\x20\x46\x4a\x14\x4e\xc9\xe6\x5a\x60\x8d\xee\x3d\x24\x57\x8b\xf1\xae\x1b\xa9\x8a\x64\x86\x00\x0e\x46\xde\xcd\xfe\x00\x73\xab\x15\xef\xaf\x16\x6f\x69\xc4\xdc\xc1\x36\x32\x17\xc2\x94\x82\x5f\x77\x39\x92\xc8\x44\x12\x78\x01\xcf\x04\x89\x32\xc9\x56\x24\xe4\xde\xd5\x24\x8b\xf1\x1f\xe1\xe0\x1e\x14\xbd\x4b\x34\xdc\xc7\x0f\xe8\x22\xe6\x42\x06\x5e\x9f\x0f\x45\xd9\xf8\x35\xc0\x38\x83\xa7\xf8\xf8\x41\x61\x6e\xf8\x50\x85\x48\x0b\x5f\x25\x55\x6e\x35\xa3\xbe\xa2\xd8\x74\xa8\xc9\x84\xb8\x51\xf6\xd0\x25\x34\xf6\xf1\xe9\x08\x9e\x80\x31\xf5\x7d\x38\x19\x92\x93\x88\x5e\x31\x92\x70\x21\x82\x19\x78\x7b\xaa\x74\x46\x28\x1c\x95\x98\x91\x25\xda\x05\x16\xc1\x9a\x40\x90\x4c\xc0\x0a\x67\x09\xa7\x12\xe6\x95\x1c\x68\x6c\xc0\x8f\xb9\xfe\x58\xb1\xbc\x0d\x2c\x4b\x99\xe1\xc3\x51\x29\x6f\xc3\xe2\x40\x57\xf8\xe1\x74\x4a\xd4\xc0\x91\x19\xa8\x1a\xd1\x18\x0d\xa4\x88\x02\x10\x35\x5e\x0c\x8d\x29\x50\x2e\x85\x0c\xb2\x05\x51\xc4\x40\xeb\x92\x85\xb9\xe6\xf2\x93\x74\x0a\xc4\x9a\x5f\x2a\xe9\xce\x6a\xe2\x9d\xfd\x1f\xc8\x07\xaa\xf4\x83\x39\xe0\x80\xa3\x90\xa6\x73\x75\xe8\xd6\x38\xf3\x6d\x6c\xa1\xe2\xe6\x5a\x64\xd8\x03\xcd\x21\x42\x6a\x5c\xb2\x84\xc3\x13\xc4\x92\xc5\xe0\x39\xff\xf8\x16\x05\x57\x64\xbc\x2b\x11\xcf\xb6\x96\x11\x95\xcc\x33\xf4\x75\x5c\xb0\x8d\x5e\x5f\xff\x37\x12\x6b\xf1\x4a\xb4\x87\xa5\x06\x6e\x45\x9d\x67\xb7\x2b\xdb\xd9\xd6\xc2\x75\xc6\xca\x38\x08\x21\x50\x40\x4a\xc7\xf5\xe4\x52\xca\x64\xfc\x96\x2d\xcf\xd8\x9f\x19\x13\x98\x72\xc3\x50\x0c\x21\xa0\x2e\x80\x40\xc2\x31\x31\x14\xfc\x19\xf7\x73\x0c\xce\x10\xaa\x69\x08\x41\x2f\x06\xaf\xff\xc2\xbe\x35\x21\x37\xd8\x39\xd6\xcb\x7f\x5e\x58\x43\x92\xa5\xe1\x10\x05\x6b\x86\xd5\x3e\xf2\x8a\x5c\x26\xc4\xe2\x88\x1e\xe8\x49\xdb\x56\x13\xd3\x8e\x98\xbc\xe4\xfe\x90\xd8\x0a\xd6\x46\x31\xac\xba\xb9\x60\xa8\x62\x2e\xf5\x51\x11\x9c\x6b\x2d\xdd\x88\x59\x15\xd5\xaa\x65\xbe\xaa\x72\xc5\x25\xcf\x42\x1f\x0b\x9e\x24\x2d\x0a\x23\x08\xff\xf2\x92\x29\x9b\xa5\xc6\x42\x33\xa0\xed\xcb\x85\xa0\xfd\x94\x41\x84\x83\x90\x04\x39\x02\x52\xc7\xc7\x4f\x69\x16\x33\x47\x0c\x3e\x1e\x7e\xd2\x75\x15\xb8\x15\x18\x1a\xb2\x05\x9c\xd1\x25\x05\x27\xf2\x09\x92\x10\x11\x06\x1e\x24\x9e\x10\x5c\x4c\xc5\xb4\x86\x75\xc1\xaa\x60\xc5\x88\xc6\x5e\xaf\x8d\x53\xf2\x64\x5a\x63\xda\x30\x6a\x3a\x24\x9f\x91\x24\x93\xf3\x1f\xc7\xa7\x50\xd6\xf8\xec\x0c\x68\x5f\xc5\xe7\x32\x05\x87\x83\x35\x66\x41\xcc\xa1\x1e\x80\x9f\x73\xc6\xc8\x4b\x0e\x81\x5a\x64\x8c\x00\x0f\x38\x10\x92\x06\xa1\x78\xa2\x14\x2b\x9e\x4c\x26\x95\x42\x74\xc1\x43\x1a\x2f\xe0\xdf\x44\xd1\x8b\xc9\xa3\x1f\x8e\x1e\x1f\x6a\x07\xd1\x7a\x5d\xb3\xec\xab\xee\xcc\x06\x6c\xb5\x83\xc6\xf1\xc5\x32\xe7\x22\x4f\x74\x11\x24\x94\xd4\xf5\x8a\xc6\xf5\x40\xfb\x81\x0f\xdb\x85\x74\x1d\x52\x0f\xcf\x91\x6d\x13\x95\x99\xbb\xb6\x0d\x9c\xdc\x1e\xb3\xaa\x23\x4b\xf8\x9c\xb8\x21\x8b\x5d\x4c\xfc\x58\x33\x89\x2c\x94\x98\xc1\xf8\xec\x8b\x8a\xb9\xa8\x1c\xce\x44\x7c\x5f\xea\xb2\x41\xb0\x58\xb4\x1e\xd2\x86\xcd\x00\xd3\xa1\x29\xa8\xe0\x78\x4a\x0e\x1b\xf6\x2a\xe7\xa6\x38\xa7\x14\x29\x42\x9e\x24\xf9\x6b\x98\xe8\xd1\x60\x30\x57\x4b\xed\xd5\x80\x9c\xc0\x4a\x2c\x2f\x0f\xd0\x79\xc9\xb5\x5b\x3b\x3d\xae\x8d\xa3\x6e\x4d\x7f\xe8\xf4\xb0\x5e\x48\xc8\xc5\xe8\x64\x45\x2b\x00\xfe\x9a\xd2\x24\x61\x70\xb4\x6a\xd8\xa0\x02\x1a\x2e\x69\x0e\x8d\x43\x9a\x41\x79\x59\x17\xe3\x10\xb7\x55\xd0\xdf\xb2\x18\x35\xec\x0d\x31\x2a\x72\x28\x9a\xcf\x5a\x5e\xe0\x52\xb0\x00\xb7\xe8\x11\xbe\x8a\xde\x10\xb7\xba\xff\xba\xd4\xfd\x90\x85\x1c\xc7\xdd\x62\xcc\xa1\x03\x6b\xca\x71\x72\x83\x18\xc7\xbd\x52\x6c\x22\x96\xeb\x50\xfc\x21\x41\x0e\x37\xed\x74\xda\xb6\x53\x63\x91\x3a\x4d\x2d\x35\xc7\x18\x6c\xcc\xae\x9f\xa2\x39\x61\xe4\xd8\xf8\xe3\x67\xf0\x02\x60\xae\x07\xab\x64\x0d\x9a\x3a\x57\x3d\xd7\xb7\xbb\x56\xa6\xe5\x29\xe8\xe3\x5a\x23\x6a\xb2\x6d\xf7\xf5\xce\x98\xf1\x85\x86\x10\x31\xc5\x12\x5c\x15\x23\x10\x86\x1c\x9d\x00\xb0\xbf\x80\xe8\x0a\x41\x05\xc7\x13\x0a\x2d\x77\xc8\x42\x48\xef\x22\x58\xc4\xe8\xe8\x5b\x84\x8c\x03\x19\x25\x64\x4a\x0e\x56\x4f\xe1\x17\x1f\x72\x78\xc8\xf1\x01\x26\x1a\x21\xe4\x60\x35\x34\x73\x39\x3c\xac\x74\x10\x01\xe9\xce\x41\xb4\xbe\x10\x62\x23\x0f\x50\x90\x0d\xd8\x36\xae\xb7\x81\x9b\x8d\xdc\x70\xc6\x6d\x69\x22\x47\xeb\x2e\xd2\x06\x5e\x9a\x16\xb8\xdb\x79\x7f\x03\x09\xa1\xd9\xbb\x1c\x61\x87\x3d\x9a\x71\x1e\xae\x8f\xbc\xb9\x8a\xc0\x64\x09\x39\x0f\x12\x64\x20\x89\xab\x14\x4f\x40\x3b\x5a\xc5\x5b\x68\x4b\x33\x50\x16\x23\x5f\xc7\xe3\xf1\x75\x43\x43\x66\x5e\x4f\xe9\x18\xab\x46\x2e\x60\x41\x9f\x86\x6a\xb8\x6d\x5e\xa3\x13\x10\x23\xf7\x6b\x94\xd7\xf7\x75\x32\x2a\x46\x61\xa0\xe6\xbf\x66\x18\xd5\xbd\x3d\xf4\x9a\xba\x09\x8f\x33\x9a\x45\xa7\x05\xca\xd6\x0b\xb2\xbb\x4a\xd3\x2c\x56\x69\x13\x33\xde\x3c\xa4\x0b\x17\xdc\x54\xdf\x44\x25\x1c\xeb\xde\xb4\xbb\x9b\x6f\xa8\x7e\x86\x1e\x74\x80\x18\xe3\x67\x60\x5a\xc7\x9a\x41\x09\xa9\x8e\xec\x90\x58\x33\xc8\x97\x9e\xb0\x9a\x85\xe7\x17\x9a\xc2\x3a\xf4\x84\xa7\xa4\x5c\xf9\x33\x4d\x9d\xfd\x19\x2e\x6a\x03\x50\x46\x43\xda\x53\x94\xbf\xcf\x66\x15\x59\x40\xab\x83\x4a\x14\x6e\x28\x01\x0b\x74\x1d\x46\x23\xd8\x98\xaa\xe6\xc2\x1c\x2b\x70\x28\x0e\x79\xfa\x94\x94\x55\x86\x3e\xc7\x55\x41\x6b\xd6\xd4\x0c\x4f\x33\xdd\xa9\xde\x01\xd3\x02\xba\x9d\xf1\x8b\x90\x53\xf9\xf8\xd1\x1d\xf0\x35\xc8\xed\x6c\x5f\xc5\xf2\x0e\x58\x02\x6a\x27\xbb\x3b\xd9\xa3\xc2\x6d\x67\x59\x54\x8e\xb7\xce\x53\x03\xb7\x33\xfd\x10\xdc\x89\x5e\x11\xb6\x9b\xe1\x9d\x68\x56\x03\x6b\xa6\xdd\xed\x54\x94\x48\xe8\xcd\x94\x42\x08\x9c\x65\xef\xaa\x7e\x39\x8d\xf9\x06\xba\x70\x60\x0b\xb1\x26\xf0\x03\x1e\xc1\x49\xc0\x2e\x3a\xff\xd6\xde\x18\xab\x00\x61\xaa\xec\x46\x72\x50\x6d\xa7\xa5\x43\x8c\x12\x48\x1b\xe8\x02\xcb\xa3\x9e\x40\xa3\xea\x0a\x80\xfc\xae\x2c\x9c\xb6\x6f\x61\xac\x22\xba\x43\x91\xe5\xea\x68\xee\xda\x02\xa1\x7e\xb3\x7e\xb3\x1a\xd7\xc8\x05\xa3\xe9\xed\x31\x9a\xae\x19\x75\xda\x06\x3a\xe4\x2c\xf6\x29\xd6\xe5\x3c\x86\xd4\xad\x9b\xde\x19\x93\x4b\x06\x16\x31\x36\xc3\x5b\xd5\x8f\x9f\x66\xb9\xdc\x26\x4d\x7b\x3c\xc9\x1d\x08\xf1\x7a\x01\x98\xa3\x99\x18\x0a\x02\x61\x02\xbe\x66\xf2\x2b\x52\x8b\x3e\x53\xa8\x75\xf6\xe7\x12\x19\xb4\x55\xf1\x66\x55\xbc\x98\xd7\x1c\x39\x71\xd7\x34\x2e\xde\x00\x80\x3a\xfa\x8b\x17\x25\x83\x80\x48\xe1\xb3\x95\xbe\xc1\xe9\xec\xf3\x55\x73\x2f\xba\x1b\xfb\x66\xd9\x52\x85\x76\xf4\x27\x67\x35\x18\x92\xbc\xa9\x18\xa5\x01\x43\xb7\x2a\xb7\x99\x0f\x6e\xb7\x99\x3f\xfa\xe1\xc7\xc7\x8f\xb4\xe6\x03\x64\xf5\x13\x6e\xa7\xb7\x3e\x6a\xdb\x80\x8d\x3b\xb0\xf3\xe6\xad\xdc\x0a\x5c\xf5\x7d\x06\xdb\xd6\x2f\x2f\x72\xf3\x71\xdb\xc6\xbe\xaa\x01\x7b\xad\x02\xe0\xd3\xdb\xd8\x97\xef\xbe\x2a\x86\x33\xb1\xc5\x9c\x0c\x5f\xe3\x2b\x1f\x8f\x03\x86\xd7\x2f\xba\xfd\xdc\xa8\x81\xda\x5c\x7a\xb9\x18\xff\xe4\xfb\xce\xe8\x61\xd3\x62\x30\x71\xca\x63\x56\xbc\xa0\x30\x90\x2f\xf0\x5d\x50\x5f\x49\xbe\xc6\x6b\xa8\x6f\xb9\xa8\x1d\xf5\x3c\xf6\xc6\xbf\xd0\x40\xbe\x4c\x79\x96\x34\x8e\x3c\xba\x64\x39\xa7\xa4\x20\xfa\xc6\x0c\xc3\x38\xc6\x81\x7a\x78\xb1\x67\xd9\x7c\x0c\x95\x70\xec\x41\xbc\x77\x0e\x9b\x8c\x61\xb6\xca\x59\xdb\xe1\x59\x86\xf7\xf4\x2d\x7c\xf5\x04\x9c\x3c\xc1\x64\x2f\x57\x75\x37\xf7\x2f\x08\x1f\xa1\xd1\x8a\xb9\xac\x93\x2f\x38\x44\x9d\xea\xe9\x45\xd8\xda\xa4\x59\xd5\x0b\x5f\x78\xe6\x39\x1c\x7a\xf9\x56\xc5\x06\xfc\xd5\x7a\xad\x22\xd7\x08\x37\x21\x37\x11\xcf\xb4\xdb\x94\x90\xfd\xb0\x86\x1a\x4e\xd2\x36\xd8\x6f\xa0\x61\xcb\xe2\x00\x5b\xc9\xf1\x05\xbf\x08\x64\xc8\x90\x41\x17\xb8\x21\xe9\x55\x84\xb6\x97\x51\xc3\x3a\xf6\x5a\x63\x6b\xd0\x26\x77\x85\xbc\x4f\x60\x4d\xb6\x9d\x2a\x6a\xb4\x37\x28\x42\xd3\xd6\xd5\xf0\x01\x0f\x4e\x9b\x1a\x34\xb1\x21\xf8\x56\xd4\xd7\x7c\xd9\x8f\xaa\x08\xbe\x15\xb5\xd3\x64\x05\xea\xcd\x06\xf3\x53\xba\x1c\x9f\xc2\x9f\x37\x54\x5c\xd5\x54\x8b\xbf\x71\x10\x0e\xa1\x9b\x83\x4e\x6d\xfc\x1e\x3b\xb5\xaf\xd7\x05\xb7\xc6\x41\x2c\x61\x36\x99\x75\x06\x4b\xe8\x39\x17\x50\x42\x8d\x9f\xf3\x28\x09\x42\x76\xe0\xf6\xc4\xcb\x82\xf6\x4d\x26\x64\x49\xbf\x45\xc0\x4c\x99\xb9\xa0\xae\xf3\x82\x24\xad\xde\x6c\x27\x54\xe2\xeb\x8f\x8d\x96\x11\x96\xae\xd7\x54\x78\x6e\xae\x53\xe1\x56\x13\x22\x5d\x5f\xb4\x6d\x88\x60\x03\x48\x33\xfa\xc1\x50\xdb\x17\x01\x5c\xcc\xad\x35\xce\xe6\x75\x00\x62\x0c\xd5\x7d\xc6\xa6\xac\xf5\x9b\xb3\x3a\xf3\xf7\xef\xce\x5f\xfd\x7a\xe7\x12\x28\x2e\xee\x76\xaf\xfa\xeb\x99\x73\xdb\xeb\x80\x66\x94\x14\xd0\xcb\xa7\x3c\x1a\x42\xa5\x35\x24\x87\x4d\xab\xf6\x52\x63\x4e\x2d\x5e\xee\x3f\x07\x19\xb6\x29\x44\xda\x22\x92\xfd\x6f\x96\xf2\xa6\x56\x71\x0c\xd4\xfa\x33\x5e\x30\xa9\x5e\x56\x97\xd7\x5d\x6f\x5b\x69\xba\x20\x87\x43\x92\xa4\x7c\x46\x67\xd0\xfa\x44\x0c\xeb\xe2\xd1\x43\x40\xd5\xef\x05\x35\xde\x76\x21\xf2\x7f\x2a\xd0\xcd\x39\xf1\x6f\xd5\xcf\xdf\x29\x4b\x45\x18\x2c\xce\x62\xdf\xa9\xc5\x6d\x37\xe6\x23\x9e\x10\x3d\xa5\x4e\x41\x15\x54\x7f\xcd\x01\x99\x65\xea\x22\xb3\xef\x54\xad\x6f\x7e\xbb\xbe\x7a\x05\xf9\xc1\xf4\xce\x6b\x5a\xf3\xed\x07\xbe\x55\x1d\x8a\x37\xb1\x2b\x72\x40\x8e\x1a\x27\x0c\xc6\xa6\x30\xa8\xce\x91\x86\x7e\xd7\x7f\x27\xac\xaf\x74\xc9\x03\x82\xfa\xda\x28\xde\xd7\x49\xad\xad\xaf\x5c\x3d\x78\x50\x4f\x91\x05\xda\x68\x27\xb4\xd1\xa8\x59\xa9\x96\xc2\xd9\xf9\x0e\x78\xe4\xc1\xd4\xdc\x54\xb7\x49\xb8\x1b\xe4\xa8\x80\x6c\xc1\x3c\xd8\x11\xf3\xa0\x5b\xcc\xc9\x8e\x90\x93\x6e\xc8\xef\x77\x84\xfc\xbe\x1b\x72\x7f\x47\xc8\xfd\x6e\xc8\xbf\x76\x84\xfc\xab\x1b\xf2\xf7\x1d\x21\x7f\xef\x86\x3c\x3e\xde\x11\xf3\xf8\xb8\x1b\xf4\xe4\x64\x47\xd0\x93\x93\x1e\x13\xed\xba\xfb\xfd\x72\xfb\xd7\x7b\xff\x01\xc2\x38\x1d\x24\xbe\x2a\x00\x00")
 
 func bindataRulesRulesGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -97,10 +97,10 @@ func bindataRulesRulesGo() (*asset, error) {
 
 	info := bindataFileInfo{
 		name: "rules/rules.go",
-		size: 11022,
+		size: 10942,
 		md5checksum: "",
 		mode: os.FileMode(436),
-		modTime: time.Unix(1622980644, 0),
+		modTime: time.Unix(1792006315, 0),
 	}
 
 	a := &asset{bytes: bytes, info: info}
@@ -0,0 +1,92 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "selectDefaultBusyLoop"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects select statements with an empty default case that spin inside an endless loop"
+	info.Before = `
+for {
+	select {
+	case msg := <-ch:
+		handle(msg)
+	default:
+	}
+}`
+	info.After = `
+for {
+	msg := <-ch
+	handle(msg)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&selectDefaultBusyLoopChecker{ctx: ctx}), nil
+	})
+}
+
+type selectDefaultBusyLoopChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// yieldingFuncs lists the calls that wait or yield the processor.
+var yieldingFuncs = map[string]bool{
+	"time.Sleep":             true,
+	"runtime.Gosched":        true,
+	"(*sync.Cond).Wait":      true,
+	"(*sync.WaitGroup).Wait": true,
+}
+
+func (c *selectDefaultBusyLoopChecker) VisitStmt(stmt ast.Stmt) {
+	loop, ok := stmt.(*ast.ForStmt)
+	if !ok || loop.Init != nil || loop.Cond != nil || loop.Post != nil || len(loop.Body.List) != 1 {
+		return
+	}
+	sel, ok := loop.Body.List[0].(*ast.SelectStmt)
+	if !ok {
+		return
+	}
+	var dflt *ast.CommClause
+	for _, clause := range sel.Body.List {
+		clause := clause.(*ast.CommClause)
+		if clause.Comm == nil {
+			dflt = clause
+		}
+	}
+	if dflt == nil || !c.isEmptyBody(dflt.Body) || c.yields(loop.Body) {
+		return
+	}
+	c.ctx.Warn(dflt, "select with an empty default case spins the endless loop; remove the default case or add a blocking wait")
+}
+
+func (c *selectDefaultBusyLoopChecker) isEmptyBody(body []ast.Stmt) bool {
+	switch len(body) {
+	case 0:
+		return true
+	case 1:
+		branch, ok := body[0].(*ast.BranchStmt)
+		return ok && branch.Tok == token.CONTINUE && branch.Label == nil
+	default:
+		return false
+	}
+}
+
+// yields reports whether body contains a call that waits or yields the processor.
+func (c *selectDefaultBusyLoopChecker) yields(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && yieldingFuncs[calledFuncName(c.ctx.TypesInfo, call)] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
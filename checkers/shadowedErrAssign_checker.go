@@ -0,0 +1,147 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "shadowedErrAssign"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"checkAllErrors": {
+			Value: false,
+			Usage: "whether to check all error-typed variables, not only the ones named err",
+		},
+	}
+	info.Summary = "Detects err := that shadows an outer error which is checked after the block"
+	info.Before = `
+var err error
+if data, err := load(); err == nil {
+	process(data)
+}
+return err`
+	info.After = `
+var err error
+var data []byte
+if data, err = load(); err == nil {
+	process(data)
+}
+return err`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&shadowedErrAssignChecker{
+			ctx:            ctx,
+			checkAllErrors: info.Params.Bool("checkAllErrors"),
+		}), nil
+	})
+}
+
+type shadowedErrAssignChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkAllErrors bool
+}
+
+func (c *shadowedErrAssignChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	type shadowDef struct {
+		assign *ast.AssignStmt
+		id     *ast.Ident
+	}
+	var defs []shadowDef
+	var funcLits []*ast.FuncLit
+	// uses maps the variables to their uses in the source order.
+	uses := make(map[types.Object][]*ast.Ident)
+	// assigned is a set of idents that are assigned to.
+	assigned := make(map[*ast.Ident]bool)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			funcLits = append(funcLits, n)
+		case *ast.AssignStmt:
+			if n.Tok != token.ASSIGN && n.Tok != token.DEFINE {
+				break
+			}
+			for _, lhs := range n.Lhs {
+				id, ok := lhs.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				assigned[id] = true
+				if n.Tok == token.DEFINE && c.ctx.TypesInfo.Defs[id] != nil {
+					defs = append(defs, shadowDef{assign: n, id: id})
+				}
+			}
+		case *ast.Ident:
+			if obj := c.ctx.TypesInfo.Uses[n]; obj != nil {
+				uses[obj] = append(uses[obj], n)
+			}
+		}
+		return true
+	})
+
+	for _, def := range defs {
+		outer := c.shadowedVar(def.id)
+		if outer == nil || outer.Pos() < decl.Pos() {
+			continue
+		}
+		// Closures usually declare their own errors on purpose.
+		inClosure := false
+		for _, lit := range funcLits {
+			if lit.Pos() <= def.id.Pos() && def.id.End() <= lit.End() &&
+				(outer.Pos() < lit.Pos() || outer.Pos() >= lit.End()) {
+				inClosure = true
+				break
+			}
+		}
+		if inClosure {
+			continue
+		}
+
+		// Find the first outer variable use after the shadowing scope ends,
+		// it should be a read for the shadowing to be suspicious.
+		scopeEnd := c.ctx.TypesInfo.Defs[def.id].Parent().End()
+		for _, use := range uses[outer] {
+			if use.Pos() < scopeEnd {
+				continue
+			}
+			if !assigned[use] {
+				pos := c.ctx.FileSet.Position(outer.Pos())
+				declPos := fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+				c.ctx.Warn(def.assign, "%s shadows %s declared at %s, which is read after this scope; assign it with = instead",
+					def.id, outer.Name(), declPos)
+			}
+			break
+		}
+	}
+}
+
+// shadowedVar returns the error variable from an enclosing
+// scope that is shadowed by the variable defined at id.
+func (c *shadowedErrAssignChecker) shadowedVar(id *ast.Ident) types.Object {
+	if !c.checkAllErrors && id.Name != "err" {
+		return nil
+	}
+	obj := c.ctx.TypesInfo.Defs[id]
+	if obj == nil || !isErrorType(obj.Type()) || obj.Parent() == nil || obj.Parent().Parent() == nil {
+		return nil
+	}
+	_, outer := obj.Parent().Parent().LookupParent(id.Name, id.Pos())
+	if outer, ok := outer.(*types.Var); ok && isErrorType(outer.Type()) {
+		return outer
+	}
+	return nil
+}
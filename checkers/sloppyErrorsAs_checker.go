@@ -0,0 +1,100 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "sloppyErrorsAs"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects errors.As and errors.Is calls that can't work as intended"
+	info.Before = `
+if errors.Is(err, &os.PathError{}) {
+	// ...
+}`
+	info.After = `
+var pathErr *os.PathError
+if errors.As(err, &pathErr) {
+	// ...
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&sloppyErrorsAsChecker{ctx: ctx}), nil
+	})
+}
+
+type sloppyErrorsAsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *sloppyErrorsAsChecker) VisitExpr(expr ast.Expr) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return
+	}
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "errors.As":
+		c.checkAs(call.Args[1])
+	case "errors.Is":
+		c.checkIs(call.Args[1])
+	}
+}
+
+func (c *sloppyErrorsAsChecker) checkAs(target ast.Expr) {
+	ptr, ok := c.ctx.TypeOf(target).(*types.Pointer)
+	if !ok {
+		return
+	}
+	if isErrorType(ptr.Elem()) {
+		c.ctx.Warn(target, "errors.As target %s is *error, so it matches any error; use a concrete error type", target)
+		return
+	}
+
+	typ := ptr.Elem()
+	if elem, ok := typ.(*types.Pointer); ok {
+		typ = elem.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return
+	}
+	obj := named.Obj()
+	if obj.Pkg() != nil && obj.Pkg() != c.ctx.Pkg && !obj.Exported() {
+		qualifier := func(pkg *types.Package) string { return pkg.Name() }
+		c.ctx.Warn(target, "errors.As target type %s is unexported in %s and can't be relied on; use an exported type or an interface",
+			types.TypeString(ptr.Elem(), qualifier), obj.Pkg().Name())
+	}
+}
+
+func (c *sloppyErrorsAsChecker) checkIs(target ast.Expr) {
+	switch x := astutil.Unparen(target).(type) {
+	case *ast.UnaryExpr:
+		// Pointer to a new composite literal is never equal to other pointers.
+		if _, ok := astutil.Unparen(x.X).(*ast.CompositeLit); x.Op != token.AND || !ok {
+			return
+		}
+	case *ast.CallExpr:
+		// Typed nil conversion, like (*MyError)(nil).
+		if len(x.Args) != 1 || !c.ctx.TypesInfo.Types[x.Fun].IsType() || !isNil(c.ctx.TypesInfo, x.Args[0]) {
+			return
+		}
+	default:
+		return
+	}
+
+	// Types with Is method can define their own matching.
+	typ := c.ctx.TypeOf(target)
+	if is, _, _ := types.LookupFieldOrMethod(typ, true, nil, "Is"); is != nil {
+		return
+	}
+	c.ctx.Warn(target, "errors.Is target %s is not an error value that is returned anywhere; use errors.As to check the error type",
+		target)
+}
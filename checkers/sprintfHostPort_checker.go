@@ -0,0 +1,313 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/checkers/internal/lintutil"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "sprintfHostPort"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"hostPattern": {
+			Value: `(?i)(?:host|hostname|addr|ip)$`,
+			Usage: "regexp that matches the names of variables and fields that hold hosts",
+		},
+		"portPattern": {
+			Value: `(?i)port$`,
+			Usage: "regexp that matches the names of variables and fields that hold ports",
+		},
+		"strict": {
+			Value: false,
+			Usage: "whether to report constant hosts that are not IPv6 literals, like \"localhost\"",
+		},
+	}
+	info.Summary = "Detects host:port addresses built without net.JoinHostPort"
+	info.Before = `conn, err := net.Dial("tcp", fmt.Sprintf("%s:%d", host, port))`
+	info.After = `conn, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)))`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		hostRE, err := regexp.Compile(info.Params.String("hostPattern"))
+		if err != nil {
+			return nil, err
+		}
+		portRE, err := regexp.Compile(info.Params.String("portPattern"))
+		if err != nil {
+			return nil, err
+		}
+		return astwalk.WalkerForFuncDecl(&sprintfHostPortChecker{
+			ctx:    ctx,
+			hostRE: hostRE,
+			portRE: portRE,
+			strict: info.Params.Bool("strict"),
+		}), nil
+	})
+}
+
+// hostPortSinks maps the functions that accept the host:port
+// addresses to their address argument index.
+var hostPortSinks = map[string]int{
+	"net.Dial":                         1,
+	"net.DialTimeout":                  1,
+	"net.Listen":                       1,
+	"net.ListenPacket":                 1,
+	"net.ResolveTCPAddr":               1,
+	"net.ResolveUDPAddr":               1,
+	"(*net.Dialer).Dial":               1,
+	"(*net.Dialer).DialContext":        2,
+	"crypto/tls.Dial":                  1,
+	"crypto/tls.DialWithDialer":        2,
+	"crypto/tls.Listen":                1,
+	"net/http.ListenAndServe":          0,
+	"net/http.ListenAndServeTLS":       0,
+	"(*net.ListenConfig).Listen":       2,
+	"(*net.ListenConfig).ListenPacket": 2,
+	"(*crypto/tls.Dialer).DialContext": 2,
+}
+
+type sprintfHostPortChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	hostRE *regexp.Regexp
+	portRE *regexp.Regexp
+	strict bool
+
+	// values maps the local variables to their initialization expressions.
+	values map[types.Object]ast.Expr
+}
+
+func (c *sprintfHostPortChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	c.values = make(map[types.Object]ast.Expr)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == len(n.Rhs) {
+				for i, lhs := range n.Lhs {
+					if obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(lhs)); obj != nil {
+						c.values[obj] = n.Rhs[i]
+					}
+				}
+			}
+		case *ast.CallExpr:
+			if i, ok := hostPortSinks[calledFuncName(c.ctx.TypesInfo, n)]; ok && i < len(n.Args) {
+				c.checkAddr(n.Args[i])
+			}
+		case *ast.CompositeLit:
+			if typ, ok := c.ctx.TypeOf(n).(*types.Named); ok && typ.String() == "net/http.Server" {
+				for _, elt := range n.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if ok && astcast.ToIdent(kv.Key).Name == "Addr" {
+						c.checkAddr(kv.Value)
+					}
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *sprintfHostPortChecker) checkAddr(addr ast.Expr) {
+	if id, ok := addr.(*ast.Ident); ok {
+		if v := c.values[c.ctx.TypesInfo.ObjectOf(id)]; v != nil {
+			addr = v
+		}
+	}
+	switch addr := addr.(type) {
+	case *ast.BinaryExpr:
+		c.checkConcat(addr)
+	case *ast.CallExpr:
+		if calledFuncName(c.ctx.TypesInfo, addr) == "fmt.Sprintf" {
+			c.checkSprintf(addr)
+		}
+	}
+}
+
+func (c *sprintfHostPortChecker) checkConcat(concat *ast.BinaryExpr) {
+	if concat.Op != token.ADD {
+		return
+	}
+	var operands []ast.Expr
+	var flatten func(x ast.Expr)
+	flatten = func(x ast.Expr) {
+		if bin, ok := x.(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+			flatten(bin.X)
+			flatten(bin.Y)
+			return
+		}
+		operands = append(operands, x)
+	}
+	flatten(concat)
+
+	for i := 1; i < len(operands); i++ {
+		port := operands[i]
+		if !c.portRE.MatchString(c.name(port)) {
+			continue
+		}
+		s, ok := c.constString(operands[i-1])
+		if !ok || !strings.HasSuffix(s, ":") {
+			continue
+		}
+		if s != ":" {
+			c.checkConstHost(concat, c.hostOf(strings.TrimSuffix(s, ":")), port)
+			return
+		}
+		if i < 2 {
+			return
+		}
+		host := operands[i-2]
+		if s, ok := c.constString(host); ok {
+			c.checkConstHost(concat, c.hostOf(s), port)
+			return
+		}
+		if c.hostRE.MatchString(c.name(host)) {
+			c.warn(concat, "concatenation", astfmt.Sprint(host), c.portString(port))
+		}
+		return
+	}
+}
+
+func (c *sprintfHostPortChecker) checkSprintf(call *ast.CallExpr) {
+	if len(call.Args) < 2 {
+		return
+	}
+	format, ok := c.constString(call.Args[0])
+	if !ok {
+		return
+	}
+	args := call.Args[1:]
+
+	parts := splitFormat(format)
+	for i := 1; i < len(parts); i++ {
+		part := parts[i]
+		if part.arg == -1 || part.arg >= len(args) {
+			continue
+		}
+		port := args[part.arg]
+		prev := parts[i-1]
+		if prev.arg != -1 || !strings.HasSuffix(prev.text, ":") || !c.portRE.MatchString(c.name(port)) {
+			continue
+		}
+		if prev.text != ":" {
+			c.checkConstHost(call, c.hostOf(strings.TrimSuffix(prev.text, ":")), port)
+			return
+		}
+		if i < 2 || parts[i-2].arg == -1 || parts[i-2].arg >= len(args) {
+			return
+		}
+		host := args[parts[i-2].arg]
+		if s, ok := c.constString(host); ok {
+			c.checkConstHost(call, c.hostOf(s), port)
+			return
+		}
+		if c.hostRE.MatchString(c.name(host)) {
+			c.warn(call, "fmt.Sprintf", astfmt.Sprint(host), c.portString(port))
+		}
+		return
+	}
+}
+
+// formatPart is either a literal text or a verb of the format string.
+type formatPart struct {
+	text string
+	// arg is the verb argument index or -1 for the literal text.
+	arg int
+}
+
+// splitFormat splits the printf-style format into the literal texts and verbs.
+func splitFormat(format string) []formatPart {
+	var parts []formatPart
+	addText := func(text string) {
+		if text != "" {
+			parts = append(parts, formatPart{text: strings.ReplaceAll(text, "%%", "%"), arg: -1})
+		}
+	}
+	verbs, _ := lintutil.ParseFormat(format)
+	pos := 0
+	for _, v := range verbs {
+		addText(format[pos:v.Pos])
+		parts = append(parts, formatPart{text: v.Text, arg: v.Arg})
+		pos = v.End
+	}
+	addText(format[pos:])
+	return parts
+}
+
+// hostOf returns the host part of the literal address prefix, like "localhost" for "http://localhost".
+func (c *sprintfHostPortChecker) hostOf(prefix string) string {
+	if i := strings.LastIndexAny(prefix, "/@"); i != -1 {
+		return prefix[i+1:]
+	}
+	return prefix
+}
+
+// checkConstHost reports the address with a constant host if it's an
+// IPv6 literal, other constant hosts are only reported in the strict mode.
+func (c *sprintfHostPortChecker) checkConstHost(cause ast.Node, host string, port ast.Expr) {
+	if host == "" || strings.HasPrefix(host, "[") {
+		return
+	}
+	if !strings.Contains(host, ":") && !c.strict {
+		return
+	}
+	how := "concatenation"
+	if _, ok := cause.(*ast.CallExpr); ok {
+		how = "fmt.Sprintf"
+	}
+	c.warn(cause, how, strconv.Quote(host), c.portString(port))
+}
+
+func (c *sprintfHostPortChecker) warn(cause ast.Node, how, host, port string) {
+	c.ctx.Warn(cause, "host:port address built with %s breaks for IPv6 hosts; use net.JoinHostPort(%s, %s)",
+		how, host, port)
+}
+
+// portString returns the port expression as a string argument.
+func (c *sprintfHostPortChecker) portString(port ast.Expr) string {
+	if typ, ok := c.ctx.TypeOf(port).Underlying().(*types.Basic); ok && typ.Info()&types.IsInteger != 0 {
+		return "strconv.Itoa(" + astfmt.Sprint(port) + ")"
+	}
+	return astfmt.Sprint(port)
+}
+
+// name returns the variable or field name that holds the value of x.
+// Conversion and formatting calls like strconv.Itoa(port) are unwrapped.
+func (c *sprintfHostPortChecker) name(x ast.Expr) string {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return x.Name
+	case *ast.SelectorExpr:
+		return x.Sel.Name
+	case *ast.ParenExpr:
+		return c.name(x.X)
+	case *ast.CallExpr:
+		if len(x.Args) == 1 {
+			return c.name(x.Args[0])
+		}
+	}
+	return ""
+}
+
+func (c *sprintfHostPortChecker) constString(x ast.Expr) (string, bool) {
+	tv, ok := c.ctx.TypesInfo.Types[x]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
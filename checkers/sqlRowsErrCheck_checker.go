@@ -0,0 +1,163 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "sqlRowsErrCheck"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"rowsTypes": {
+			Value: "database/sql.Rows,github.com/jackc/pgx/v4.Rows",
+			Usage: "comma-separated list of qualified rows type names",
+		},
+	}
+	info.Summary = "Detects rows iteration that is not followed by rows.Err() check"
+	info.Before = `
+rows, err := db.Query(q)
+if err != nil {
+	return err
+}
+for rows.Next() {
+	// ...
+}
+return nil`
+	info.After = `
+rows, err := db.Query(q)
+if err != nil {
+	return err
+}
+defer rows.Close()
+for rows.Next() {
+	// ...
+}
+return rows.Err()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		rowsTypes := make(map[string]bool)
+		for _, name := range strings.Split(info.Params.String("rowsTypes"), ",") {
+			rowsTypes[strings.TrimSpace(name)] = true
+		}
+		return astwalk.WalkerForFuncDecl(&sqlRowsErrCheckChecker{
+			ctx:       ctx,
+			rowsTypes: rowsTypes,
+		}), nil
+	})
+}
+
+type sqlRowsErrCheckChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	rowsTypes map[string]bool
+}
+
+func (c *sqlRowsErrCheckChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	// loops maps rows variables to the first `for rows.Next()` loop.
+	loops := make(map[types.Object]*ast.ForStmt)
+	var objects []types.Object
+	errChecked := make(map[types.Object]bool)
+	closeDeferred := make(map[types.Object]bool)
+	escaped := make(map[types.Object]bool)
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt:
+			if obj := c.rowsMethodCall(n.Cond, "Next"); obj != nil && loops[obj] == nil {
+				loops[obj] = n
+				objects = append(objects, obj)
+			}
+		case *ast.DeferStmt:
+			if obj := c.rowsMethodCall(n.Call, "Close"); obj != nil {
+				closeDeferred[obj] = true
+			}
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				ast.Inspect(lit.Body, func(n ast.Node) bool {
+					if obj := c.rowsMethodCall(n, "Close"); obj != nil {
+						closeDeferred[obj] = true
+					}
+					return true
+				})
+			}
+		case *ast.CallExpr:
+			if obj := c.rowsMethodCall(n, "Err"); obj != nil {
+				errChecked[obj] = true
+			}
+			// Rows can be checked inside the function they're passed to.
+			for _, arg := range n.Args {
+				c.markEscaped(escaped, arg)
+			}
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				c.markEscaped(escaped, result)
+			}
+		}
+		return true
+	})
+
+	for _, obj := range objects {
+		if escaped[obj] {
+			continue
+		}
+		loop := loops[obj]
+		if !errChecked[obj] {
+			c.ctx.Warn(loop, "%s.Err() is not checked after the %s.Next() loop", obj.Name(), obj.Name())
+		}
+		// Rows that are passed as a parameter are closed by the caller.
+		local := obj.Pos() > decl.Body.Pos() && obj.Pos() < decl.Body.End()
+		if local && !closeDeferred[obj] {
+			c.ctx.Warn(loop, "%s.Close() is not deferred, %s can leak if iteration stops early", obj.Name(), obj.Name())
+		}
+	}
+}
+
+func (c *sqlRowsErrCheckChecker) markEscaped(escaped map[types.Object]bool, x ast.Expr) {
+	if id, ok := x.(*ast.Ident); ok {
+		if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+			escaped[obj] = true
+		}
+	}
+}
+
+// rowsMethodCall returns the rows variable for the rows.{method}() calls.
+func (c *sqlRowsErrCheckChecker) rowsMethodCall(n ast.Node, method string) types.Object {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method {
+		return nil
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil || !c.isRowsType(obj.Type()) {
+		return nil
+	}
+	return obj
+}
+
+func (c *sqlRowsErrCheckChecker) isRowsType(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return c.rowsTypes[named.Obj().Pkg().Path()+"."+named.Obj().Name()]
+}
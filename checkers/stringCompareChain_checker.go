@@ -0,0 +1,187 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "stringCompareChain"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects chains of equality checks of the same expression that could be a switch"
+	info.Before = `
+if ext == ".jpg" || ext == ".png" || ext == ".gif" {
+	serveImage(w, path)
+}`
+	info.After = `
+switch ext {
+case ".jpg", ".png", ".gif":
+	serveImage(w, path)
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&stringCompareChainChecker{ctx: ctx}), nil
+	})
+}
+
+type stringCompareChainChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	file *ast.File
+}
+
+// minCompareChain is the minimal number of the reported comparisons.
+const minCompareChain = 3
+
+func (c *stringCompareChainChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *stringCompareChainChecker) VisitStmt(stmt ast.Stmt) {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok {
+		return
+	}
+	cond, ok := astutil.Unparen(ifStmt.Cond).(*ast.BinaryExpr)
+	if !ok {
+		return
+	}
+	var cmpOp token.Token
+	switch cond.Op {
+	case token.LOR:
+		cmpOp = token.EQL
+	case token.LAND:
+		cmpOp = token.NEQ
+	default:
+		return
+	}
+	subject, consts := c.matchChain(cond, cmpOp)
+	if subject == nil {
+		return
+	}
+
+	constList := make([]string, len(consts))
+	for i, x := range consts {
+		constList[i] = astfmt.Sprint(x)
+	}
+	var format string
+	if cmpOp == token.EQL {
+		format = "%s is compared against %s; use a switch with the multi-value case"
+		if c.hasSlicesContains() {
+			format += " or slices.Contains"
+		}
+	} else {
+		format = "%s is compared against %s; use a switch with the multi-value case and default"
+		if c.hasSlicesContains() {
+			format += " or !slices.Contains"
+		}
+	}
+
+	if hasCommentsIn(c.file, ifStmt) || hasUnlabeledBreak(ifStmt.Body) || hasUnlabeledBreak(ifStmt.Else) {
+		c.ctx.Warn(cond, format, subject, strings.Join(constList, ", "))
+		return
+	}
+	c.ctx.WarnFixable(cond, linter.QuickFix{
+		From:        ifStmt.Pos(),
+		To:          ifStmt.End(),
+		Replacement: []byte(c.suggestSwitch(ifStmt, subject, consts, cmpOp == token.NEQ)),
+	}, format, subject, strings.Join(constList, ", "))
+}
+
+func (c *stringCompareChainChecker) hasSlicesContains() bool {
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21})
+}
+
+// matchChain returns the compared expression and the constants it's compared against
+// or nil if cond is not a chain of the cmpOp comparisons of the same expression.
+func (c *stringCompareChainChecker) matchChain(cond *ast.BinaryExpr, cmpOp token.Token) (subject ast.Expr, consts []ast.Expr) {
+	var terms []ast.Expr
+	var collect func(x ast.Expr) bool
+	collect = func(x ast.Expr) bool {
+		x = astutil.Unparen(x)
+		if bin, ok := x.(*ast.BinaryExpr); ok && bin.Op == cond.Op {
+			return collect(bin.X) && collect(bin.Y)
+		}
+		terms = append(terms, x)
+		return true
+	}
+	collect(cond)
+	if len(terms) < minCompareChain {
+		return nil, nil
+	}
+
+	consts = make([]ast.Expr, 0, len(terms))
+	seen := make(map[string]bool)
+	for _, term := range terms {
+		cmp, ok := term.(*ast.BinaryExpr)
+		if !ok || cmp.Op != cmpOp {
+			return nil, nil
+		}
+		x, y := cmp.X, cmp.Y
+		if c.isConst(x) {
+			x, y = y, x
+		}
+		if c.isConst(x) || !c.isConst(y) {
+			return nil, nil
+		}
+		switch {
+		case subject == nil:
+			if !typep.SideEffectFree(c.ctx.TypesInfo, x) {
+				return nil, nil
+			}
+			subject = x
+		case !astequal.Expr(subject, x):
+			return nil, nil
+		}
+		key := c.ctx.TypesInfo.Types[y].Value.ExactString()
+		if seen[key] {
+			// Duplicated switch cases don't compile.
+			return nil, nil
+		}
+		seen[key] = true
+		consts = append(consts, y)
+	}
+	return subject, consts
+}
+
+func (c *stringCompareChainChecker) isConst(x ast.Expr) bool {
+	return c.ctx.TypesInfo.Types[x].Value != nil
+}
+
+// suggestSwitch returns the switch statement that replaces ifStmt.
+func (c *stringCompareChainChecker) suggestSwitch(ifStmt *ast.IfStmt, subject ast.Expr, consts []ast.Expr, negated bool) string {
+	thenBody := ifStmt.Body.List
+	var elseBody []ast.Stmt
+	switch e := ifStmt.Else.(type) {
+	case *ast.BlockStmt:
+		elseBody = e.List
+	case *ast.IfStmt:
+		elseBody = []ast.Stmt{e}
+	}
+	if negated {
+		thenBody, elseBody = elseBody, thenBody
+	}
+	clauses := []ast.Stmt{&ast.CaseClause{List: consts, Body: thenBody}}
+	if len(elseBody) != 0 {
+		clauses = append(clauses, &ast.CaseClause{Body: elseBody})
+	}
+	sw := &ast.SwitchStmt{
+		Init: ifStmt.Init,
+		Tag:  subject,
+		Body: &ast.BlockStmt{List: clauses},
+	}
+
+	return reindent(c.ctx.FileSet, ifStmt.Pos(), astfmt.Sprint(sw))
+}
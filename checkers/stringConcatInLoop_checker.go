@@ -0,0 +1,194 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/typep"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "stringConcatInLoop"
+	info.Tags = []string{"performance", "experimental"}
+	info.Params = linter.CheckerParams{
+		"smallLoopBound": {
+			Value: 4,
+			Usage: "loops with a constant number of iterations not exceeding this value are ignored",
+		},
+	}
+	info.Summary = "Detects string concatenations inside loops that can be done with strings.Builder"
+	info.Before = `
+var s string
+for _, part := range parts {
+	s += part
+}`
+	info.After = `
+var sb strings.Builder
+for _, part := range parts {
+	sb.WriteString(part)
+}
+s := sb.String()`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&stringConcatInLoopChecker{
+			ctx:            ctx,
+			smallLoopBound: info.Params.Int("smallLoopBound"),
+		}), nil
+	})
+}
+
+type stringConcatInLoopChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	smallLoopBound int
+}
+
+func (c *stringConcatInLoopChecker) VisitStmt(stmt ast.Stmt) {
+	var body *ast.BlockStmt
+	var sizeHint ast.Expr
+	switch loop := stmt.(type) {
+	case *ast.RangeStmt:
+		body = loop.Body
+		switch c.ctx.TypeOf(loop.X).Underlying().(type) {
+		case *types.Slice, *types.Map, *types.Array:
+			sizeHint = loop.X
+		}
+	case *ast.ForStmt:
+		if c.isSmallLoop(loop) {
+			return
+		}
+		body = loop.Body
+		sizeHint = c.lenBound(loop)
+	default:
+		return
+	}
+
+	reported := make(map[types.Object]bool)
+	c.walkConcats(body, func(assign *ast.AssignStmt, obj types.Object) {
+		if reported[obj] || obj.Pos() > stmt.Pos() {
+			return // Reported already or declared inside the loop
+		}
+		reported[obj] = true
+		if !c.hasOtherUses(stmt, obj) {
+			c.warn(assign.Lhs[0], sizeHint)
+		}
+	})
+}
+
+// walkConcats calls visit for every string concatenation assignment
+// that belongs to the current loop (nested loops are skipped).
+func (c *stringConcatInLoopChecker) walkConcats(body *ast.BlockStmt, visit func(*ast.AssignStmt, types.Object)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			if obj := c.concatTarget(n); obj != nil {
+				visit(n, obj)
+			}
+		}
+		return true
+	})
+}
+
+// concatTarget returns the string variable that is being extended
+// by the `s += x` or `s = s + x` assignment.
+func (c *stringConcatInLoopChecker) concatTarget(assign *ast.AssignStmt) types.Object {
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	lhs, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || !typep.HasStringProp(c.ctx.TypeOf(lhs).Underlying()) {
+		return nil
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(lhs)
+	switch assign.Tok {
+	case token.ADD_ASSIGN:
+		return obj
+	case token.ASSIGN:
+		sum := astcast.ToBinaryExpr(assign.Rhs[0])
+		if sum.Op != token.ADD {
+			return nil
+		}
+		// For s = s + a + b the leftmost operand is nested.
+		x := sum.X
+		for {
+			bin, ok := x.(*ast.BinaryExpr)
+			if !ok || bin.Op != token.ADD {
+				break
+			}
+			x = bin.X
+		}
+		if id, ok := x.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == obj {
+			return obj
+		}
+	}
+	return nil
+}
+
+// hasOtherUses reports whether the string is used inside the loop in
+// a way that would require a builder.String() call each iteration.
+func (c *stringConcatInLoopChecker) hasOtherUses(loop ast.Stmt, obj types.Object) bool {
+	allowed := make(map[*ast.Ident]bool)
+	found := false
+	ast.Inspect(loop, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if c.concatTarget(n) == obj {
+				ast.Inspect(n, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.ObjectOf(id) == obj {
+						allowed[id] = true
+					}
+					return true
+				})
+			}
+		case *ast.CallExpr:
+			// len(s) has a cheap sb.Len() equivalent.
+			if isBuiltinCall(c.ctx.TypesInfo, n, "len") && len(n.Args) == 1 {
+				if id, ok := n.Args[0].(*ast.Ident); ok {
+					allowed[id] = true
+				}
+			}
+		case *ast.Ident:
+			if c.ctx.TypesInfo.ObjectOf(n) == obj && !allowed[n] {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// isSmallLoop reports whether loop has a few iterations known at compile time.
+func (c *stringConcatInLoopChecker) isSmallLoop(loop *ast.ForStmt) bool {
+	n, ok := constTripCount(c.ctx.TypesInfo, loop)
+	return ok && n <= int64(c.smallLoopBound)
+}
+
+// lenBound returns x for the `i < len(x)` loop conditions.
+func (c *stringConcatInLoopChecker) lenBound(loop *ast.ForStmt) ast.Expr {
+	cond := astcast.ToBinaryExpr(loop.Cond)
+	call := astcast.ToCallExpr(cond.Y)
+	if cond.Op != token.LSS || !isBuiltinCall(c.ctx.TypesInfo, call, "len") || len(call.Args) != 1 {
+		return nil
+	}
+	return call.Args[0]
+}
+
+func (c *stringConcatInLoopChecker) warn(cause, sizeHint ast.Expr) {
+	if sizeHint != nil {
+		c.ctx.Warn(cause, "%s is concatenated in a loop; use strings.Builder, it can be pre-grown based on len(%s)",
+			cause, sizeHint)
+		return
+	}
+	c.ctx.Warn(cause, "%s is concatenated in a loop; use strings.Builder", cause)
+}
@@ -0,0 +1,187 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "stringsBuilderMisuse"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"aggressive": {
+			Value: false,
+			Usage: "whether to report writes to a builder after its String result is stored",
+		},
+	}
+	info.Summary = "Detects strings.Builder copies and resets by assignment"
+	info.Before = `
+var b strings.Builder
+b.WriteString("header")
+writeBody(b)
+b.WriteString("footer")`
+	info.After = `
+var b strings.Builder
+b.WriteString("header")
+writeBody(&b)
+b.WriteString("footer")`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&stringsBuilderMisuseChecker{
+			ctx:        ctx,
+			aggressive: info.Params.Bool("aggressive"),
+		}), nil
+	})
+}
+
+type stringsBuilderMisuseChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	aggressive bool
+}
+
+// builderString is a stored result of the b.String() call.
+type builderString struct {
+	builder types.Object
+	result  *ast.Ident
+}
+
+func (c *stringsBuilderMisuseChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	// uses maps the builder variables to their uses in the source order.
+	uses := make(map[types.Object][]*ast.Ident)
+	var copies []*ast.CallExpr
+	var strs []builderString
+	var writes []*ast.CallExpr
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if obj := c.ctx.TypesInfo.Uses[n]; obj != nil && c.isBuilder(obj.Type()) {
+				uses[obj] = append(uses[obj], n)
+			}
+		case *ast.CallExpr:
+			if c.builderMethod(n, "Write") != nil {
+				writes = append(writes, n)
+			}
+			if c.passesBuilder(n) {
+				copies = append(copies, n)
+			}
+		case *ast.AssignStmt:
+			c.checkReset(n)
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				result := astcast.ToIdent(n.Lhs[0])
+				builder := c.builderMethod(astcast.ToCallExpr(n.Rhs[0]), "String")
+				if builder != nil && result.Name != "" && result.Name != "_" {
+					strs = append(strs, builderString{builder: builder, result: result})
+				}
+			}
+		}
+		return true
+	})
+
+	for _, call := range copies {
+		c.checkCopy(call, uses)
+	}
+	if c.aggressive {
+		c.checkWritesAfterString(strs, writes)
+	}
+}
+
+// passesBuilder reports whether call has strings.Builder arguments passed by value.
+func (c *stringsBuilderMisuseChecker) passesBuilder(call *ast.CallExpr) bool {
+	for _, arg := range call.Args {
+		if _, ok := arg.(*ast.Ident); ok && c.isBuilder(c.ctx.TypeOf(arg)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *stringsBuilderMisuseChecker) checkCopy(call *ast.CallExpr, uses map[types.Object][]*ast.Ident) {
+	for _, arg := range call.Args {
+		id, ok := arg.(*ast.Ident)
+		if !ok || !c.isBuilder(c.ctx.TypeOf(id)) {
+			continue
+		}
+		for _, use := range uses[c.ctx.TypesInfo.ObjectOf(id)] {
+			if use.Pos() > call.End() {
+				c.ctx.Warn(id, "%s is passed to %s by value and used afterwards; pass a pointer, copied strings.Builder panics on write",
+					id, call.Fun)
+				break
+			}
+		}
+	}
+}
+
+func (c *stringsBuilderMisuseChecker) checkReset(assign *ast.AssignStmt) {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, rhs := range assign.Rhs {
+		lit, ok := rhs.(*ast.CompositeLit)
+		if !ok || len(lit.Elts) != 0 || !c.isBuilder(c.ctx.TypeOf(lit)) {
+			continue
+		}
+		lhs := assign.Lhs[i]
+		if len(assign.Lhs) != 1 {
+			c.ctx.Warn(lhs, "use %s.Reset() instead of assigning an empty strings.Builder", lhs)
+			continue
+		}
+		c.ctx.WarnFixable(assign, linter.QuickFix{
+			From:        assign.Pos(),
+			To:          assign.End(),
+			Replacement: []byte(astfmt.Sprint(lhs) + ".Reset()"),
+		}, "use %s.Reset() instead of assigning an empty strings.Builder", lhs)
+	}
+}
+
+func (c *stringsBuilderMisuseChecker) checkWritesAfterString(strs []builderString, writes []*ast.CallExpr) {
+	for _, str := range strs {
+		for _, write := range writes {
+			if write.Pos() > str.result.End() && c.builderMethod(write, "Write") == str.builder {
+				c.ctx.Warn(write, "%s is written after its String result is stored in %s; build the string after all writes",
+					str.builder.Name(), str.result)
+				break
+			}
+		}
+	}
+}
+
+// builderMethod returns the builder variable if call is b.Method() call
+// for the method with the given name prefix.
+func (c *stringsBuilderMisuseChecker) builderMethod(call *ast.CallExpr, prefix string) types.Object {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !strings.HasPrefix(sel.Sel.Name, prefix) {
+		return nil
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil || !c.isBuilder(obj.Type()) {
+		return nil
+	}
+	return obj
+}
+
+func (c *stringsBuilderMisuseChecker) isBuilder(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "strings" && obj.Name() == "Builder"
+}
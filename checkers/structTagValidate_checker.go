@@ -0,0 +1,233 @@
+package checkers
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "structTagValidate"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"knownKeys": {
+			Value: "json,xml,yaml,toml,bson,db,protobuf,msgpack,mapstructure,validate,form,query,env,gorm,csv,schema,binding",
+			Usage: "comma-separated list of the tag keys that are used to find the misspelled keys",
+		},
+	}
+	info.Summary = "Detects malformed struct tags that go vet doesn't report"
+	info.Before = `
+type user struct {
+	Name  string ` + "`json:\"name, omitempty\"`" + `
+	Email string ` + "`jsno:\"email\" json:\"mail\"`" + `
+}`
+	info.After = `
+type user struct {
+	Name  string ` + "`json:\"name,omitempty\"`" + `
+	Email string ` + "`json:\"email\"`" + `
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		c := &structTagValidateChecker{
+			ctx:       ctx,
+			knownKeys: splitPatterns(info.Params.String("knownKeys")),
+			isKnown:   make(map[string]bool),
+		}
+		for _, key := range c.knownKeys {
+			c.isKnown[key] = true
+		}
+		return astwalk.WalkerForTypeExpr(c, ctx.TypesInfo), nil
+	})
+}
+
+// tagOptionKeys is a set of tag keys that use comma-separated options.
+var tagOptionKeys = map[string]bool{
+	"json":         true,
+	"xml":          true,
+	"yaml":         true,
+	"toml":         true,
+	"bson":         true,
+	"msgpack":      true,
+	"mapstructure": true,
+}
+
+type structTagValidateChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	knownKeys []string
+	isKnown   map[string]bool
+}
+
+// structTagPair is a key:"value" pair of the struct tag.
+type structTagPair struct {
+	key   string
+	value string
+}
+
+func (c *structTagValidateChecker) VisitTypeExpr(x ast.Expr) {
+	typ, ok := x.(*ast.StructType)
+	if !ok {
+		return
+	}
+	for _, field := range typ.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		c.checkTag(field, parseStructTag(tag))
+	}
+}
+
+func (c *structTagValidateChecker) checkTag(field *ast.Field, pairs []structTagPair) {
+	name := c.fieldName(field)
+	seen := make(map[string]bool)
+	for _, pair := range pairs {
+		if seen[pair.key] {
+			c.ctx.Warn(field.Tag, "struct tag key %s is repeated in the tag of field %s, only the first one is used",
+				pair.key, name)
+		}
+		seen[pair.key] = true
+
+		if !c.isKnown[pair.key] {
+			if known := c.similarKey(pair.key); known != "" {
+				c.ctx.Warn(field.Tag, "struct tag key %s of field %s is unknown, did you mean %s?",
+					pair.key, name, known)
+			}
+		}
+
+		if tagOptionKeys[pair.key] {
+			options := strings.Split(pair.value, ",")
+			for _, opt := range options[1:] {
+				if opt != strings.TrimLeft(opt, " ") && strings.TrimSpace(opt) != "" {
+					c.ctx.Warn(field.Tag, "%s tag option %q of field %s starts with a space and is ignored; remove the space after the comma",
+						pair.key, opt, name)
+					break
+				}
+			}
+		}
+
+		if pair.key == "json" && len(field.Names) == 0 && pair.value != "-" && strings.HasPrefix(pair.value, ",") {
+			c.ctx.Warn(field.Tag, "json tag %q of embedded field %s is ignored; encoding/json only uses a tag name to stop promoting the fields",
+				pair.value, name)
+		}
+	}
+}
+
+// similarKey returns a known key that differs from key by a single edit
+// or an empty string.
+func (c *structTagValidateChecker) similarKey(key string) string {
+	for _, known := range c.knownKeys {
+		if len(known) < 3 {
+			// Short keys are similar to too many other keys.
+			continue
+		}
+		if isSingleEdit(key, known) {
+			return known
+		}
+	}
+	return ""
+}
+
+func (c *structTagValidateChecker) fieldName(field *ast.Field) string {
+	if len(field.Names) != 0 {
+		return field.Names[0].Name
+	}
+	// Embedded field is named after its type.
+	typ := field.Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if sel, ok := typ.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	if id, ok := typ.(*ast.Ident); ok {
+		return id.Name
+	}
+	return "_"
+}
+
+// parseStructTag splits tag into key:"value" pairs following the
+// reflect.StructTag.Lookup rules. Parsing stops at the malformed part.
+func parseStructTag(tag string) []structTagPair {
+	var pairs []structTagPair
+	for tag != "" {
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			break
+		}
+
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != '\x7f' {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			break
+		}
+		tag = tag[i+1:]
+		pairs = append(pairs, structTagPair{key: key, value: value})
+	}
+	return pairs
+}
+
+// isSingleEdit reports whether a and b differ by exactly one insertion,
+// deletion, substitution or transposition of adjacent characters.
+func isSingleEdit(a, b string) bool {
+	if a == b {
+		return false
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	switch len(b) - len(a) {
+	case 0:
+		diff := -1
+		for i := 0; i < len(a); i++ {
+			if a[i] == b[i] {
+				continue
+			}
+			if diff != -1 {
+				// Second difference is only allowed for the transposition.
+				return diff == i-1 && a[diff] == b[i] && a[i] == b[diff] && a[i+1:] == b[i+1:]
+			}
+			diff = i
+		}
+		return true
+	case 1:
+		i := 0
+		for i < len(a) && a[i] == b[i] {
+			i++
+		}
+		return a[i:] == b[i+1:]
+	default:
+		return false
+	}
+}
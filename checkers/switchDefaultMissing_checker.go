@@ -0,0 +1,161 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "switchDefaultMissing"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"requireDefaultEvenWhenExhaustive": {
+			Value: false,
+			Usage: "whether to require a default case in switches that cover all constants",
+		},
+		"ignoreTypes": {
+			Value: "go/token.Token,go/types.BasicKind,reflect.Kind,regexp/syntax.Op",
+			Usage: "comma-separated list of the enum types to skip, like go/token.Token",
+		},
+	}
+	info.Summary = "Detects switches over enum-like types that don't handle all constants"
+	info.Before = `
+switch color {
+case Red:
+	paintRed()
+case Green:
+	paintGreen()
+}`
+	info.After = `
+switch color {
+case Red:
+	paintRed()
+case Green:
+	paintGreen()
+default:
+	panic("unexpected color")
+}`
+	info.Note = `
+A named type with package-level constants of that type is considered an enum.
+The large enums like go/token.Token are skipped by default, see ignoreTypes.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		ignoreTypes := make(map[string]bool)
+		for _, name := range splitPatterns(info.Params.String("ignoreTypes")) {
+			ignoreTypes[name] = true
+		}
+		return astwalk.WalkerForStmt(&switchDefaultMissingChecker{
+			ctx:            ctx,
+			requireDefault: info.Params.Bool("requireDefaultEvenWhenExhaustive"),
+			ignoreTypes:    ignoreTypes,
+		}), nil
+	})
+}
+
+type switchDefaultMissingChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	requireDefault bool
+	// ignoreTypes is a set of package path qualified type names.
+	ignoreTypes map[string]bool
+}
+
+func (c *switchDefaultMissingChecker) VisitStmt(stmt ast.Stmt) {
+	sw, ok := stmt.(*ast.SwitchStmt)
+	if !ok || sw.Tag == nil {
+		return
+	}
+	named, ok := c.ctx.TypeOf(sw.Tag).(*types.Named)
+	if !ok {
+		return
+	}
+	consts := c.enumConsts(named)
+	if len(consts) < 2 {
+		return
+	}
+
+	var covered []constant.Value
+	hasDefault := false
+	for _, clause := range sw.Body.List {
+		clause := clause.(*ast.CaseClause)
+		if clause.List == nil {
+			hasDefault = true
+		}
+		for _, x := range clause.List {
+			if tv, ok := c.ctx.TypesInfo.Types[x]; ok && tv.Value != nil {
+				covered = append(covered, tv.Value)
+			}
+		}
+	}
+
+	var missing []string
+	// missingValues prevents listing several constants with the same value.
+	var missingValues []constant.Value
+	for _, k := range consts {
+		if containsConstant(covered, k.Val()) || containsConstant(missingValues, k.Val()) {
+			continue
+		}
+		missing = append(missing, k.Name())
+		missingValues = append(missingValues, k.Val())
+	}
+
+	switch {
+	case hasDefault:
+		return
+	case len(missing) != 0:
+		const maxListed = 5
+		list := strings.Join(missing, ", ")
+		if len(missing) > maxListed {
+			list = fmt.Sprintf("%s and %d more", strings.Join(missing[:maxListed], ", "), len(missing)-maxListed)
+		}
+		c.ctx.Warn(sw, "switch on %s doesn't handle %s and has no default case", sw.Tag, list)
+	case c.requireDefault:
+		c.ctx.Warn(sw, "switch on %s covers all %s constants but has no default case",
+			sw.Tag, named.Obj().Name())
+	}
+}
+
+// enumConsts returns the package-level constants of type typ
+// that can be referenced from the current package.
+func (c *switchDefaultMissingChecker) enumConsts(typ *types.Named) []*types.Const {
+	pkg := typ.Obj().Pkg()
+	if pkg == nil || c.ignoreTypes[pkg.Path()+"."+typ.Obj().Name()] {
+		return nil
+	}
+	scope := pkg.Scope()
+	var consts []*types.Const
+	for _, name := range scope.Names() {
+		k, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(k.Type(), typ) {
+			continue
+		}
+		if pkg != c.ctx.Pkg && !k.Exported() {
+			continue
+		}
+		consts = append(consts, k)
+	}
+	// Report the missing constants in the declaration order.
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+	return consts
+}
+
+func containsConstant(list []constant.Value, x constant.Value) bool {
+	for _, y := range list {
+		if constant.Compare(x, token.EQL, y) {
+			return true
+		}
+	}
+	return false
+}
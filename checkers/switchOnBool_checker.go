@@ -0,0 +1,165 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "switchOnBool"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects boolean switch statements that could be written as if-else"
+	info.Before = `
+switch ok {
+case true:
+	onSuccess()
+case false:
+	onFailure()
+}`
+	info.After = `
+if ok {
+	onSuccess()
+} else {
+	onFailure()
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&switchOnBoolChecker{ctx: ctx}), nil
+	})
+}
+
+type switchOnBoolChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	file *ast.File
+}
+
+func (c *switchOnBoolChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *switchOnBoolChecker) VisitStmt(stmt ast.Stmt) {
+	sw, ok := stmt.(*ast.SwitchStmt)
+	if !ok || len(sw.Body.List) != 2 {
+		return
+	}
+	clauses := [2]*ast.CaseClause{
+		sw.Body.List[0].(*ast.CaseClause),
+		sw.Body.List[1].(*ast.CaseClause),
+	}
+	for _, cc := range clauses {
+		if len(cc.List) > 1 || c.hasBranch(cc) {
+			return
+		}
+	}
+
+	if sw.Tag == nil {
+		c.checkCondSwitch(sw, clauses)
+		return
+	}
+	if !types.Identical(c.ctx.TypeOf(sw.Tag).Underlying(), types.Typ[types.Bool]) {
+		return
+	}
+	// trueClause and falseClause are the clauses executed for
+	// the true and false tag values, default matches either of them.
+	var trueClause, falseClause *ast.CaseClause
+	for _, cc := range clauses {
+		if cc.List == nil {
+			continue
+		}
+		tv := c.ctx.TypesInfo.Types[cc.List[0]]
+		if tv.Value == nil || tv.Value.Kind() != constant.Bool {
+			return
+		}
+		if constant.BoolVal(tv.Value) {
+			trueClause = cc
+		} else {
+			falseClause = cc
+		}
+	}
+	for _, cc := range clauses {
+		if cc.List == nil && trueClause == nil {
+			trueClause = cc
+		} else if cc.List == nil && falseClause == nil {
+			falseClause = cc
+		}
+	}
+	if trueClause == nil || falseClause == nil {
+		return
+	}
+
+	const format = "switch on bool %s can be rewritten as if-else"
+	if hasCommentsIn(c.file, sw) {
+		c.ctx.Warn(sw, format, sw.Tag)
+		return
+	}
+	c.ctx.WarnFixable(sw, linter.QuickFix{
+		From:        sw.Pos(),
+		To:          sw.End(),
+		Replacement: []byte(c.suggestIf(sw, trueClause.Body, falseClause.Body)),
+	}, format, sw.Tag)
+}
+
+func (c *switchOnBoolChecker) checkCondSwitch(sw *ast.SwitchStmt, clauses [2]*ast.CaseClause) {
+	if (clauses[0].List == nil) == (clauses[1].List == nil) {
+		return
+	}
+	c.ctx.Warn(sw, "switch with a single condition and default can be rewritten as if-else")
+}
+
+// suggestIf returns the if-else statement that replaces
+// the switch with the given clause bodies.
+func (c *switchOnBoolChecker) suggestIf(sw *ast.SwitchStmt, trueBody, falseBody []ast.Stmt) string {
+	cond := sw.Tag
+	if len(trueBody) == 0 && len(falseBody) != 0 {
+		cond = &ast.UnaryExpr{Op: token.NOT, X: c.parenthesize(cond)}
+		trueBody, falseBody = falseBody, nil
+	}
+	ifStmt := &ast.IfStmt{
+		Init: sw.Init,
+		Cond: cond,
+		Body: &ast.BlockStmt{List: trueBody},
+	}
+	if len(falseBody) != 0 {
+		ifStmt.Else = &ast.BlockStmt{List: falseBody}
+	}
+
+	return reindent(c.ctx.FileSet, sw.Pos(), astfmt.Sprint(ifStmt))
+}
+
+func (c *switchOnBoolChecker) parenthesize(x ast.Expr) ast.Expr {
+	switch x.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.CallExpr, *ast.ParenExpr, *ast.IndexExpr:
+		return x
+	default:
+		return &ast.ParenExpr{X: x}
+	}
+}
+
+// hasBranch reports whether cc contains fallthrough or break
+// statements that would change their meaning in the if statement.
+func (c *switchOnBoolChecker) hasBranch(cc *ast.CaseClause) bool {
+	found := false
+	astutil.Apply(cc, func(cur *astutil.Cursor) bool {
+		switch n := cur.Node().(type) {
+		case *ast.BranchStmt:
+			if n.Label == nil && (n.Tok == token.BREAK || n.Tok == token.FALLTHROUGH) {
+				found = true
+			}
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SelectStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.FuncLit:
+			return false
+		}
+		return true
+	}, nil)
+	return found
+}
@@ -0,0 +1,168 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "syncOnceValue"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects sync.Once guarded getters that can be replaced by sync.OnceValue or sync.OnceFunc"
+	info.Before = `
+var (
+	configOnce sync.Once
+	config     *Config
+)
+
+func getConfig() *Config {
+	configOnce.Do(func() { config = loadConfig() })
+	return config
+}`
+	info.After = `var getConfig = sync.OnceValue(loadConfig)`
+	info.Note = "Only reported for Go 1.21 and later, sync.OnceValue and sync.OnceFunc were added in that version"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &syncOnceValueChecker{ctx: ctx}, nil
+	})
+}
+
+type syncOnceValueChecker struct {
+	ctx *linter.CheckerContext
+
+	// suggestions maps the once-guarded getters to their replacements.
+	suggestions map[*ast.FuncDecl]string
+}
+
+func (c *syncOnceValueChecker) WalkPackage(files []*ast.File) {
+	c.suggestions = make(map[*ast.FuncDecl]string)
+	v := c.ctx.GoVersion
+	if !v.IsAny() && !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 21}) {
+		return
+	}
+
+	// uses counts the references to the package-level variables.
+	uses := make(map[types.Object]int)
+	for _, obj := range c.ctx.TypesInfo.Uses {
+		if c.isPackageVar(obj) {
+			uses[obj]++
+		}
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if ok && decl.Recv == nil && decl.Body != nil && decl.Type.Params.NumFields() == 0 {
+				c.collectGetter(decl, uses)
+			}
+		}
+	}
+}
+
+func (c *syncOnceValueChecker) collectGetter(decl *ast.FuncDecl, uses map[types.Object]int) {
+	body := decl.Body.List
+	if len(body) == 0 {
+		return
+	}
+	once, fn := c.onceCall(body[0])
+	if once == nil || uses[once] != 1 {
+		return
+	}
+
+	switch {
+	case len(body) == 1 && decl.Type.Results.NumFields() == 0:
+		// func setup() { once.Do(initialize) }
+		if lit, ok := fn.(*ast.FuncLit); ok && len(lit.Body.List) == 1 {
+			if call, ok := c.plainCall(lit.Body.List[0]); ok && len(call.Args) == 0 {
+				fn = call.Fun
+			}
+		}
+		c.suggestions[decl] = "sync.OnceFunc(" + astfmt.Sprint(fn) + ")"
+
+	case len(body) == 2 && decl.Type.Results.NumFields() == 1:
+		// func getX() T { once.Do(func() { x = compute() }); return x }
+		ret, ok := body[1].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return
+		}
+		cached, ok := ret.Results[0].(*ast.Ident)
+		if !ok || uses[c.ctx.TypesInfo.ObjectOf(cached)] != 2 {
+			return
+		}
+		lit, ok := fn.(*ast.FuncLit)
+		if !ok || len(lit.Body.List) != 1 {
+			return
+		}
+		assign, ok := lit.Body.List[0].(*ast.AssignStmt)
+		if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return
+		}
+		lhs, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || c.ctx.TypesInfo.ObjectOf(lhs) != c.ctx.TypesInfo.ObjectOf(cached) {
+			return
+		}
+		value := assign.Rhs[0]
+		result := c.ctx.TypeOf(decl.Type.Results.List[0].Type)
+		if call, ok := value.(*ast.CallExpr); ok && len(call.Args) == 0 && c.returns(call, result) {
+			c.suggestions[decl] = "sync.OnceValue(" + astfmt.Sprint(call.Fun) + ")"
+			return
+		}
+		c.suggestions[decl] = "sync.OnceValue(func() " + astfmt.Sprint(decl.Type.Results.List[0].Type) + " { return " + astfmt.Sprint(value) + " })"
+	}
+}
+
+// onceCall returns the package-level sync.Once variable and the function
+// argument of the once.Do call statement, or nil if stmt is something else.
+func (c *syncOnceValueChecker) onceCall(stmt ast.Stmt) (types.Object, ast.Expr) {
+	call, ok := c.plainCall(stmt)
+	if !ok || len(call.Args) != 1 || calledFuncName(c.ctx.TypesInfo, call) != "(*sync.Once).Do" {
+		return nil, nil
+	}
+	id, ok := call.Fun.(*ast.SelectorExpr).X.(*ast.Ident)
+	if !ok {
+		return nil, nil
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if !c.isPackageVar(obj) {
+		return nil, nil
+	}
+	return obj, call.Args[0]
+}
+
+func (c *syncOnceValueChecker) plainCall(stmt ast.Stmt) (*ast.CallExpr, bool) {
+	expr, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+	call, ok := expr.X.(*ast.CallExpr)
+	return call, ok
+}
+
+// returns reports whether call is a call of a function with a single result of type typ.
+func (c *syncOnceValueChecker) returns(call *ast.CallExpr, typ types.Type) bool {
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	return ok && sig.Results().Len() == 1 && types.Identical(sig.Results().At(0).Type(), typ)
+}
+
+func (c *syncOnceValueChecker) isPackageVar(obj types.Object) bool {
+	v, ok := obj.(*types.Var)
+	return ok && !v.IsField() && v.Pkg() == c.ctx.Pkg && v.Parent() == c.ctx.Pkg.Scope()
+}
+
+func (c *syncOnceValueChecker) WalkFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if suggestion, ok := c.suggestions[decl]; ok {
+			c.ctx.Warn(decl.Name, "%s guards its body with sync.Once, it can be replaced by `var %s = %s`",
+				decl.Name, decl.Name, suggestion)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "testCleanupPreferred"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects test helpers that defer cleanup of resources they return"
+	info.Before = `
+func startServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	return srv
+}`
+	info.After = `
+func startServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&testCleanupPreferredChecker{ctx: ctx}), nil
+	})
+}
+
+// cleanupMethods is a set of method names that release resources.
+var cleanupMethods = map[string]bool{
+	"Close":     true,
+	"Stop":      true,
+	"Shutdown":  true,
+	"Remove":    true,
+	"RemoveAll": true,
+	"Release":   true,
+	"Terminate": true,
+	"Kill":      true,
+}
+
+type testCleanupPreferredChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *testCleanupPreferredChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil || decl.Recv != nil || isTestFuncName(decl.Name.Name) {
+		return
+	}
+	t := testingParam(c.ctx.TypesInfo, decl.Type.Params)
+	if t == nil {
+		return
+	}
+
+	// groups maps every variable to the variables defined
+	// by the same assignment, like srv and teardown
+	// in `srv, teardown := start()`.
+	groups := make(map[types.Object][]types.Object)
+	escaped := make(map[types.Object]bool)
+	var defers []*ast.DeferStmt
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.DeferStmt:
+			defers = append(defers, n)
+		case *ast.AssignStmt:
+			group := make([]types.Object, 0, len(n.Lhs))
+			for _, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+						group = append(group, obj)
+					}
+				}
+				// Stored resources outlive the helper as well.
+				if _, ok := lhs.(*ast.SelectorExpr); ok {
+					for _, rhs := range n.Rhs {
+						c.markEscaped(escaped, rhs)
+					}
+				}
+			}
+			for _, obj := range group {
+				groups[obj] = append(groups[obj], group...)
+			}
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				c.markEscaped(escaped, result)
+			}
+		}
+		return true
+	})
+
+	for _, d := range defers {
+		target := c.cleanupTarget(d.Call)
+		if target == nil {
+			continue
+		}
+		related := append([]types.Object{target}, groups[target]...)
+		for _, obj := range related {
+			if escaped[obj] {
+				c.warn(d, decl.Name, t)
+				break
+			}
+		}
+	}
+}
+
+func (c *testCleanupPreferredChecker) warn(d *ast.DeferStmt, helper, t *ast.Ident) {
+	var call interface{} = d.Call
+	if _, ok := astutil.Unparen(d.Call.Fun).(*ast.FuncLit); ok {
+		call = "deferred func"
+	}
+	c.ctx.Warn(d, "%s runs when %s returns, not when the test ends; use %s.Cleanup instead",
+		call, helper, t)
+}
+
+// cleanupTarget returns the variable that is being released by the call.
+func (c *testCleanupPreferredChecker) cleanupTarget(call *ast.CallExpr) types.Object {
+	switch fn := astutil.Unparen(call.Fun).(type) {
+	case *ast.FuncLit:
+		if c.containsRecover(fn.Body) {
+			return nil
+		}
+		var target types.Object
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && target == nil {
+				target = c.cleanupTarget(call)
+			}
+			return target == nil
+		})
+		return target
+	case *ast.Ident:
+		// Deferred call of a cleanup func, like `defer teardown()`.
+		if v, ok := c.ctx.TypesInfo.ObjectOf(fn).(*types.Var); ok {
+			return v
+		}
+	case *ast.SelectorExpr:
+		switch calledFuncName(c.ctx.TypesInfo, call) {
+		case "os.Remove", "os.RemoveAll":
+			if len(call.Args) == 1 {
+				return c.rootObject(call.Args[0])
+			}
+			return nil
+		}
+		if cleanupMethods[fn.Sel.Name] && !c.isPackage(fn.X) {
+			return c.rootObject(fn.X)
+		}
+	}
+	return nil
+}
+
+func (c *testCleanupPreferredChecker) containsRecover(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isBuiltinCall(c.ctx.TypesInfo, call, "recover") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// rootObject returns the variable in x, y.f or y.f.g selector chains.
+func (c *testCleanupPreferredChecker) rootObject(x ast.Expr) types.Object {
+	for {
+		switch e := astutil.Unparen(x).(type) {
+		case *ast.SelectorExpr:
+			x = e.X
+		case *ast.Ident:
+			if v, ok := c.ctx.TypesInfo.ObjectOf(e).(*types.Var); ok {
+				return v
+			}
+			return nil
+		default:
+			return nil
+		}
+	}
+}
+
+func (c *testCleanupPreferredChecker) isPackage(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = c.ctx.TypesInfo.ObjectOf(id).(*types.PkgName)
+	return ok
+}
+
+func (c *testCleanupPreferredChecker) markEscaped(escaped map[types.Object]bool, x ast.Expr) {
+	ast.Inspect(x, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+				escaped[obj] = true
+			}
+		}
+		return true
+	})
+}
+
+// isTestFuncName reports whether name is a Test, Benchmark,
+// Fuzz or Example function name recognized by go test.
+func isTestFuncName(name string) bool {
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
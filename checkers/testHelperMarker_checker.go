@@ -0,0 +1,133 @@
+package checkers
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "testHelperMarker"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects test helpers that report failures without calling t.Helper()"
+	info.Before = `
+func assertEqual(t *testing.T, got, want int) {
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}`
+	info.After = `
+func assertEqual(t *testing.T, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&testHelperMarkerChecker{ctx: ctx}), nil
+	})
+}
+
+// testFailureMethods is a set of testing.TB methods that mark the test as failed.
+var testFailureMethods = map[string]bool{
+	"Error":   true,
+	"Errorf":  true,
+	"Fatal":   true,
+	"Fatalf":  true,
+	"Fail":    true,
+	"FailNow": true,
+}
+
+type testHelperMarkerChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *testHelperMarkerChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	if !c.isTestFunc(decl) {
+		c.checkFunc(decl.Name.Name, decl.Type, decl.Body)
+	}
+
+	// subtests is a set of function literals that are passed to t.Run or f.Fuzz.
+	subtests := make(map[*ast.FuncLit]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && (sel.Sel.Name == "Run" || sel.Sel.Name == "Fuzz") {
+				for _, arg := range n.Args {
+					if lit, ok := arg.(*ast.FuncLit); ok {
+						subtests[lit] = true
+					}
+				}
+			}
+		case *ast.FuncLit:
+			if !subtests[n] {
+				c.checkFunc("function literal", n.Type, n.Body)
+			}
+		}
+		return true
+	})
+}
+
+func (c *testHelperMarkerChecker) isTestFunc(decl *ast.FuncDecl) bool {
+	if decl.Recv != nil || decl.Type.Results != nil || len(decl.Type.Params.List) != 1 {
+		return false
+	}
+	name := decl.Name.Name
+	return strings.HasPrefix(name, "Test") ||
+		strings.HasPrefix(name, "Benchmark") ||
+		strings.HasPrefix(name, "Fuzz")
+}
+
+func (c *testHelperMarkerChecker) checkFunc(name string, typ *ast.FuncType, body *ast.BlockStmt) {
+	t := testingParam(c.ctx.TypesInfo, typ.Params)
+	if t == nil || t.Name == "_" || len(body.List) == 0 {
+		return
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(t)
+
+	var failure *ast.SelectorExpr
+	hasHelper := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			// Nested functions are checked separately.
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); !ok || c.ctx.TypesInfo.Uses[id] != obj {
+			return true
+		}
+		switch {
+		case sel.Sel.Name == "Helper":
+			hasHelper = true
+		case testFailureMethods[sel.Sel.Name] && failure == nil:
+			failure = sel
+		}
+		return true
+	})
+	if hasHelper || failure == nil {
+		return
+	}
+
+	indent := strings.Repeat("\t", c.ctx.FileSet.Position(body.List[0].Pos()).Column-1)
+	c.ctx.WarnFixable(typ, linter.QuickFix{
+		From:        body.Lbrace + 1,
+		To:          body.Lbrace + 1,
+		Replacement: []byte("\n" + indent + t.Name + ".Helper()"),
+	}, "%s calls %s but not %s.Helper(), so failures point at the helper lines; call %s.Helper() first",
+		name, failure, t, t)
+}
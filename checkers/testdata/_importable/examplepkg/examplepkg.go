@@ -8,3 +8,10 @@ type StructType struct {
 type InterfaceType interface {
 	Method() int
 }
+
+type timeoutError struct{}
+
+func (*timeoutError) Error() string { return "timeout" }
+
+// NewTimeoutError returns a value of unexported error type.
+func NewTimeoutError() *timeoutError { return &timeoutError{} }
@@ -0,0 +1,23 @@
+package main
+
+func main() {}
+
+// Exported is reported unless minResults is above 2.
+func Exported() (int, int) {
+	return 0, 0
+}
+
+// unexported is skipped when exportedOnly is set.
+func unexported() (int, int) {
+	return 0, 0
+}
+
+// Triple is reported with any of the params.
+func Triple() (int, int, int) {
+	return 0, 0, 0
+}
+
+// triple is reported only when exportedOnly is not set.
+func triple() (int, int, int) {
+	return 0, 0, 0
+}
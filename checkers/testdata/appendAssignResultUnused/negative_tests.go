@@ -0,0 +1,51 @@
+package checker_test
+
+func returned(opts []string) []string {
+	opts = append(opts, "-v")
+	return opts
+}
+
+func usedLocally(xs []int) int {
+	xs = append(xs, 1)
+	return len(xs)
+}
+
+func passedOnward(xs []int, f func([]int)) {
+	xs = append(xs, 1)
+	f(xs)
+}
+
+func usedInLoop(xs []int, n int) {
+	for i := 0; i < n; i++ {
+		println(len(xs))
+		xs = append(xs, i)
+	}
+}
+
+func usedInClosure(xs []int) func() int {
+	f := func() int { return len(xs) }
+	xs = append(xs, 1)
+	return f
+}
+
+func pointerParam(xs *[]int) {
+	*xs = append(*xs, 1)
+}
+
+func appendToOther(xs []int) []int {
+	ys := append(xs, 1)
+	return ys
+}
+
+func copyAppendNotRead(a []int) []int {
+	b := a
+	b = append(b, 1)
+	return b
+}
+
+func copyAppendReassigned(a []int) int {
+	b := a
+	b = append(b, 1)
+	a = b
+	return len(a)
+}
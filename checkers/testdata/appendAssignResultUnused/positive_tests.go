@@ -0,0 +1,32 @@
+package checker_test
+
+func addDefaults(opts []string) {
+	/*! append result is invisible to the caller; return the slice or use a pointer */
+	opts = append(opts, "-v")
+}
+
+func collect(dst []int, src []int) {
+	if len(dst) == 0 {
+		return
+	}
+	for _, x := range src {
+		if x > 0 {
+			/*! append result is invisible to the caller; return the slice or use a pointer */
+			dst = append(dst, x)
+		}
+	}
+}
+
+func variadicParam(args ...string) {
+	/*! append result is invisible to the caller; return the slice or use a pointer */
+	args = append(args, "--")
+	args = append(args, "end")
+}
+
+func copyAppend(a []int) int {
+	b := a
+	/*! b is a copy of a, appending to it doesn't change a */
+	b = append(b, 1)
+	_ = b
+	return len(a)
+}
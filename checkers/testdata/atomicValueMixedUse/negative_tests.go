@@ -0,0 +1,52 @@
+package checker_test
+
+import (
+	"sync/atomic"
+)
+
+var loaded uint32
+
+func init() {
+	loaded = 1
+}
+
+func markLoaded() {
+	atomic.StoreUint32(&loaded, 1)
+}
+
+type queue struct {
+	size    int64
+	closed  atomic.Bool
+	pending int
+}
+
+func NewQueue(size int64) *queue {
+	q := &queue{size: size}
+	q.size = size * 2
+	q.closed.Store(false)
+	go q.loop()
+	return q
+}
+
+func (q *queue) loop() {
+	for !q.closed.Load() {
+		atomic.AddInt64(&q.size, -1)
+	}
+	q.pending++
+}
+
+func (q *queue) close() {
+	q.closed.Store(true)
+	q.pending = 0
+}
+
+func onlyAtomic(n *int64) int64 {
+	atomic.AddInt64(n, 1)
+	return atomic.LoadInt64(n)
+}
+
+func onlyPlain() int {
+	var n int
+	n++
+	return n
+}
@@ -0,0 +1,62 @@
+package checker_test
+
+import (
+	"sync/atomic"
+)
+
+var requests int64
+
+func handleRequest() {
+	atomic.AddInt64(&requests, 1)
+}
+
+func requestsCount() int64 {
+	/*! requests is accessed both atomically at positive_tests.go:10 and non-atomically at positive_tests.go:15; use sync/atomic for every access */
+	return requests
+}
+
+type stats struct {
+	hits   uint32
+	misses atomic.Int64
+}
+
+func (s *stats) hit() {
+	atomic.AddUint32(&s.hits, 1)
+	s.misses.Add(1)
+}
+
+func (s *stats) reset() {
+	/*! s.hits is accessed both atomically at positive_tests.go:24 and non-atomically at positive_tests.go:30; use sync/atomic for every access */
+	s.hits = 0
+	/*! s.misses is accessed both atomically at positive_tests.go:25 and non-atomically at positive_tests.go:32; use sync/atomic for every access */
+	s.misses = atomic.Int64{}
+}
+
+type pool struct {
+	active int32
+}
+
+func newPool() *pool {
+	p := &pool{}
+	go p.run()
+	/*! p.active is accessed both atomically at positive_tests.go:48 and non-atomically at positive_tests.go:43; use sync/atomic for every access */
+	p.active = 1
+	return p
+}
+
+func (p *pool) run() {
+	for atomic.LoadInt32(&p.active) != 0 {
+	}
+}
+
+func localCounter(jobs []func()) int32 {
+	var done int32
+	for _, job := range jobs {
+		go func(job func()) {
+			job()
+			atomic.AddInt32(&done, 1)
+		}(job)
+	}
+	/*! done is accessed both atomically at positive_tests.go:57 and non-atomically at positive_tests.go:61; use sync/atomic for every access */
+	return done
+}
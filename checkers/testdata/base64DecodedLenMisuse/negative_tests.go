@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+func encodeSized(src []byte) []byte {
+	dst := make([]byte, base64.StdEncoding.EncodedLen(len(src)))
+	base64.StdEncoding.Encode(dst, src)
+	return dst
+}
+
+func decodeSized(src []byte) ([]byte, error) {
+	dst := make([]byte, base64.StdEncoding.DecodedLen(len(src)))
+	n, err := base64.StdEncoding.Decode(dst, src)
+	return dst[:n], err
+}
+
+func hexDecodeExact(src []byte) ([]byte, error) {
+	dst := make([]byte, hex.DecodedLen(len(src)))
+	_, err := hex.Decode(dst, src)
+	return dst, err
+}
+
+func hexDecodeInPlace(buf []byte) ([]byte, error) {
+	n, err := hex.Decode(buf, buf)
+	return buf[:n], err
+}
+
+func decodeNotUsedAfter(src []byte) error {
+	dst := make([]byte, base64.StdEncoding.DecodedLen(len(src)))
+	_, err := base64.StdEncoding.Decode(dst, src)
+	return err
+}
+
+func reassignedDst(src, other []byte) ([]byte, error) {
+	dst := make([]byte, len(src))
+	dst = other
+	n, err := base64.StdEncoding.Decode(dst, src)
+	return dst[:n], err
+}
+
+func differentBuffers(dst, src []byte) {
+	base64.StdEncoding.Encode(dst, src)
+}
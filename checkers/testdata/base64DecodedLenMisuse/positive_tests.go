@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+func encodeShort(src []byte) []byte {
+	dst := make([]byte, len(src))
+	/*! dst is sized with len(src), use base64.StdEncoding.EncodedLen(len(src)) instead */
+	base64.StdEncoding.Encode(dst, src)
+	return dst
+}
+
+func hexEncodeShort(src []byte) []byte {
+	dst := make([]byte, len(src))
+	/*! dst is sized with len(src), use hex.EncodedLen(len(src)) instead */
+	hex.Encode(dst, src)
+	return dst
+}
+
+func decodeLenSrc(enc *base64.Encoding, src []byte) ([]byte, error) {
+	dst := make([]byte, len(src))
+	/*! dst is sized with len(src), use enc.DecodedLen(len(src)) instead */
+	n, err := enc.Decode(dst, src)
+	return dst[:n], err
+}
+
+func decodeIgnoredLen(src []byte) ([]byte, error) {
+	dst := make([]byte, base64.StdEncoding.DecodedLen(len(src)))
+	/*! decoded length returned by base64.StdEncoding.Decode is ignored, dst may contain garbage after the decoded bytes; use dst[:n] */
+	_, err := base64.StdEncoding.Decode(dst, src)
+	return dst, err
+}
+
+func decodeStmt(src []byte) []byte {
+	dst := make([]byte, base64.URLEncoding.DecodedLen(len(src)))
+	/*! decoded length returned by base64.URLEncoding.Decode is ignored, dst may contain garbage after the decoded bytes; use dst[:n] */
+	base64.URLEncoding.Decode(dst, src)
+	return dst
+}
+
+func decodeInPlace(buf []byte) (int, error) {
+	/*! src and dst of base64.StdEncoding.Decode overlap, the input is overwritten while it's processed */
+	return base64.StdEncoding.Decode(buf, buf)
+}
+
+func encodeOverlap(buf []byte, n int) {
+	/*! src and dst of base64.RawStdEncoding.Encode overlap, the input is overwritten while it's processed */
+	base64.RawStdEncoding.Encode(buf[n:], buf[:n])
+}
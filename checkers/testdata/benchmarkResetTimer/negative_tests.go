@@ -0,0 +1,102 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func BenchmarkResetAfterSetup(b *testing.B) {
+	b.ReportAllocs()
+	data, _ := ioutil.ReadFile("testdata/input.json")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchParse(data)
+	}
+}
+
+func BenchmarkStoppedTimer(b *testing.B) {
+	b.ReportAllocs()
+	b.StopTimer()
+	f, _ := os.Open("testdata/input.json")
+	defer f.Close()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+func BenchmarkSmallSetup(b *testing.B) {
+	b.ReportAllocs()
+	data := make([]byte, 64)
+	for i := 0; i < b.N; i++ {
+		benchParse(data)
+	}
+}
+
+func BenchmarkAllocIsMeasured(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = make([]byte, 128)
+	}
+	for i := 0; i < b.N; i++ {
+		data := make([]byte, 128)
+		_ = data
+	}
+}
+
+func BenchmarkDependentAlloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data := make([]byte, i%128)
+		s := []string{strconv.Itoa(i)}
+		benchParse(data)
+		_ = s
+	}
+	for i := 0; i < b.N; i++ {
+		x := i * 2
+		data := []int{x}
+		_ = data
+	}
+}
+
+func BenchmarkEmptyLiteral(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var data []byte
+		buf := []byte{}
+		benchParse(append(data, buf...))
+	}
+}
+
+func BenchmarkSetupInsideLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, _ := ioutil.ReadFile("testdata/input.json")
+		benchParse(data)
+	}
+}
+
+func BenchmarkNoLoop(b *testing.B) {
+	b.ReportAllocs()
+	data, _ := ioutil.ReadFile("testdata/input.json")
+	benchParse(data)
+}
+
+func notBenchmark(b *testing.B) {
+	data, _ := ioutil.ReadFile("testdata/input.json")
+	for i := 0; i <= b.N; i++ {
+		benchParse(data)
+	}
+}
+
+func BenchmarkNotTestingB(x int) {
+	for i := 0; i <= x; i++ {
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	data, _ := ioutil.ReadFile("testdata/input.json")
+	_ = data
+}
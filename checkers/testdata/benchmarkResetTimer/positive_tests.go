@@ -0,0 +1,101 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+)
+
+func benchParse(data []byte) int { return len(data) }
+
+func BenchmarkReadFile(b *testing.B) {
+	b.ReportAllocs()
+	/*! ioutil.ReadFile before the benchmark loop is included in the measured time; call b.ResetTimer() before the loop */
+	data, _ := ioutil.ReadFile("testdata/input.json")
+	for i := 0; i < b.N; i++ {
+		benchParse(data)
+	}
+}
+
+func BenchmarkOpen(b *testing.B) {
+	b.ReportAllocs()
+	/*! os.Open before the benchmark loop is included in the measured time; call b.ResetTimer() before the loop */
+	f, err := os.Open("testdata/input.json")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+	for i := 0; i < b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+func BenchmarkDialAfterReset(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	/*! net.Dial before the benchmark loop is included in the measured time; call b.ResetTimer() before the loop */
+	conn, _ := net.Dial("tcp", "localhost:8080")
+	_ = conn
+	for i := 0; i < b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+func BenchmarkLargeMake(b *testing.B) {
+	b.ReportAllocs()
+	/*! make([]byte, 1<<20) before the benchmark loop is included in the measured time; call b.ResetTimer() before the loop */
+	data := make([]byte, 1<<20)
+	for i := 0; i < b.N; i++ {
+		benchParse(data)
+	}
+}
+
+func BenchmarkHoistable(b *testing.B) {
+	b.ReportAllocs()
+	n := 128
+	for i := 0; i < b.N; i++ {
+		/*! data is allocated on every iteration but doesn't depend on it; allocate it before the loop and call b.ResetTimer() */
+		data := make([]byte, n)
+		benchParse(data)
+	}
+	for i := 0; i < b.N; i++ {
+		/*! input is allocated on every iteration but doesn't depend on it; allocate it before the loop and call b.ResetTimer() */
+		input := []byte{'a', 'b', byte(n)}
+		benchParse(input)
+	}
+}
+
+func BenchmarkOffByOne(b *testing.B) {
+	b.ReportAllocs()
+	/*! benchmark loop runs b.N+1 iterations; use i < b.N */
+	for i := 0; i <= b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+func BenchmarkStartFromOne(b *testing.B) {
+	b.ReportAllocs()
+	/*! benchmark loop starts from 1 and runs fewer than b.N iterations; start from 0 */
+	for i := 1; i < b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+/*! BenchmarkNoAllocs doesn't call b.ReportAllocs() */
+func BenchmarkNoAllocs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchParse(nil)
+	}
+}
+
+func BenchmarkSub(b *testing.B) {
+	b.ReportAllocs()
+	b.Run("read", func(b *testing.B) {
+		/*! ioutil.ReadFile before the benchmark loop is included in the measured time; call b.ResetTimer() before the loop */
+		data, _ := ioutil.ReadFile("testdata/input.json")
+		for i := 0; i < b.N; i++ {
+			benchParse(data)
+		}
+	})
+}
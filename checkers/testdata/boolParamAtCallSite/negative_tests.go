@@ -0,0 +1,25 @@
+package checker_test
+
+import (
+	"fmt"
+	"strings"
+)
+
+func variadic(name string, flags ...bool) {}
+
+func callNegative(force bool) {
+	deploy("app", true, force)
+	deploy("app", true /* force */, false /* dryRun */)
+	deploy("app", /* force */ true, /* dryRun */ false)
+	variadic("x", true, false, true)
+	fmt.Println(true, false)
+	_ = strings.EqualFold("a", "b")
+
+	f := func(a, b bool) {}
+	f(true, false)
+}
+
+func shadowedTrue() {
+	true := false
+	deploy("app", true, true)
+}
@@ -0,0 +1,23 @@
+package checker_test
+
+func deploy(app string, force, dryRun bool) {}
+
+type client struct{}
+
+func (client) Fetch(url string, retry, cache, verbose bool) {}
+
+func callDeploy() {
+	/*! deploy is called with bool literals for force, dryRun; add inline comments with the parameter names or use named constants */
+	deploy("app", true, false)
+
+	var c client
+	/*! c.Fetch is called with bool literals for retry, verbose; add inline comments with the parameter names or use named constants */
+	c.Fetch("/", false, true /* cache */, true)
+}
+
+func unnamedParams(bool, bool) {}
+
+func callUnnamed() {
+	/*! unnamedParams is called with bool literals for #1, #2; add inline comments with the parameter names or use named constants */
+	unnamedParams(true, true)
+}
@@ -0,0 +1,6 @@
+//go:build !windows
+
+/*! // +build lines disagree with //go:build !windows for aix/ppc64 cgo; remove them or run gofmt to sync them */
+// +build !windows,!cgo
+
+package checker_test
@@ -0,0 +1,6 @@
+//go:build linux || darwin
+
+/*! // +build lines disagree with //go:build linux || darwin for darwin/amd64; remove them or run gofmt to sync them */
+// +build linux
+
+package checker_test
@@ -0,0 +1,5 @@
+/*! // +build lines have no //go:build counterpart; add //go:build (linux || darwin) && amd64 */
+// +build linux darwin
+// +build amd64
+
+package checker_test
@@ -0,0 +1,4 @@
+//go:build linux || darwin || !darwin
+
+// Package doc comment after the constraint.
+package checker_test
@@ -0,0 +1,8 @@
+/*! //go:build linux || !linux is ignored because it is directly above the package clause; separate it with a blank line */
+//go:build linux || !linux
+package checker_test
+
+/*! // +build linux after the package clause is ignored; move it to the top of the file */
+// +build linux
+
+func buildTagPlacement() {}
@@ -0,0 +1,4 @@
+//go:build (linux && amd64) || !cgo
+// +build linux,amd64 !cgo
+
+package checker_test
@@ -0,0 +1,4 @@
+//go:build unix || windows || plan9 || js || wasip1
+// +build aix android darwin dragonfly freebsd hurd illumos ios linux netbsd openbsd solaris windows plan9 js wasip1
+
+package checker_test
@@ -36,3 +36,17 @@ func genDeclNoShadow() {
 	_ = _true
 	_ = _false
 }
+
+func rangeNoShadow(xs []string) {
+	for i, s := range xs {
+		_, _ = i, s
+	}
+	var i int
+	for i = range xs {
+	}
+	_ = i
+}
+
+func funcLitNoShadow() {
+	_ = func(x int) (y int) { return x }
+}
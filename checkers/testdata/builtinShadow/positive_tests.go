@@ -196,3 +196,23 @@ func assigningToBuiltinIdentifiers() {
 	recover := 1
 	_ = recover
 }
+
+func rangeShadow(xs []string) {
+	/*! shadowing of predeclared identifier: len */
+	/*! shadowing of predeclared identifier: string */
+	for len, string := range xs {
+		_, _ = len, string
+	}
+}
+
+func funcLitShadow() {
+	/*! shadowing of predeclared identifier: cap */
+	_ = func(cap int) {}
+
+	f := func() {
+		/*! shadowing of predeclared identifier: copy */
+		copy := 1
+		_ = copy
+	}
+	_ = f
+}
@@ -0,0 +1,53 @@
+package checker_test
+
+import (
+	"bytes"
+)
+
+type bytesWithMethods []byte
+
+func (b bytesWithMethods) Len() int { return len(b) }
+
+type stringWithMethods string
+
+func (s stringWithMethods) Len() int { return len(s) }
+
+func singleConversions(b []byte, s string) {
+	_ = string(b)
+	_ = []byte(s)
+	_ = []byte(string(b) + "x")
+	_ = string(append([]byte(s), 'x'))
+	_ = bytes.NewBuffer([]byte(s))
+}
+
+func differentTypes(rs []rune, s string) {
+	_ = []rune(string(rs))
+	_ = string([]rune(s))
+	_ = []byte(string(rs))
+}
+
+func namedWithMethods(b []byte, s string, bm bytesWithMethods, sm stringWithMethods) {
+	_ = []byte(bytesWithMethods(b))
+	_ = bytesWithMethods(string(bm))
+	_ = []byte(string(bm))
+	_ = string(bytesWithMethods(s))
+	_ = stringWithMethods([]byte(sm))
+	_ = string(stringWithMethods(s))
+}
+
+func rangeOverOther(s string, b []byte, sm stringWithMethods) {
+	for i := range s {
+		println(i)
+	}
+	for i := range b {
+		println(i)
+	}
+	for i := range []rune(s) {
+		println(i)
+	}
+	for i := range []byte(sm) {
+		println(i)
+	}
+	for range []byte(s) {
+	}
+}
@@ -0,0 +1,68 @@
+package checker_test
+
+import (
+	"bytes"
+	"io"
+)
+
+type rawBytes []byte
+
+type rawString string
+
+func bytesRoundTrip(w io.Writer, b []byte, raw rawBytes) {
+	/*! []byte(string(b)) is a redundant conversion round trip; use b instead */
+	w.Write([]byte(string(b)))
+
+	/*! []byte(string((b))) is a redundant conversion round trip; use b instead */
+	_ = bytes.Equal([]byte(string((b))), b)
+
+	/*! rawBytes(string(raw)) is a redundant conversion round trip; use raw instead */
+	for _, ch := range rawBytes(string(raw)) {
+		println(ch)
+	}
+
+	/*! rawBytes(string(b)) is a redundant conversion round trip; use rawBytes(b) instead */
+	w.Write(rawBytes(string(b)))
+
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	appendAll(nil, []byte(string(b)))
+}
+
+func appendAll(dst []byte, chunks ...[]byte) {}
+
+func bytesCopy(b []byte, raw rawBytes) {
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	b2 := []byte(string(b))
+	b2[0] = 'x'
+
+	/*! rawBytes(string(raw)) copies raw via a string conversion; use bytes.Clone(raw) instead */
+	_ = rawBytes(string(raw))
+}
+
+func stringRoundTrip(s string, raw rawString) {
+	/*! string([]byte(s)) is a redundant conversion round trip; use s instead */
+	println(string([]byte(s)))
+
+	/*! rawString([]byte(raw)) is a redundant conversion round trip; use raw instead */
+	_ = rawString([]byte(raw))
+
+	/*! string(rawBytes(s)) is a redundant conversion round trip; use s instead */
+	_ = string(rawBytes(s))
+}
+
+func runesRoundTrip(rs []rune) {
+	/*! string([]byte(string(rs))) is a redundant conversion round trip; use string(rs) instead */
+	_ = string([]byte(string(rs)))
+}
+
+func rangeOverBytes(s string) {
+	/*! []byte(s) allocates a byte slice copy; iterate over len(s) and index s directly */
+	for i := range []byte(s) {
+		println(i)
+	}
+
+	/*! []byte(s) allocates a byte slice copy; iterate over len(s) and index s directly */
+	for _, ch := range []byte(s) {
+		println(ch)
+	}
+}
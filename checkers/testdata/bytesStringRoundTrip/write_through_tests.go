@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+func writeThrough(r io.Reader, b, x []byte) {
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	copy([]byte(string(b)), x)
+
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	_ = append([]byte(string(b)), x...)
+
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	io.ReadFull(r, []byte(string(b)))
+
+	/*! []byte(string(b)) copies b via a string conversion; use bytes.Clone(b) instead */
+	_ = bytes.NewBuffer([]byte(string(b)))
+}
+
+func readOnly(w io.Writer, b, x []byte) {
+	/*! []byte(string(b)) is a redundant conversion round trip; use b instead */
+	copy(x, []byte(string(b)))
+
+	/*! []byte(string(b)) is a redundant conversion round trip; use b instead */
+	_ = append(x, []byte(string(b))...)
+
+	/*! []byte(string(b)) is a redundant conversion round trip; use b instead */
+	_ = utf8.Valid([]byte(string(b)))
+
+	/*! []byte(string(b)) is a redundant conversion round trip; use b instead */
+	_, _ = w.Write([]byte(string(b)))
+}
@@ -40,3 +40,11 @@ func noWarnings() {
 		)
 	}
 }
+
+func allowedIdents(ID int, URL string) {
+	DB, IP := 1, "127.0.0.1"
+	_, _ = DB, IP
+	for API := range []int{} {
+		_ = API
+	}
+}
@@ -53,3 +53,33 @@ func localBody() {
 		)
 	}
 }
+
+func rangeVars(xs []int) int {
+	total := 0
+	/*! `I' should not be capitalized */
+	/*! `Elem' should not be capitalized */
+	for I, Elem := range xs {
+		total += I * Elem
+	}
+	return total
+}
+
+func funcLitVars() func(int) int {
+	/*! `Scale' should not be capitalized */
+	return func(Scale int) int {
+		/*! `Result' should not be capitalized */
+		Result := Scale * 2
+		return Result
+	}
+}
+
+/*! `Name' should not be capitalized */
+func singleRename(Name string) string {
+	return "hello, " + Name
+}
+
+/*! `Len' should not be capitalized */
+func collidingRename(Len int) int {
+	len := 0
+	return len + Len
+}
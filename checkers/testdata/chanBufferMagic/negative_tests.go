@@ -0,0 +1,53 @@
+package checker_test
+
+const maxWorkers = 64
+
+type config struct {
+	queueSize int
+}
+
+type task struct{}
+
+func constSize() chan int {
+	return make(chan int, maxWorkers*2)
+}
+
+func lenSize(items []string) chan string {
+	ch := make(chan string, len(items))
+	for _, item := range items {
+		ch <- item
+	}
+	close(ch)
+	return ch
+}
+
+func boundedSize(n int) chan task {
+	if n > maxWorkers {
+		n = maxWorkers
+	}
+	return make(chan task, n)
+}
+
+func configSize(cfg config) chan task {
+	return make(chan task, cfg.queueSize)
+}
+
+func localSize() chan int {
+	n := 10
+	return make(chan int, n)
+}
+
+func usedForSends() {
+	results := make(chan int, 1)
+	go func() {
+		results <- 1
+		close(results)
+	}()
+	<-results
+}
+
+func unbufferedDone() {
+	done := make(chan struct{})
+	go close(done)
+	<-done
+}
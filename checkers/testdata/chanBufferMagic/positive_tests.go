@@ -0,0 +1,41 @@
+package checker_test
+
+import (
+	"net/http"
+	"strconv"
+)
+
+type job struct{}
+
+func startWorkers(n int) chan job {
+	/*! channel buffer size n comes from unchecked input; bound it to avoid huge allocations */
+	jobs := make(chan job, n)
+	return jobs
+}
+
+func fromRequest(r *http.Request) (chan int, error) {
+	size, err := strconv.Atoi(r.FormValue("size"))
+	if err != nil {
+		return nil, err
+	}
+	/*! channel buffer size size * 2 comes from unchecked input; bound it to avoid huge allocations */
+	return make(chan int, size*2), nil
+}
+
+func checkedAfterMake(n int, xs []int) chan int {
+	/*! channel buffer size n + len(xs) comes from unchecked input; bound it to avoid huge allocations */
+	ch := make(chan int, n+len(xs))
+	if n > 100 {
+		return nil
+	}
+	return ch
+}
+
+func doneSignal() {
+	/*! done is only closed to signal completion, its buffer is unnecessary */
+	done := make(chan struct{}, 1)
+	go func() {
+		defer close(done)
+	}()
+	<-done
+}
@@ -0,0 +1,75 @@
+package checker_test
+
+import "sync"
+
+func closeAfterLoop(jobs []int) {
+	results := make(chan int, len(jobs))
+	for _, job := range jobs {
+		results <- job
+	}
+	close(results)
+}
+
+func closeEachChannel(chans []chan int) {
+	for _, ch := range chans {
+		close(ch)
+	}
+	for i := range chans {
+		close(chans[i])
+	}
+}
+
+func closeAndLeave(ticks <-chan int) {
+	done := make(chan struct{})
+	for {
+		select {
+		case <-ticks:
+			close(done)
+			return
+		case <-done:
+		}
+	}
+}
+
+func closeAndBreak(n int) {
+	done := make(chan struct{})
+	for i := 0; i < n; i++ {
+		if i == 5 {
+			close(done)
+			break
+		}
+	}
+}
+
+func closeLoopLocal(n int) {
+	for i := 0; i < n; i++ {
+		ch := make(chan int)
+		close(ch)
+	}
+}
+
+func closeReassigned(n int) {
+	var ch chan int
+	for i := 0; i < n; i++ {
+		ch = make(chan int)
+		close(ch)
+	}
+}
+
+func closeOnce(once *sync.Once, n int) {
+	ch := make(chan int)
+	for i := 0; i < n; i++ {
+		once.Do(func() { close(ch) })
+	}
+}
+
+func producer(out chan<- int) {
+	defer close(out)
+	out <- 1
+}
+
+func paramReplaced(ch chan int) chan int {
+	ch = make(chan int)
+	close(ch)
+	return ch
+}
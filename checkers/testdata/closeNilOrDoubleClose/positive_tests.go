@@ -0,0 +1,56 @@
+package checker_test
+
+func closeInLoop(jobs []int) {
+	results := make(chan int, len(jobs))
+	for _, job := range jobs {
+		results <- job
+		/*! close(results) is called on every loop iteration without reassigning results; closing it twice panics */
+		close(results)
+	}
+}
+
+func closeInCondition(n int) {
+	done := make(chan struct{})
+	for i := 0; i < n; i++ {
+		if i%2 == 0 {
+			/*! close(done) is called on every loop iteration without reassigning done; closing it twice panics */
+			close(done)
+		}
+	}
+}
+
+func deferCloseInLoop(items []string) {
+	ch := make(chan string)
+	for _, item := range items {
+		/*! close(ch) is deferred on every loop iteration, so ch is closed more than once on return; closing it twice panics */
+		defer close(ch)
+		ch <- item
+	}
+}
+
+func closeInSelect(ticks <-chan int) {
+	stop := make(chan struct{})
+	for {
+		select {
+		case <-ticks:
+			/*! close(stop) is called on every loop iteration without reassigning stop; closing it twice panics */
+			close(stop)
+		}
+	}
+}
+
+func consumer(ch chan int) {
+	for v := range ch {
+		_ = v
+	}
+	/*! ch parameter is closed by the function that didn't create it; leave closing to the channel owner */
+	close(ch)
+}
+
+func asyncConsumer(ch chan int) {
+	go func() {
+		/*! ch parameter is closed by the function that didn't create it; leave closing to the channel owner */
+		defer close(ch)
+		<-ch
+	}()
+}
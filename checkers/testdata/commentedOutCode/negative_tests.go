@@ -77,4 +77,23 @@ func permittedComments() {
 	// //line directives with omitted filenames lead to empty filenames
 
 	/* CINC/CINV/CNEG */
+
+	// usage: foo(bar)
+
+	// Example: printValue(x)
+
+	// e.g.: fmt.Println(x)
+
+	//nolint:errcheck
+
+	//export exportedFunc
+
+	// json:"name,omitempty"
+	// yaml:"name"
+
+	// `json:"id" db:"id"`
+
+	/*
+		import "os"
+	*/
 }
@@ -39,3 +39,21 @@ func multiLineCode() {
 	//rulebases.DELETE("/:id", deleteRsHandler)                         //delete a rulebase
 	//rulebases.PUT("/:setid", putRsHandler)                            //update a rulebase
 }
+
+func blockCode() {
+	/*! may want to remove commented-out code */
+	/*
+		func debugDump(x interface{}) {
+			fmt.Printf("%#v\n", x)
+		}
+	*/
+
+	/*! may want to remove commented-out code */
+	/*
+		var verbose = true
+	*/
+
+	/*! may want to remove commented-out code */
+	// fmt.Println("debug")
+	//go:generate stringer -type=Kind
+}
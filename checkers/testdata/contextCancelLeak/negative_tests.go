@@ -0,0 +1,74 @@
+package checker_test
+
+import (
+	"context"
+	"time"
+)
+
+func load(ctx context.Context) (int, error) { return 0, nil }
+
+func deferAfterErrorCheck(parent context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(parent, time.Second)
+	n, err := load(ctx)
+	if err != nil {
+		cancel()
+		return 0, err
+	}
+	defer cancel()
+	return n, nil
+}
+
+func deferInClosure(parent context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	defer func() {
+		cancel()
+	}()
+	_, _ = load(ctx)
+}
+
+func returnedCancel(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	return ctx, cancel
+}
+
+type worker struct {
+	stop context.CancelFunc
+}
+
+func storedCancel(w *worker, parent context.Context) context.Context {
+	ctx, cancel := context.WithCancel(parent)
+	w.stop = cancel
+	return ctx
+}
+
+func passedCancel(parent context.Context, register func(func())) context.Context {
+	ctx, cancel := context.WithCancel(parent)
+	register(cancel)
+	return ctx
+}
+
+func calledLater(parent context.Context, done chan struct{}) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		<-done
+		cancel()
+	}()
+	_, _ = load(ctx)
+}
+
+func reassignedInBranches(parent context.Context, timeout time.Duration) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	defer cancel()
+	_, _ = load(ctx)
+}
+
+func namedResults(parent context.Context) (ctx context.Context, cancel context.CancelFunc) {
+	ctx, cancel = context.WithCancel(parent)
+	return
+}
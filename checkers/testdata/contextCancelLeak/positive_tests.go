@@ -0,0 +1,29 @@
+package checker_test
+
+import (
+	"context"
+	"time"
+)
+
+func fetch(ctx context.Context) error { return nil }
+
+func silenced(ctx context.Context) error {
+	/*! cancel function cancel is never called, the context leaks; defer cancel() after creating it */
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	_ = cancel
+	return fetch(ctx)
+}
+
+func discarded(ctx context.Context) error {
+	/*! cancel function from context.WithCancel is discarded, the context resources are never released */
+	ctx, _ = context.WithCancel(ctx)
+	return fetch(ctx)
+}
+
+func overwritten(parent context.Context, deadline time.Time) error {
+	/*! cancel function cancel is never called, the context leaks; defer cancel() after creating it */
+	ctx, cancel := context.WithCancel(parent)
+	ctx, cancel = context.WithDeadline(ctx, deadline)
+	defer cancel()
+	return fetch(ctx)
+}
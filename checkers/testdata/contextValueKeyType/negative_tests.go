@@ -0,0 +1,33 @@
+package checker_test
+
+import (
+	"context"
+)
+
+type ctxKey string
+
+type userKey struct{}
+
+type keyPtr *int
+
+func customKeys(ctx context.Context) {
+	_ = context.WithValue(ctx, ctxKey("user"), 1)
+	_ = context.WithValue(ctx, userKey{}, 1)
+
+	const typedKey ctxKey = "typed"
+	_ = context.WithValue(ctx, typedKey, 1)
+
+	var p keyPtr
+	_ = context.WithValue(ctx, p, 1)
+}
+
+type fakeContext struct{}
+
+func (fakeContext) WithValue(ctx context.Context, key, val interface{}) context.Context {
+	return ctx
+}
+
+func notContextWithValue(ctx context.Context) {
+	var fake fakeContext
+	_ = fake.WithValue(ctx, "key", 1)
+}
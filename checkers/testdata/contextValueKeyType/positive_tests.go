@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"context"
+	"time"
+)
+
+func builtinKeys(ctx context.Context) {
+	/*! should not use string as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, "user", 1)
+
+	/*! should not use int as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, 10, 1)
+
+	key := "request-id"
+	/*! should not use string as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, key, 1)
+
+	var id int64
+	/*! should not use int64 as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, id, 1)
+
+	const strKey = "const-key"
+	/*! should not use string as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, strKey, 1)
+}
+
+func stdlibKeys(ctx context.Context) {
+	/*! should not use time.Duration as a context.WithValue key; define an unexported key type instead */
+	_ = context.WithValue(ctx, time.Second, 1)
+}
@@ -0,0 +1,96 @@
+package checker_test
+
+import "sync"
+
+type vec struct {
+	x, y int32
+}
+
+type bigVec struct {
+	x, y, z, w, v int64
+}
+
+type guarded struct {
+	mu sync.Mutex
+	n  int
+}
+
+type shape interface {
+	scale(v *vec) int32
+}
+
+type counter struct {
+	n int
+}
+
+func (c *counter) inc() { c.n++ }
+
+func (v vec) scaled(k int32) vec { return vec{v.x * k, v.y * k} }
+
+func assignField(v *vec) {
+	v.x = 1
+}
+
+func incField(v *vec) {
+	v.y++
+}
+
+func assignDeref(v *vec, other vec) {
+	*v = other
+}
+
+func pointerMethods(c *counter) int {
+	return c.n
+}
+
+func addrOfField(v *vec) *int32 {
+	return &v.x
+}
+
+func nilCheck(v *vec) int32 {
+	if v == nil {
+		return 0
+	}
+	return v.x
+}
+
+func comparedPointers(a, b *vec) bool {
+	return a == b
+}
+
+func storedPointer(v *vec) []*vec {
+	return []*vec{v}
+}
+
+func passedPointer(v *vec, f func(*vec)) int32 {
+	f(v)
+	return v.x
+}
+
+func tooBig(v *bigVec) int64 {
+	return v.x
+}
+
+func withMutex(g *guarded) int {
+	return g.n
+}
+
+func unusedParam(v *vec) {}
+
+func sliceParam(xs *[]int) int {
+	return len(*xs)
+}
+
+type square struct{}
+
+func (square) scale(v *vec) int32 {
+	return v.x * 2
+}
+
+type holder struct {
+	c counter
+}
+
+func fieldPointerMethod(h *holder) {
+	h.c.inc()
+}
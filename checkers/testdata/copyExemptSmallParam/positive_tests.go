@@ -0,0 +1,38 @@
+package checker_test
+
+type point struct {
+	x, y int32
+}
+
+type pair [2]int64
+
+func (p point) norm() int32 { return p.x*p.x + p.y*p.y }
+
+/*! a points to a small point (8 bytes) that is never modified; consider passing it by value */
+func distance(a *point, b point) int32 {
+	dx := a.x - b.x
+	dy := a.y - b.y
+	return dx*dx + dy*dy
+}
+
+/*! p points to a small pair (16 bytes) that is never modified; consider passing it by value */
+func sum(p *pair) int64 {
+	return p[0] + (*p)[1]
+}
+
+/*! n points to a small int (8 bytes) that is never modified; consider passing it by value */
+func double(n *int) int {
+	return *n * 2
+}
+
+/*! p points to a small point (8 bytes) that is never modified; consider passing it by value */
+func valueMethod(p *point) int32 {
+	return p.norm()
+}
+
+type canvas struct{}
+
+/*! p points to a small point (8 bytes) that is never modified; consider passing it by value */
+func (c *canvas) plot(p *point, values map[int32]int32) {
+	values[p.x] = p.y
+}
@@ -0,0 +1,71 @@
+package checker_test
+
+type settings struct {
+	flags   []string
+	labels  map[string]string
+	payload []byte
+	other   []int
+}
+
+func newSettings(flags []string) *settings {
+	return &settings{flags: flags, labels: map[string]string{}}
+}
+
+func (s *settings) Write(p []byte) {
+	s.payload = append(s.payload, p...)
+}
+
+func (s *settings) SetOther(v []int) {
+	s.other = v
+}
+
+// Flags are never modified after the construction.
+func (s *settings) Flags() []string { return s.flags }
+
+func (s *settings) Labels() map[string]string { return s.labels }
+
+func (s *settings) Payload() []byte { return s.payload }
+
+func (s *settings) Other() []int { return s.other }
+
+func (s *settings) Copy() []string {
+	out := make([]string, len(s.flags))
+	copy(out, s.flags)
+	return out
+}
+
+type hiddenList struct {
+	values []string
+}
+
+func (l *hiddenList) set(i int, v string) { l.values[i] = v }
+
+func (l *hiddenList) all() []string { return l.values }
+
+type lineBuffer struct {
+	lines []string
+}
+
+func (b *lineBuffer) Push(line string) { b.lines = append(b.lines, line) }
+
+func (b *lineBuffer) Lines() []string { return b.lines }
+
+type rowView struct {
+	cells []int
+}
+
+func (v *rowView) Set(i, x int) { v.cells[i] = x }
+
+func (v *rowView) Cells() []int { return v.cells }
+
+type lazyList struct {
+	values []int
+}
+
+func (l *lazyList) Add(x int) { l.values = append(l.values, x) }
+
+func (l *lazyList) Each(fn func([]int)) {
+	func() []int {
+		return l.values
+	}()
+}
@@ -0,0 +1,54 @@
+package checker_test
+
+import "sort"
+
+type item struct {
+	name string
+}
+
+type registry struct {
+	items  []item
+	byName map[string]item
+	names  []string
+}
+
+func (r *registry) Add(it item) {
+	r.items = append(r.items, it)
+	r.byName[it.name] = it
+}
+
+func (r *registry) Rename(i int, name string) {
+	r.names[i] = name
+	sort.Strings(r.names)
+}
+
+func (r *registry) Items() []item {
+	/*! Items returns internal field r.items that the package modifies, callers share it; return slices.Clone(r.items) or document the aliasing */
+	return r.items
+}
+
+func (r *registry) ByName() map[string]item {
+	/*! ByName returns internal field r.byName that the package modifies, callers share it; return maps.Clone(r.byName) or document the aliasing */
+	return r.byName
+}
+
+func (r registry) Names() ([]string, bool) {
+	if len(r.names) == 0 {
+		return nil, false
+	}
+	/*! Names returns internal field r.names that the package modifies, callers share it; return slices.Clone(r.names) or document the aliasing */
+	return r.names, true
+}
+
+type counters struct {
+	hits map[string]int
+}
+
+func (c *counters) Hit(key string) { c.hits[key]++ }
+
+func (c *counters) Reset(key string) { delete(c.hits, key) }
+
+func (c *counters) Hits() map[string]int {
+	/*! Hits returns internal field c.hits that the package modifies, callers share it; return maps.Clone(c.hits) or document the aliasing */
+	return (c.hits)
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func closureSeesLater() {
+	var err error
+	defer func() {
+		log.Println("finished with", err)
+	}()
+	err = run()
+	_ = err
+}
+
+func assignedBefore() {
+	err := run()
+	defer log.Println("result", err)
+}
+
+func pointerArg(report func(*int)) {
+	n := 0
+	defer report(&n)
+	n++
+}
+
+func handleErr(err *error) {}
+
+func namedResultPointer() (err error) {
+	defer handleErr(&err)
+	return run()
+}
+
+func receiverOnly(f *os.File) {
+	defer f.Close()
+	f = nil
+	_ = f
+}
+
+func bareReturn() (err error) {
+	defer log.Println(err)
+	return
+}
@@ -0,0 +1,54 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func run() error { return nil }
+
+func finishedWith() {
+	var err error
+	/*! deferred log.Println evaluates err at defer time, so it doesn't see the later assignments; call it from a deferred closure instead */
+	defer log.Println("finished with", err)
+	err = run()
+	_ = err
+}
+
+func namedResult() (err error) {
+	/*! deferred log.Printf evaluates err at defer time, so it doesn't see the later assignments; call it from a deferred closure instead */
+	defer log.Printf("done: %v", err)
+	return run()
+}
+
+func counter(report func(int)) {
+	n := 0
+	/*! deferred report evaluates n at defer time, so it doesn't see the later assignments; call it from a deferred closure instead */
+	defer report(n)
+	for i := 0; i < 10; i++ {
+		n++
+	}
+}
+
+func reportStatus(status string) {}
+
+func insideClosure() {
+	func() {
+		status := "started"
+		/*! deferred reportStatus evaluates status at defer time, so it doesn't see the later assignments; call it from a deferred closure instead */
+		defer reportStatus(status)
+		status = "finished"
+		_ = status
+	}()
+}
+
+func loopVars(files []string) {
+	for _, name := range files {
+		/*! deferred log.Println captures loop variable name at defer time; use a deferred closure if the final value is meant */
+		defer log.Println(name)
+	}
+	for i := 0; i < 3; i++ {
+		/*! deferred os.Remove captures loop variable i at defer time; use a deferred closure if the final value is meant */
+		defer os.Remove(files[i])
+	}
+}
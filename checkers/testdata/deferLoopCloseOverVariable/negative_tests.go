@@ -0,0 +1,58 @@
+package checker_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+func closePerIteration(files []string) {
+	for _, name := range files {
+		f, _ := os.Open(name)
+		defer f.Close()
+		defer func() { f.Close() }()
+	}
+}
+
+func closeEvaluatedNow(files []string) {
+	var f *os.File
+	for _, name := range files {
+		f, _ = os.Open(name)
+		// The receiver is evaluated at defer time.
+		defer f.Close()
+	}
+}
+
+func closeArgument(files []string) {
+	var f *os.File
+	for _, name := range files {
+		f, _ = os.Open(name)
+		defer func(f *os.File) { f.Close() }(f)
+	}
+}
+
+func notReassigned(w *bufio.Writer, files []string) {
+	var log bufio.Writer
+	for range files {
+		defer func() { w.Flush() }()
+		defer log.Flush()
+	}
+}
+
+func deferOutsideLoop(outputs []io.Writer) {
+	var w *bufio.Writer
+	for _, out := range outputs {
+		w = bufio.NewWriter(out)
+	}
+	defer func() { w.Flush() }()
+}
+
+func closureInLoop(files []string) {
+	var f *os.File
+	for _, name := range files {
+		f, _ = os.Open(name)
+		func() {
+			defer f.Close()
+		}()
+	}
+}
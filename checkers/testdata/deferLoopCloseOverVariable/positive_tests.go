@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+func closeReused(files []string) error {
+	var f *os.File
+	var err error
+	for _, name := range files {
+		f, err = os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			/*! deferred closure calls f.Close on f that is declared outside the loop and reassigned in it, so all deferred calls act on its final value; pass f as the closure argument */
+			_ = f.Close()
+		}()
+	}
+	return nil
+}
+
+func closeReusedNested(groups [][]string) {
+	var rc io.ReadCloser
+	for _, files := range groups {
+		for _, name := range files {
+			rc, _ = os.Open(name)
+			/*! deferred closure calls rc.Close on rc that is declared outside the loop and reassigned in it, so all deferred calls act on its final value; pass rc as the closure argument */
+			defer func() { rc.Close() }()
+		}
+	}
+}
+
+func flushReused(outputs []io.Writer) {
+	var w bufio.Writer
+	for i := 0; i < len(outputs); i++ {
+		w = *bufio.NewWriter(outputs[i])
+		/*! deferred w.Flush takes the address of w that is declared outside the loop and reassigned in it, so all deferred calls act on its final value */
+		defer w.Flush()
+	}
+}
+
+func rangeAssign(files []*os.File) {
+	var f *os.File
+	for _, f = range files {
+		/*! deferred closure calls f.Close on f that is declared outside the loop and reassigned in it, so all deferred calls act on its final value; pass f as the closure argument */
+		defer func() { f.Close() }()
+	}
+}
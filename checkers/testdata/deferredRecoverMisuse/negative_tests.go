@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"errors"
+	"log"
+)
+
+func deferredLit() {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("recovered: %v", r)
+		}
+	}()
+}
+
+func recoverToError() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New("panic")
+		}
+	}()
+	return nil
+}
+
+// handlePanic is deferred by its callers.
+func handlePanic() {
+	if r := recover(); r != nil {
+		log.Println(r)
+	}
+}
+
+func deferNamed() {
+	defer handlePanic()
+}
+
+func ignoredWithCleanup(done chan struct{}) {
+	defer func() {
+		recover()
+		close(done)
+	}()
+}
+
+func passedToLog() {
+	defer func() {
+		log.Println(recover())
+	}()
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import "log"
+
+func deferRecover() {
+	/*! defer recover() doesn't stop panics; call recover inside a deferred function */
+	defer recover()
+	panic("boom")
+}
+
+func recoverInGoroutine() {
+	go func() {
+		/*! recover() always returns nil outside of deferred functions */
+		if r := recover(); r != nil {
+			log.Println(r)
+		}
+	}()
+}
+
+func recoverInCalledLit() {
+	defer func() {
+		func() {
+			/*! recover() always returns nil outside of deferred functions */
+			recover()
+		}()
+	}()
+	check := func() {
+		/*! recover() always returns nil outside of deferred functions */
+		_ = recover()
+	}
+	check()
+}
+
+func swallowPanics() {
+	/*! deferred function swallows panics silently; handle or log the recovered value */
+	defer func() {
+		recover()
+	}()
+	panic("ignored")
+}
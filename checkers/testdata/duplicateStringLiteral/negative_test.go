@@ -0,0 +1,5 @@
+package checker_test_test
+
+func testLiterals() []string {
+	return []string{"test literal", "test literal", "test literal", "test literal"}
+}
@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"net/http"
+)
+
+const methodOptions = "OPTIONS"
+
+type request struct {
+	Method string `json:"method,omitempty"`
+	Path   string `json:"method,omitempty"`
+	Body   string `json:"method,omitempty"`
+	Query  string `json:"method,omitempty"`
+}
+
+func shortStrings() []string {
+	return []string{"short", "short", "short", "short", "short"}
+}
+
+func options(req *http.Request) bool {
+	return req.Method == "OPTIONS" || req.Method == "OPTIONS" ||
+		req.Method == "OPTIONS" || req.Method == "OPTIONS"
+}
+
+func threeTimes() []string {
+	return []string{"repeated thrice", "repeated thrice", "repeated thrice"}
+}
+
+func mapKeys() []int {
+	limits := map[string]int{
+		"max connections": 10,
+		"max requests":    100,
+	}
+	return []int{
+		limits["max connections"], limits["max connections"],
+		limits["max connections"], limits["max connections"],
+	}
+}
+
+func names() []string {
+	return []string{
+		"experimental", "experimental", "experimental", "experimental",
+		"fmt.Sprintf", "fmt.Sprintf", "fmt.Sprintf", "fmt.Sprintf",
+		"net/http", "net/http", "net/http", "net/http",
+	}
+}
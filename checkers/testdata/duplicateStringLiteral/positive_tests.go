@@ -0,0 +1,11 @@
+package checker_test
+
+import "net/http"
+
+func setHeaders(req *http.Request) {
+	/*! string literal "application/json" is repeated 6 times in the package (also at positive_tests.go:8, positive_tests.go:9, positive_tests.go:10); consider a named constant */
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	_ = "application/json"
+	_ = `application/json`
+}
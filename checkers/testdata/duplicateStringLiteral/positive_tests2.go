@@ -0,0 +1,26 @@
+package checker_test
+
+import "net/http"
+
+func contentType(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", "application/json")
+}
+
+func statuses() []string {
+	/*! string literal "service unavailable" is repeated 4 times in the package (also at positive_tests2.go:13, positive_tests2.go:14, positive_tests2.go:15); consider a named constant */
+	return []string{"service unavailable",
+		"service unavailable",
+		"service unavailable",
+		"service unavailable"}
+}
+
+func mapValues() map[string]string {
+	return map[string]string{
+		/*! string literal "not configured" is repeated 4 times in the package (also at positive_tests2.go:22, positive_tests2.go:23, positive_tests2.go:24); consider a named constant */
+		"host": "not configured",
+		"port": "not configured",
+		"user": "not configured",
+		"pass": "not configured",
+	}
+}
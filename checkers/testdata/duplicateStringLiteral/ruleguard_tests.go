@@ -0,0 +1,13 @@
+package checker_test
+
+import (
+	"github.com/quasilyte/go-ruleguard/dsl"
+)
+
+func sprintRules(m dsl.Matcher) {
+	m.Match(`fmt.Sprint($x)`).Report(`use strconv for the simple conversions`)
+	m.Match(`fmt.Sprintf("%d", $x)`).Report(`use strconv for the simple conversions`)
+	m.Match(`fmt.Sprintf("%s", $x)`).Report(`use strconv for the simple conversions`)
+	m.Match(`fmt.Sprintf("%v", $x)`).Report(`use strconv for the simple conversions`)
+	m.Match(`fmt.Sprintf("%x", $x)`).Report(`use strconv for the simple conversions`)
+}
@@ -0,0 +1,34 @@
+package checker_test
+
+import (
+	"time"
+)
+
+const timeoutMs = 100
+
+func matchingUnits(timeoutSeconds int, delayMs int64, cfg config, items int, status int) {
+	_ = time.Duration(timeoutSeconds) * time.Second
+	_ = time.Millisecond * time.Duration(delayMs)
+	_ = time.Duration(cfg.pollMinutes) * time.Minute
+	_ = time.Duration(items) * time.Second
+	_ = time.Duration(status) * time.Microsecond
+	_ = 5 * time.Second
+	_ = timeoutMs * time.Second
+	_ = time.Duration(timeoutSeconds) * 2
+}
+
+func unitConversions(seconds, millis int) {
+	_ = time.Duration(seconds*1000) * time.Millisecond
+	_ = time.Duration(seconds * 1e9)
+	_ = time.Duration(millis * 2)
+	_ = time.Duration(1000 * 1000)
+}
+
+func smallLiterals() {
+	time.Sleep(1000)
+	time.Sleep(0)
+	time.Sleep(5000 * time.Millisecond)
+	takesInt(5000)
+}
+
+func takesInt(n int) {}
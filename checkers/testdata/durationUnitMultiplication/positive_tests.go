@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"context"
+	"time"
+)
+
+type config struct {
+	TimeoutMs   int
+	retry_secs  int64
+	pollMinutes int
+}
+
+func mismatchedUnits(timeoutMs int, delayNanos int64, cfg config) {
+	/*! timeoutMs is multiplied by time.Second, but its name suggests it's in milliseconds */
+	_ = time.Duration(timeoutMs) * time.Second
+
+	/*! delayNanos is multiplied by time.Millisecond, but its name suggests it's in nanoseconds */
+	_ = time.Millisecond * time.Duration(delayNanos)
+
+	/*! cfg.TimeoutMs is multiplied by time.Second, but its name suggests it's in milliseconds */
+	_ = time.Duration(cfg.TimeoutMs) * time.Second
+
+	/*! cfg.retry_secs is multiplied by time.Millisecond, but its name suggests it's in seconds */
+	_ = time.Duration(cfg.retry_secs) * time.Millisecond
+
+	intervalMillis := time.Duration(10)
+	/*! intervalMillis is multiplied by time.Second, but its name suggests it's in milliseconds */
+	_ = intervalMillis * time.Second
+}
+
+func conversions(seconds, minutes int) {
+	/*! time.Duration(seconds * 1000) is measured in nanoseconds; multiply by a time unit constant instead of 1000 */
+	_ = time.Duration(seconds * 1000)
+
+	/*! time.Duration(1e6 * minutes) is measured in nanoseconds; multiply by a time unit constant instead of 1e6 */
+	_ = time.Duration(1e6 * minutes)
+}
+
+func bareLiterals(ctx context.Context) {
+	/*! 5000 is interpreted as 5000 nanoseconds; use an explicit unit like 5000 * time.Millisecond */
+	time.Sleep(5000)
+
+	/*! 30000 is interpreted as 30000 nanoseconds; use an explicit unit like 30000 * time.Millisecond */
+	_, _ = context.WithTimeout(ctx, 30000)
+
+	/*! 1500 is interpreted as 1500 nanoseconds; use an explicit unit like 1500 * time.Millisecond */
+	waitAll(1, 1500)
+}
+
+func waitAll(n int, durations ...time.Duration) {}
@@ -0,0 +1,39 @@
+//go:build go1.16
+
+package checker_test
+
+import "embed"
+
+//go:embed assets.txt
+var asset string
+
+//go:embed assets.txt
+var assetBytes []byte
+
+//go:embed assets.txt
+var assets embed.FS
+
+// Blank lines and line comments are permitted.
+//go:embed assets.txt
+
+// The asset is loaded at build time.
+var separatedAsset string
+
+var (
+	//go:embed assets.txt
+	groupedAsset string
+
+	//go:embed assets.txt
+	groupedFS embed.FS
+)
+
+type assetText string
+
+//go:embed assets.txt
+var namedText assetText
+
+// go:embed with a space is a regular comment.
+var notDirective = 1
+
+//go:embedded is not a directive.
+var notEmbed = 2
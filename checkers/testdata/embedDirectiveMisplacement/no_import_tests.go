@@ -0,0 +1,7 @@
+//go:build go1.16
+
+package checker_test
+
+/*! go:embed directive requires importing "embed"; add import _ "embed" */
+//go:embed assets.txt
+var noImport string
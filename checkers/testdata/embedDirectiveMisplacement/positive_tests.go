@@ -0,0 +1,42 @@
+//go:build go1.16
+
+package checker_test
+
+import _ "embed"
+
+// The misplaced go:embed directives are compile errors,
+// so this package is checked with the load errors ignored.
+
+/*! go:embed directive is not followed by a single var declaration */
+//go:embed assets.txt
+/* block comment */
+var afterBlockComment string
+
+func localEmbed() {
+	/*! go:embed directive can only be applied to the package-level variables */
+	//go:embed assets.txt
+	var local string
+	_ = local
+}
+
+/*! go:embed directive can't be applied to several variables first, second */
+//go:embed assets.txt
+var first, second string
+
+/*! go:embed directive can't be applied to the initialized variable initialized */
+//go:embed assets.txt
+var initialized = "default"
+
+/*! go:embed directive can't be applied to lines of type []string; use string, []byte or embed.FS */
+//go:embed assets.txt
+var lines []string
+
+/*! go:embed directive is not followed by a single var declaration */
+//go:embed assets.txt
+func notVar() {}
+
+const (
+	/*! go:embed directive is not followed by a single var declaration */
+	//go:embed assets.txt
+	constAsset = "x"
+)
@@ -0,0 +1,73 @@
+package checker_test
+
+var flag chan bool
+
+func valueUsed() bool {
+	return <-flag
+}
+
+// Exported channels can be used outside of the package.
+var Done = make(chan bool)
+
+type Server struct {
+	Stopped chan bool
+}
+
+func sendNonConst(ok bool) {
+	results := make(chan bool)
+	results <- ok
+
+	values := make(chan interface{})
+	values <- 10
+	values <- "x"
+	values <- ok
+}
+
+func receivedValue() {
+	ch := make(chan bool)
+	if v := <-ch; v {
+		println(v)
+	}
+
+	ch2 := make(chan bool)
+	for v := range ch2 {
+		println(v)
+	}
+
+	ch3 := make(chan bool)
+	select {
+	case v, ok := <-ch3:
+		println(v, ok)
+	}
+}
+
+func escapes() {
+	ch := make(chan bool)
+	passChan(ch)
+
+	ch2 := make(chan bool)
+	alias := ch2
+	<-alias
+}
+
+func passChan(ch chan bool) {
+	ch <- true
+}
+
+func param(ch chan bool) {
+	<-ch
+}
+
+func alreadyStruct() {
+	ch := make(chan struct{})
+	ch <- struct{}{}
+	<-ch
+
+	ints := make(chan int)
+	ints <- 1
+	<-ints
+}
+
+func directional(ch <-chan bool) {
+	<-ch
+}
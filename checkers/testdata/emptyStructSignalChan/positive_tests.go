@@ -0,0 +1,51 @@
+package checker_test
+
+type worker struct {
+	/*! quit is only used for signaling; use chan struct{} instead of chan bool */
+	quit chan bool
+	/*! ready is only used for signaling; use chan struct{} instead of chan interface{} */
+	ready chan interface{}
+}
+
+func newWorker() *worker {
+	w := &worker{ready: make(chan interface{}, 1)}
+	w.quit = make(chan bool)
+	return w
+}
+
+func (w *worker) run() {
+	w.ready <- struct{}{}
+	for {
+		select {
+		case <-w.quit:
+			return
+		default:
+		}
+	}
+}
+
+func (w *worker) stop() {
+	w.quit <- true
+	close(w.ready)
+}
+
+/*! started is only used for signaling; use chan struct{} instead of chan bool */
+var started chan bool
+
+func localSignal() {
+	started = make(chan bool, 1)
+
+	/*! done is only used for signaling; use chan struct{} instead of chan bool */
+	done := make(chan bool)
+	go func() {
+		started <- true
+		done <- true
+	}()
+	<-done
+	_ = <-started
+	for range done {
+	}
+
+	// Uses from negative_tests.go file disqualify the flag channel.
+	flag <- true
+}
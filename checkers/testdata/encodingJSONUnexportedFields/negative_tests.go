@@ -0,0 +1,60 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+type publicConfig struct {
+	Name string
+	port int
+}
+
+type innerFields struct {
+	Name string
+}
+
+type promotedFields struct {
+	innerFields
+	secret string
+}
+
+type customJSON struct {
+	name string
+}
+
+func (c customJSON) MarshalJSON() ([]byte, error) { return json.Marshal(c.name) }
+
+type customText struct {
+	name string
+}
+
+func (c *customText) UnmarshalText(data []byte) error {
+	c.name = string(data)
+	return nil
+}
+
+type emptyValue struct{}
+
+type hiddenCount int
+
+func marshalVisible(data []byte) {
+	_, _ = json.Marshal(publicConfig{Name: "api"})
+	_, _ = json.Marshal(&promotedFields{})
+	_, _ = json.Marshal(customJSON{})
+	_ = json.Unmarshal(data, &customText{})
+	_, _ = xml.Marshal(&customText{})
+	_, _ = json.Marshal(emptyValue{})
+	_, _ = json.Marshal(hiddenCount(1))
+	_, _ = json.Marshal(time.Now())
+	_, _ = json.Marshal(map[string]int{})
+	_, _ = json.Marshal(struct{ name string }{})
+}
+
+type taggedExported struct {
+	ID      int    `json:"id"`
+	private string `json:"-"`
+	cache   string `yaml:"cache"`
+	innerFields `json:"inner"`
+}
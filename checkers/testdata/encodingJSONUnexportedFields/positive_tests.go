@@ -0,0 +1,55 @@
+package checker_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"sync"
+)
+
+type hiddenConfig struct {
+	name string
+	port int
+}
+
+type hiddenWrapper struct {
+	hiddenConfig
+	mu sync.Mutex
+}
+
+func marshalHidden(w io.Writer, data []byte) {
+	/*! hiddenConfig has no exported fields, json.Marshal ignores all of them; export the fields or implement MarshalJSON */
+	_, _ = json.Marshal(hiddenConfig{name: "api", port: 80})
+
+	var cfg hiddenConfig
+	/*! hiddenConfig has no exported fields, json.Unmarshal ignores all of them; export the fields or implement UnmarshalJSON */
+	_ = json.Unmarshal(data, &cfg)
+
+	/*! hiddenConfig has no exported fields, json.Encoder.Encode ignores all of them; export the fields or implement MarshalJSON */
+	_ = json.NewEncoder(w).Encode(&cfg)
+
+	/*! hiddenWrapper has no exported fields, json.MarshalIndent ignores all of them; export the fields or implement MarshalJSON */
+	_, _ = json.MarshalIndent(&hiddenWrapper{}, "", "  ")
+
+	/*! hiddenConfig has no exported fields, xml.Marshal ignores all of them; export the fields or implement MarshalXML */
+	_, _ = xml.Marshal(cfg)
+
+	/*! sync.WaitGroup has no exported fields, json.Marshal ignores all of them; export the fields or implement MarshalJSON */
+	_, _ = json.Marshal(&sync.WaitGroup{})
+
+	var buf bytes.Buffer
+	/*! hiddenConfig has no exported fields, gob.Encoder.Encode ignores all of them; export the fields or implement GobEncode */
+	_ = gob.NewEncoder(&buf).Encode(cfg)
+}
+
+type taggedRecord struct {
+	ID int `json:"id"`
+
+	/*! json tag of unexported field secret is ignored, encoding/json only uses exported fields */
+	secret string `json:"secret"`
+
+	/*! json tag of unexported field x is ignored, encoding/json only uses exported fields */
+	x, Y int `json:"point,omitempty"`
+}
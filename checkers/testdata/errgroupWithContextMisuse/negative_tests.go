@@ -0,0 +1,74 @@
+package checker_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-critic/go-critic/checkers/testdata/_importable/errgroup"
+)
+
+func derivedContext(ctx context.Context, urls []string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	for _, url := range urls {
+		url := url
+		g.Go(func() error { return fetch(gctx, url) })
+	}
+	return g.Wait()
+}
+
+func replacedContext(ctx context.Context) error {
+	var g *errgroup.Group
+	g, ctx = errgroup.WithContext(ctx)
+	g.Go(func() error { return fetch(ctx, "a") })
+	return g.Wait()
+}
+
+func plainGroup(ctx context.Context) error {
+	var g errgroup.Group
+	g.Go(func() error { return fetch(ctx, "a") })
+	return g.Wait()
+}
+
+func reusedGroup(ctx context.Context) error {
+	var g errgroup.Group
+	g.Go(func() error { return fetch(ctx, "a") })
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	g.Go(func() error { return fetch(ctx, "b") })
+	return g.Wait()
+}
+
+func conditionalWait(ctx context.Context, early bool) error {
+	var g errgroup.Group
+	if early {
+		return g.Wait()
+	}
+	g.Go(func() error { return fetch(ctx, "a") })
+	return g.Wait()
+}
+
+func otherGroups(ctx context.Context, urls []string) {
+	var g1, g2 errgroup.Group
+	_ = g1.Wait()
+	g2.Go(func() error { return fetch(ctx, "a") })
+	_ = g2.Wait()
+
+	var wg sync.WaitGroup
+	wg.Wait()
+	for _, url := range urls {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			_ = fetch(ctx, url)
+		}(url)
+	}
+}
+
+func methodValue(ctx context.Context, jobs []func() error) error {
+	g, _ := errgroup.WithContext(ctx)
+	for _, job := range jobs {
+		g.Go(job)
+	}
+	return g.Wait()
+}
@@ -0,0 +1,69 @@
+package checker_test
+
+import (
+	"context"
+
+	"github.com/go-critic/go-critic/checkers/testdata/_importable/errgroup"
+)
+
+func fetch(ctx context.Context, url string) error { return nil }
+
+func originalContext(ctx context.Context, urls []string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	_ = gctx
+	g.Go(func() error {
+		/*! g.Go closure uses ctx instead of the derived context gctx, so it isn't canceled when another goroutine fails */
+		if err := fetch(ctx, urls[0]); err != nil {
+			return err
+		}
+		return fetch(ctx, urls[1])
+	})
+	return g.Wait()
+}
+
+func discardedContext(ctx context.Context) error {
+	g, _ := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		/*! g.Go closure uses ctx instead of the context derived by errgroup.WithContext, so it isn't canceled when another goroutine fails */
+		return fetch(ctx, "a")
+	})
+	return g.Wait()
+}
+
+func goAfterWait(ctx context.Context) error {
+	var g errgroup.Group
+	g.Go(func() error { return fetch(ctx, "a") })
+	err := g.Wait()
+	/*! g.Go is called after g.Wait at line 36 returned, so this goroutine is never awaited */
+	g.Go(func() error { return fetch(ctx, "b") })
+	return err
+}
+
+func goAfterWaitInLoop(ctx context.Context, urls []string) {
+	g := &errgroup.Group{}
+	if err := g.Wait(); err != nil {
+		return
+	}
+	for i := range urls {
+		/*! g.TryGo is called after g.Wait at line 44 returned, so this goroutine is never awaited */
+		/*! g.TryGo closure captures loop variable i that is reused by all iterations; copy it with i := i before the call */
+		g.TryGo(func() error { return fetch(ctx, urls[i]) })
+	}
+}
+
+func loopCapture(ctx context.Context, urls []string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	for _, url := range urls {
+		g.Go(func() error {
+			/*! g.Go closure captures loop variable url that is reused by all iterations; copy it with url := url before the call */
+			return fetch(gctx, url)
+		})
+	}
+	for i := 0; i < len(urls); i++ {
+		g.Go(func() error {
+			/*! g.Go closure captures loop variable i that is reused by all iterations; copy it with i := i before the call */
+			return fetch(gctx, urls[i])
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,66 @@
+package checker_test
+
+func validateReassigned(name string) error {
+	var err *validationError
+	if name == "" {
+		err = &validationError{field: "name"}
+	}
+	return err
+}
+
+func validateConcreteResult() *validationError {
+	var err *validationError
+	return err
+}
+
+func validateParam(err *validationError) error {
+	return err
+}
+
+var globalValidationErr *validationError
+
+func validateGlobal() error {
+	return globalValidationErr
+}
+
+func validateAddressTaken(fill func(**validationError)) error {
+	var err *validationError
+	fill(&err)
+	return err
+}
+
+func validateMultiAssign(check func() (*validationError, bool)) error {
+	err, ok := check()
+	if !ok {
+		return nil
+	}
+	return err
+}
+
+func validateRange(errs []*validationError) error {
+	var err *validationError
+	for _, err = range errs {
+	}
+	return err
+}
+
+func validateTypeSwitch(x interface{}) error {
+	switch err := x.(type) {
+	case *validationError:
+		return err
+	}
+	return nil
+}
+
+func validateValueType() error {
+	var err valueError
+	return err
+}
+
+type valueError struct{}
+
+func (valueError) Error() string { return "" }
+
+func validateNil() error {
+	return nil
+}
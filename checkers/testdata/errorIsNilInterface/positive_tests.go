@@ -0,0 +1,29 @@
+package checker_test
+
+type validationError struct{ field string }
+
+func (e *validationError) Error() string { return e.field }
+
+func validateDeclared() error {
+	var err *validationError
+	/*! err is a nil *validationError, returning it as error makes a non-nil error interface that holds a nil pointer; return nil instead */
+	return err
+}
+
+func validateAssignedNil(name string) (int, error) {
+	var verr *validationError = nil
+	if name == "" {
+		verr = nil
+		return 0, nil
+	}
+	/*! verr is a nil *validationError, returning it as error makes a non-nil error interface that holds a nil pointer; return nil instead */
+	return len(name), (verr)
+}
+
+func validateInClosure() func() error {
+	var err *validationError
+	return func() error {
+		/*! err is a nil *validationError, returning it as error makes a non-nil error interface that holds a nil pointer; return nil instead */
+		return err
+	}
+}
@@ -0,0 +1,68 @@
+package checker_test
+
+import (
+	"log"
+)
+
+func nonZeroSuccess(s string) (int, error) {
+	n, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func namedWithDefer(x int) (err error) {
+	defer func() {
+		if err != nil {
+			log.Println(err)
+		}
+	}()
+	err = validate(x)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func withComment(x int) error {
+	err := validate(x)
+	if err != nil {
+		return err
+	}
+	// Success.
+	return nil
+}
+
+func wrapped(x int) error {
+	err := validate(x)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+func notLast(x int) error {
+	err := validate(x)
+	if err != nil {
+		return err
+	}
+	log.Println("ok")
+	return nil
+}
+
+func differentReturn(x int) error {
+	err := validate(x)
+	if err != nil {
+		return err
+	}
+	return validate(x + 1)
+}
+
+func initMultiValue(s string) (int, error) {
+	if _, err := parse(s); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
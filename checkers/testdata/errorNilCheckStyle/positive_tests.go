@@ -0,0 +1,42 @@
+package checker_test
+
+func validate(x int) error { return nil }
+
+func parse(s string) (int, error) { return 0, nil }
+
+func simple(x int) error {
+	err := validate(x)
+	/*! redundant error check before the final return; use `return err` */
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func withZeroValues(x int) (int, string, error) {
+	err := validate(x)
+	/*! redundant error check before the final return; use `return 0, "", err` */
+	if err != nil {
+		return 0, "", err
+	}
+	return 0, "", nil
+}
+
+func withInit(x int) error {
+	/*! redundant error check of validate(x) before the final return; use `return validate(x)` */
+	if err := validate(x); err != nil {
+		return err
+	}
+	return nil
+}
+
+type result struct{}
+
+func withPointer(x int) (*result, error) {
+	_, err := parse("")
+	/*! redundant error check before the final return; use `return nil, err` */
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,25 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+type ConfigLoader struct{}
+
+func errorStringsGood(name string, err error) {
+	_ = errors.New("connection refused")
+	_ = fmt.Errorf("failed to open %s", name)
+	_ = fmt.Errorf("%s: Failed", name)
+	_ = errors.New("HTTP request failed")
+	_ = errors.New("EOF")
+	_ = errors.New("GetUser failed")
+	_ = errors.New("ConfigLoader is not initialized")
+	_ = errors.New("loading...")
+	_ = fmt.Errorf("open %s: %w", name, err)
+	_ = fmt.Errorf("read %q", name)
+
+	msg := "Dynamic message."
+	_ = errors.New(msg)
+	_ = fmt.Sprintf("Not an error.")
+}
@@ -0,0 +1,36 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+func errorStrings(name string) {
+	/*! error string "Connection refused" should not be capitalized */
+	_ = errors.New("Connection refused")
+
+	/*! error string "Failed to open %s" should not be capitalized */
+	_ = fmt.Errorf("Failed to open %s", name)
+
+	/*! error string "bad input." should not end with punctuation */
+	_ = errors.New("bad input.")
+
+	/*! error string "bad input: %s!" should not end with punctuation */
+	_ = fmt.Errorf("bad input: %s!", name)
+
+	/*! error string "bad input\n" should not end with a newline */
+	_ = errors.New("bad input\n")
+
+	/*! error string "Invalid value:" should not be capitalized */
+	/*! error string "Invalid value:" should not end with punctuation */
+	_ = fmt.Errorf("Invalid value:")
+
+	/*! error string "read failed. Try again" has several sentences; use a single lowercase phrase */
+	_ = errors.New("read failed. Try again")
+
+	/*! error string `Raw string` should not be capitalized */
+	_ = errors.New(`Raw string`)
+
+	/*! error string "Ünicode name" should not be capitalized */
+	_ = errors.New("Ünicode name")
+}
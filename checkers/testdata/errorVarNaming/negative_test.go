@@ -0,0 +1,9 @@
+package checker_test_test
+
+import "errors"
+
+var testFailure = errors.New("test failure")
+
+type fakeErr struct{}
+
+func (fakeErr) Error() string { return "fake" }
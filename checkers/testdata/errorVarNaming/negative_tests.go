@@ -0,0 +1,41 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrExists = errors.New("exists")
+
+var errBusy = fmt.Errorf("busy")
+
+var ErrorUnknown = errors.New("unknown")
+
+var _ = errors.New("ignored")
+
+var lastErr error
+
+var wrapped = fmt.Sprintf("not an error")
+
+var errS, otherS = "a", "b"
+
+func localErrors() {
+	notFound := errors.New("not found")
+	var failed = errors.New("failed")
+	_, _ = notFound, failed
+}
+
+type SyntaxError struct{}
+
+func (SyntaxError) Error() string { return "syntax error" }
+
+type Temporary interface {
+	error
+	Temporary() bool
+}
+
+type NotAnError struct{}
+
+func (NotAnError) String() string { return "" }
+
+type SyntaxErrorAlias = SyntaxError
@@ -0,0 +1,27 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*! sentinel error NotFound should be named ErrNotFound */
+var NotFound = errors.New("not found")
+
+var (
+	/*! sentinel error timeout should be named errTimeout */
+	timeout = fmt.Errorf("timeout after %d seconds", 10)
+
+	/*! sentinel error closed should be named errClosed */
+	closed error = errors.New("closed")
+)
+
+/*! error type Parse should be named ParseError */
+type Parse struct{}
+
+func (Parse) Error() string { return "parse failed" }
+
+/*! error type validation should be named validationError */
+type validation struct{ field string }
+
+func (v *validation) Error() string { return v.field + " is invalid" }
@@ -0,0 +1,73 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func combineWrapped(err1, err2 error) error {
+	return fmt.Errorf("%w; %w", err1, err2)
+}
+
+func combineWithText(err1, err2 error) error {
+	return fmt.Errorf("open: %v; close: %v", err1, err2)
+}
+
+func combineNonErrors(err error, name string) error {
+	return fmt.Errorf("%v; %v", err, name)
+}
+
+func singleError(err error) error {
+	return fmt.Errorf("%v", err)
+}
+
+func collectMixed(tasks []func() error) error {
+	var msgs []string
+	for _, task := range tasks {
+		err := task()
+		msgs = append(msgs, "task failed", err.Error())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+func collectNotJoined(tasks []func() error) []string {
+	var msgs []string
+	for _, task := range tasks {
+		err := task()
+		msgs = append(msgs, err.Error())
+	}
+	return msgs
+}
+
+func collectErrors(tasks []func() error) error {
+	var errs []error
+	for _, task := range tasks {
+		if err := task(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return fmt.Errorf("%d errors", len(errs))
+}
+
+func printedToUser(w http.ResponseWriter, tasks []func() error) {
+	var msgs []string
+	for _, task := range tasks {
+		if err := task(); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	msg := strings.Join(msgs, "\n")
+	http.Error(w, msg, http.StatusBadRequest)
+}
+
+func printed(tasks []func() error) {
+	var msgs []string
+	for _, task := range tasks {
+		if err := task(); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	fmt.Println(strings.Join(msgs, "\n"))
+}
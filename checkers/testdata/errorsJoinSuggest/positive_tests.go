@@ -0,0 +1,38 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func combineTwo(err1, err2 error) error {
+	/*! use errors.Join(err1, err2) to combine errors and keep them wrapped */
+	return fmt.Errorf("%v; %v", err1, err2)
+}
+
+func combineThree(err1, err2, err3 error) error {
+	/*! use errors.Join(err1, err2, err3) to combine errors and keep them wrapped */
+	return fmt.Errorf("%s\n%s\n%s", err1, err2, err3)
+}
+
+func collectJoined(tasks []func() error) error {
+	var msgs []string
+	for _, task := range tasks {
+		err := task()
+		/*! msgs collects errors as strings; use []error and errors.Join to keep them wrapped */
+		msgs = append(msgs, err.Error())
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+func collectGuarded(tasks []func() error) []string {
+	var errs []string
+	for _, task := range tasks {
+		if err := task(); err != nil {
+			/*! errs collects errors as strings; use []error and errors.Join to keep them wrapped */
+			errs = append(errs, err.Error())
+		}
+	}
+	return errs
+}
@@ -0,0 +1,31 @@
+package checker_test
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const listCmd = "ls -la"
+
+func constants(dir string, args []string) {
+	_ = exec.Command("sh", "-c", "ls -la | wc -l")
+	_ = exec.Command("sh", "-c", listCmd+" /tmp")
+	_ = exec.Command("bash", "-c", fmt.Sprintf("echo %d", 10))
+	_ = exec.Command("cmd")
+	_ = exec.Command("sh", "-c")
+	_ = exec.Command("sh", args...)
+}
+
+func nonShells(dir string, args []string) {
+	_ = exec.Command("ls", "-c", "ls "+dir)
+	_ = exec.Command("ls", "-la", dir)
+	_ = exec.Command(dir)
+	_ = exec.Command(dir + "/bin/tool")
+	_ = exec.Command(strings.Join(args, "/"))
+	_ = exec.Command("C:\\Program Files\\tool.exe")
+}
+
+func scriptVar(script string) {
+	_ = exec.Command("sh", "-c", script)
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+func shellConcat(dir, pattern string) {
+	/*! shell command built from "ls " + dir is prone to shell injection; run the program with exec.Command and separate arguments instead */
+	_ = exec.Command("sh", "-c", "ls "+dir)
+
+	/*! shell command built from "grep " + pattern + " file.txt" is prone to shell injection; run the program with exec.Command and separate arguments instead */
+	_ = exec.Command("/bin/bash", "-e", "-c", "grep " + pattern + " file.txt")
+
+	/*! shell command built from fmt.Sprintf("dir %s", dir) is prone to shell injection; run the program with exec.Command and separate arguments instead */
+	_ = exec.Command("cmd", "/C", fmt.Sprintf("dir %s", dir))
+}
+
+func shellContext(ctx context.Context, name string) {
+	/*! shell command built from fmt.Sprintf("rm -rf /tmp/%s", name) is prone to shell injection; run the program with exec.Command and separate arguments instead */
+	_ = exec.CommandContext(ctx, "zsh", "-c", fmt.Sprintf("rm -rf /tmp/%s", name))
+}
+
+func wrapper(dir string) {
+	/*! shell command built from "echo " + dir is prone to shell injection; run the program with exec.Command and separate arguments instead */
+	_ = exec.Command("/usr/bin/env", "bash", "-c", "echo "+dir)
+}
+
+func spaceJoined(dir string, args []string) {
+	/*! "ls -la " + dir is passed as a program name, but it's not split into arguments; pass every argument separately */
+	_ = exec.Command("ls -la " + dir)
+
+	/*! strings.Join(args, " ") is passed as a program name, but it's not split into arguments; pass every argument separately */
+	_ = exec.Command(strings.Join(args, " "))
+
+	/*! fmt.Sprintf("git %s", dir) is passed as a program name, but it's not split into arguments; pass every argument separately */
+	_ = exec.Command(fmt.Sprintf("git %s", dir))
+}
@@ -0,0 +1,72 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+	"testing"
+)
+
+func properRecover() {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Print(r)
+		}
+	}()
+}
+
+func exitOutsideDefer() {
+	defer println("done")
+	os.Exit(0)
+}
+
+func deferExitCall() {
+	// Handled by the exitAfterDefer checker.
+	defer os.Exit(1)
+}
+
+func nestedLambdaInDefer() {
+	defer func() {
+		cleanup := func() {
+			os.Exit(1)
+		}
+		_ = cleanup
+	}()
+}
+
+func conditionalLambdaInDefer(failed bool) {
+	defer func() {
+		if failed {
+			go func() {
+				os.Exit(1)
+			}()
+		}
+	}()
+}
+
+func TestErrorInGoroutine(t *testing.T) {
+	go func() {
+		t.Error("reported, but the test keeps running")
+		t.Errorf("reported: %d", 1)
+		t.Log("logging is fine")
+	}()
+}
+
+func TestFatalOnTestGoroutine(t *testing.T) {
+	done := make(chan error)
+	go func() {
+		done <- nil
+	}()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+type fakeT struct{}
+
+func (fakeT) Fatal(args ...interface{}) {}
+
+func fakeFatalInGoroutine(t fakeT) {
+	go func() {
+		t.Fatal("not a testing.T")
+	}()
+}
@@ -0,0 +1,77 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+	"testing"
+)
+
+func exitInDefer() {
+	defer func() {
+		/*! os.Exit inside a deferred function prevents other deferred calls and recover from running */
+		os.Exit(1)
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			/*! log.Fatal inside a deferred function prevents other deferred calls and recover from running */
+			log.Fatal(r)
+		}
+	}()
+}
+
+func exitInDeferBranches(codes []int) {
+	defer func() {
+		for _, code := range codes {
+			switch code {
+			case 0:
+				continue
+			default:
+				/*! os.Exit inside a deferred function prevents other deferred calls and recover from running */
+				os.Exit(code)
+			}
+		}
+	}()
+}
+
+func loggerFatalInDefer(l *log.Logger) {
+	defer func() {
+		/*! l.Fatalf inside a deferred function prevents other deferred calls and recover from running */
+		l.Fatalf("exiting: %v", 1)
+	}()
+}
+
+func TestFatalInDefer(t *testing.T) {
+	defer func() {
+		/*! t.Fatal inside a deferred function prevents other deferred calls and recover from running */
+		t.Fatal("cleanup failed")
+	}()
+}
+
+func TestFatalInGoroutine(t *testing.T) {
+	go func() {
+		/*! t.Fatal must be called from the goroutine running the test, not from a goroutine it spawned */
+		t.Fatal("oops")
+	}()
+
+	go func() {
+		if true {
+			/*! t.FailNow must be called from the goroutine running the test, not from a goroutine it spawned */
+			t.FailNow()
+		}
+	}()
+}
+
+func BenchmarkFatalInGoroutine(b *testing.B) {
+	go func() {
+		/*! b.Fatalf must be called from the goroutine running the test, not from a goroutine it spawned */
+		b.Fatalf("oops: %d", 1)
+	}()
+}
+
+func helperFatalInGoroutine(tb testing.TB) {
+	go func() {
+		/*! tb.SkipNow must be called from the goroutine running the test, not from a goroutine it spawned */
+		tb.SkipNow()
+	}()
+}
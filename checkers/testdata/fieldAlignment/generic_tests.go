@@ -0,0 +1,20 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+type genericPadded[T any] struct {
+	a bool
+	b T
+	c bool
+	d int64
+	e bool
+}
+
+type genericArrayPadded[T any] struct {
+	a bool
+	b [2]T
+	c bool
+	d int64
+	e bool
+}
@@ -0,0 +1,56 @@
+package checker_test
+
+import (
+	"encoding/binary"
+	"io"
+	"unsafe"
+)
+
+type optimal struct {
+	b int64
+	d int64
+	a bool
+	c bool
+	e bool
+}
+
+type smallWaste struct {
+	a bool
+	b int64
+	c bool
+	d int32
+}
+
+type fewFields struct {
+	a bool
+	b int64
+	c bool
+}
+
+type binaryHeader struct {
+	a bool
+	b int64
+	c bool
+	d int64
+	e bool
+}
+
+func readHeader(r io.Reader) (binaryHeader, error) {
+	var h binaryHeader
+	err := binary.Read(r, binary.LittleEndian, &h)
+	return h, err
+}
+
+type offsetsMatter struct {
+	a bool
+	b int64
+	c bool
+	d int64
+	e bool
+}
+
+var _ = unsafe.Offsetof(offsetsMatter{}.d)
+
+type notStruct []int
+
+type emptyStruct struct{}
@@ -0,0 +1,50 @@
+package checker_test
+
+/*! struct badOrder is 40 bytes, could be 24 bytes with fields reordered as: b, d, a, c, e */
+type badOrder struct {
+	a bool
+	b int64
+	c bool
+	d int64
+	e bool
+}
+
+/*! struct multiNames is 40 bytes, could be 24 bytes with fields reordered as: p, q, x, y, z, w */
+type multiNames struct {
+	x, y bool
+	p    *int
+	z    bool
+	q    *int
+	w    bool
+}
+
+/*! struct zeroSized is 40 bytes, could be 24 bytes with fields reordered as: e, b, d, a, c */
+type zeroSized struct {
+	a bool
+	b int64
+	c bool
+	d int64
+	e struct{}
+}
+
+/*! struct grouped is 40 bytes, could be 24 bytes with fields reordered */
+type grouped struct {
+	// Header fields.
+	a bool
+	b int64
+
+	// Payload fields.
+	c bool
+	d int64
+	e bool
+}
+
+func localTypes() {
+	type notChecked struct {
+		a bool
+		b int64
+		c bool
+		d int64
+		e bool
+	}
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func checkedLocal(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("file")
+	if !filepath.IsLocal(name) {
+		return
+	}
+	f, _ := os.Open(filepath.Join(baseDir, name))
+	f.Close()
+}
+
+func checkedDots(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("file")
+	if strings.Contains(name, "..") {
+		return
+	}
+	_, _ = os.ReadFile(baseDir + "/" + name)
+}
+
+func baseOnly(w http.ResponseWriter, r *http.Request) {
+	name := filepath.Base(r.URL.Path)
+	_, _ = os.ReadFile(filepath.Join(baseDir, name))
+}
+
+func notFromRequest(name string) {
+	_, _ = os.ReadFile(filepath.Join(baseDir, name))
+	_, _ = os.Open(baseDir + "/" + name)
+}
+
+func otherRequestFields(w http.ResponseWriter, r *http.Request) {
+	_ = filepath.Join(baseDir, r.Method)
+	_ = filepath.Join(baseDir, r.Host)
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const baseDir = "/var/data"
+
+func serveQuery(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("file")
+	/*! filepath.Join uses name from the HTTP request that may escape the base directory; validate it with filepath.IsLocal */
+	p := filepath.Join(baseDir, name)
+	f, _ := os.Open(p)
+	f.Close()
+}
+
+func serveConcat(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/files/")
+	/*! os.Open uses baseDir + "/" + name from the HTTP request that may escape the base directory; validate it with filepath.IsLocal */
+	f, _ := os.Open(baseDir + "/" + name)
+	f.Close()
+}
+
+func serveForm(w http.ResponseWriter, r *http.Request) {
+	/*! path.Join uses r.FormValue("name") from the HTTP request that may escape the base directory; validate it with filepath.IsLocal */
+	_ = path.Join(baseDir, r.FormValue("name"))
+
+	var dir = r.Header.Get("X-Dir")
+	/*! http.ServeFile uses dir from the HTTP request that may escape the base directory; validate it with filepath.IsLocal */
+	http.ServeFile(w, r, dir)
+}
+
+func handlerInClosure() {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		name := q.Get("name")
+		/*! os.ReadFile uses "/tmp/" + name from the HTTP request that may escape the base directory; validate it with filepath.IsLocal */
+		_, _ = os.ReadFile("/tmp/" + name)
+	})
+}
@@ -0,0 +1,14 @@
+package checker_test
+
+import (
+	"io/fs"
+	"path/filepath"
+)
+
+func walkDirExamples(root string) {
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		return nil
+	})
+
+	_, _ = filepath.Glob(root)
+}
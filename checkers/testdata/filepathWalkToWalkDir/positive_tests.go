@@ -0,0 +1,56 @@
+package checker_test
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+func walkExamples(root string, walkFn filepath.WalkFunc) {
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir instead */
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		fmt.Println(path)
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir instead */
+	_ = filepath.Walk(root, func(path string, fi fs.FileInfo, _ error) error {
+		fmt.Println(path)
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir instead */
+	filepath.Walk(root, func(string, os.FileInfo, error) error {
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir and call d.Info() to get info.Size() */
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if !info.IsDir() {
+			fmt.Println(path, info.Size())
+		}
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir and call d.Info() to get info.ModTime() */
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		fmt.Println(info.ModTime())
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir instead */
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		fmt.Println(info)
+		return nil
+	})
+
+	/*! filepath.Walk calls os.Lstat for every visited file; use filepath.WalkDir instead */
+	filepath.Walk(root, walkFn)
+}
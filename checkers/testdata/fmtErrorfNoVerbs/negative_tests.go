@@ -0,0 +1,13 @@
+package checker_test
+
+import "fmt"
+
+func withVerbs(err error, format string, args []interface{}) {
+	_ = fmt.Errorf("read failed: %w", err)
+	_ = fmt.Sprintf("%d%%", 10)
+	_ = fmt.Sprintf(format)
+	_ = fmt.Errorf(format)
+	fmt.Println(fmt.Sprint("a", "b"))
+	fmt.Println(fmt.Sprintf(format, args...))
+	fmt.Println("x", fmt.Sprintf("%d", 1))
+}
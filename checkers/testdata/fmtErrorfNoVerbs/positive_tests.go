@@ -0,0 +1,43 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+const staticMessage = "static message"
+
+var _ = errors.New
+
+func errorfNoVerbs() []error {
+	return []error{
+		/*! fmt.Errorf call has no formatting directives; use errors.New */
+		fmt.Errorf("connection closed"),
+		/*! fmt.Errorf call has no formatting directives; use errors.New */
+		fmt.Errorf(staticMessage),
+		/*! fmt.Errorf call has no formatting directives; use errors.New */
+		fmt.Errorf("100%% done"),
+	}
+}
+
+func sprintfNoVerbs() []string {
+	return []string{
+		/*! fmt.Sprintf call has no formatting directives; use the string directly */
+		fmt.Sprintf("no verbs"),
+		/*! fmt.Sprintf call has no formatting directives; use the string directly */
+		fmt.Sprintf(`raw string`),
+		/*! fmt.Sprintf call has no formatting directives; use the string directly */
+		fmt.Sprintf("50%%"),
+	}
+}
+
+func printlnSprintf(n int, name string) {
+	/*! fmt.Println(fmt.Sprintf(...)) can be replaced with fmt.Printf with a trailing newline */
+	fmt.Println(fmt.Sprintf("%d items", n))
+	/*! fmt.Println(fmt.Sprintf(...)) can be replaced with fmt.Printf with a trailing newline */
+	fmt.Println(fmt.Sprintf("hello, %s: %d%%", name, n))
+	/*! fmt.Println(fmt.Sprintf(...)) can be replaced with fmt.Printf with a trailing newline */
+	fmt.Println(fmt.Sprintf("static"))
+	/*! fmt.Println(fmt.Sprintf(...)) can be replaced with fmt.Printf with a trailing newline */
+	fmt.Println(fmt.Sprintf(`%d raw`, n))
+}
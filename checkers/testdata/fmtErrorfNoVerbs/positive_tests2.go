@@ -0,0 +1,8 @@
+package checker_test
+
+import "fmt"
+
+func errorfWithoutErrorsImport() error {
+	/*! fmt.Errorf call has no formatting directives; use errors.New */
+	return fmt.Errorf("no errors import")
+}
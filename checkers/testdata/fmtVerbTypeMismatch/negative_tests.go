@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type namedStringer struct{ name string }
+
+func (n namedStringer) String() string { return n.name }
+
+type customErr struct{ code int }
+
+func (e customErr) Error() string { return "code" }
+
+type formatted struct{ x int }
+
+func (f formatted) Format(s fmt.State, verb rune) {}
+
+type count int
+
+func verbMatches(n namedStringer, e customErr, f formatted, ps *ptrStringer, id userID, c count, err error, x interface{}) {
+	fmt.Printf("%s %s %s %s", n, e, f, ps)
+	fmt.Printf("%s %q %v", id, id, id)
+	_ = fmt.Sprintf("%d %v", c, err)
+	fmt.Printf("%s", x)
+	fmt.Printf("%s %s", []byte("x"), "str")
+	fmt.Printf("%+v %v", request{}, &request{})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "failed: %v", err)
+	_ = fmt.Sprintf("failed: %v", err)
+	_ = fmt.Errorf("failed: %v", err)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s", n)
+
+	fmt.Println("%s", request{})
+}
@@ -0,0 +1,45 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+)
+
+type request struct {
+	URL    string
+	Method string
+}
+
+type ptrStringer struct{ name string }
+
+func (p *ptrStringer) String() string { return p.name }
+
+type userID string
+
+func verbMismatches(req request, preq *request, ps ptrStringer, id userID, err error, t *testing.T) {
+	/*! %s verb is used with req of type request that implements neither fmt.Stringer nor error, its fields are printed instead */
+	fmt.Printf("request %s failed\n", req)
+
+	/*! %-10s verb is used with preq of type *request that implements neither fmt.Stringer nor error, its fields are printed instead */
+	_ = fmt.Sprintf("request: %-10s", preq)
+
+	/*! %s verb is used with ps of type ptrStringer, but its String or Error method has a pointer receiver; pass &ps */
+	log.Printf("name: %s", ps)
+
+	/*! %d verb is used with id of type userID that has string underlying type */
+	_ = fmt.Errorf("user %d: %w", id, err)
+
+	/*! %v verb is used with error err in the user-facing output; use %s to print the error message */
+	fmt.Printf("failed: %v\n", err)
+
+	/*! %v verb is used with error err in the user-facing output; use %s to print the error message */
+	fmt.Fprintf(os.Stderr, "failed: %v\n", err)
+
+	/*! %[2]s verb is used with req of type request that implements neither fmt.Stringer nor error, its fields are printed instead */
+	t.Logf("%[2]s %[1]s", req.URL, req)
+
+	_ = errors.New("x")
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import "fmt"
+
+func tableDriven() {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{name: "a", ok: true},
+		{name: "b", ok: false},
+		{name: "c", ok: true && false},
+		{name: "d", ok: true || false},
+		{name: "e", ok: false},
+		{name: "f", ok: true},
+		{name: "g", ok: true},
+		{name: "h", ok: true},
+		{name: "i", ok: true},
+	}
+	for _, test := range tests {
+		fmt.Println(test.name)
+	}
+}
+
+func declarations() {
+	var (
+		a = 1
+		b = 2
+	)
+	var c int
+	const d = 10
+	type pair struct{ x, y int }
+	fmt.Println(a, b, c, d, pair{})
+}
+
+func elseIfChain(x int) {
+	if x == 1 {
+		fmt.Println(1)
+	} else if x == 2 {
+		fmt.Println(2)
+	} else if x == 3 {
+		fmt.Println(3)
+	}
+}
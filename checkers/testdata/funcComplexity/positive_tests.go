@@ -0,0 +1,56 @@
+package checker_test
+
+import "fmt"
+
+/*! manyStatements has 9 statements, the limit is 8 */
+func manyStatements() {
+	a := 1
+	b := 2
+	c := a + b
+	fmt.Println(a)
+	fmt.Println(b)
+	fmt.Println(c)
+	a++
+	b++
+	fmt.Println(a, b)
+}
+
+/*! branchy has cyclomatic complexity 6, the limit is 4 */
+func branchy(x, y int) string {
+	if x > 0 && y > 0 {
+		return "both"
+	}
+	switch {
+	case x > 0:
+		return "x"
+	case y > 0 || y < -10:
+		return "y"
+	}
+	return "none"
+}
+
+/*! deeplyNested has nesting depth 3, the limit is 2 */
+func deeplyNested(xs [][]int) {
+	for _, x := range xs {
+		for _, y := range x {
+			if y != 0 {
+				fmt.Println(y)
+			}
+		}
+	}
+}
+
+type complexType struct{}
+
+/*! deepSwitch has nesting depth 3, the limit is 2 */
+func (complexType) deepSwitch(v interface{}) {
+	switch v := v.(type) {
+	case int:
+		select {
+		default:
+			if v > 0 {
+				fmt.Println(v)
+			}
+		}
+	}
+}
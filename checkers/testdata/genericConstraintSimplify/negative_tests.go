@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+import "fmt"
+
+func uniqueTerms[T interface{ ~int | ~int64 }](xs ...T) {}
+
+func anyParam[T any](x T) {}
+
+func comparableParam[K comparable, V any](m map[K]V) {}
+
+type stringerComparable interface {
+	comparable
+	fmt.Stringer
+}
+
+type signedOrString interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~string
+}
+
+func notFloats[T interface{ float32 | float64 }](x T) {}
+
+func namedSigned[T signedInts](x T) {}
+
+func exactOrString[T signedInts | string](x T) {}
+
+type floatStringer interface {
+	~float32 | ~float64
+	String() string
+}
+
+type intersection interface {
+	signedInts
+	~int | ~string
+}
+
+type plainInterface interface {
+	String() string
+}
+
+type container[T any] struct{ items []T }
+
+func (c *container[T]) add(x T) { c.items = append(c.items, x) }
@@ -0,0 +1,65 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+import "fmt"
+
+type signedInts interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsignedInts interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type floats interface {
+	~float32 | ~float64
+}
+
+type myInt int
+
+/*! signedInts | floats | signedInts contains redundant term signedInts; remove it */
+func dupTerms[T interface{ signedInts | floats | signedInts }](xs ...T) {}
+
+/*! ~int | ~uint8 | signedInts contains redundant term ~int; remove it */
+func coveredTerm[T ~int | ~uint8 | signedInts](x T) {}
+
+type number interface {
+	/*! myInt | ~float32 | signedInts contains redundant term myInt; remove it */
+	myInt | ~float32 | signedInts
+}
+
+/*! the T constraint can be simplified to any */
+func anyIface[T interface{ any }](x T) {}
+
+type stringerAny interface {
+	/*! embedded any in stringerAny is redundant */
+	any
+	String() string
+}
+
+type twiceComparable interface {
+	comparable
+	/*! twiceComparable embeds comparable more than once */
+	comparable
+}
+
+/*! interface{ ~float32 | ~float64 } is the same type set as constraints.Float; use constraints.Float instead */
+func sum[T interface{ ~float32 | ~float64 }](x T) {}
+
+/*! ~int8 | ~int16 | ~int32 | ~int64 | ~int is the same type set as constraints.Signed; use constraints.Signed instead */
+func signed[T ~int8 | ~int16 | ~int32 | ~int64 | ~int](x T) {}
+
+/*! signedInts | unsignedInts | floats | ~string is the same type set as cmp.Ordered; use cmp.Ordered instead */
+func ordered[T signedInts | unsignedInts | floats | ~string](x T) {}
+
+/*! interface{ signedInts | unsignedInts } is the same type set as constraints.Integer; use constraints.Integer instead */
+func integer[T interface{ signedInts | unsignedInts }](x T) {}
+
+/*! embedded any in the T constraint is redundant */
+/*! the T constraint embeds fmt.Stringer more than once */
+func stringers[T interface { any; fmt.Stringer; fmt.Stringer }](x T) {}
+
+/*! floats | floats contains redundant term floats; remove it */
+type box[T interface{ floats | floats }] struct{ v T }
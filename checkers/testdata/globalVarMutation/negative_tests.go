@@ -0,0 +1,44 @@
+package checker_test
+
+import "sync"
+
+var (
+	mu       sync.Mutex
+	counters = map[string]int{}
+	once     sync.Once
+	version  string
+	handlers = map[string]func(){}
+)
+
+func init() {
+	version = "1.0"
+}
+
+func SetVersion(v string) {
+	version = v
+}
+
+func RegisterHandler(name string, h func()) {
+	handlers[name] = h
+}
+
+func Count(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	counters[name]++
+}
+
+func Once() {
+	once = sync.Once{}
+}
+
+func Local() int {
+	version := "local"
+	counters := map[string]int{}
+	counters[version]++
+	return len(counters)
+}
+
+func unexportedWrite() {
+	version = "dev"
+}
@@ -0,0 +1,35 @@
+package checker_test
+
+var lastID int
+
+var defaults = map[string]string{}
+
+type settings struct {
+	verbose bool
+}
+
+var current settings
+
+var cache []string
+
+func NextID() int {
+	/*! exported NextID modifies package-level lastID declared at positive_tests.go:3; pass the state explicitly or guard it with a mutex */
+	lastID++
+	return lastID
+}
+
+func Configure(key, value string, verbose bool) {
+	/*! exported Configure modifies package-level defaults declared at positive_tests.go:5; pass the state explicitly or guard it with a mutex */
+	defaults[key] = value
+	/*! exported Configure modifies package-level current declared at positive_tests.go:11; pass the state explicitly or guard it with a mutex */
+	current.verbose = verbose
+}
+
+type Loader struct{}
+
+func (l *Loader) Load(items []string) {
+	go func() {
+		/*! exported Load modifies package-level cache declared at positive_tests.go:13; pass the state explicitly or guard it with a mutex */
+		cache = append(cache, items...)
+	}()
+}
@@ -0,0 +1,81 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"net/http"
+)
+
+func deferredClose(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func deferredFuncClose(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	return nil
+}
+
+func closedOnAllPaths(url string) error {
+	resp, err := http.Post(url, "text/plain", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+	return nil
+}
+
+func returnedResponse(url string) (*http.Response, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func passedResponse(url string, handle func(*http.Response)) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return
+	}
+	handle(resp)
+}
+
+func passedBody(url string, consume func(interface{})) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return
+	}
+	consume(resp.Body)
+}
+
+func storedResponse(url string) {
+	type result struct {
+		resp *http.Response
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		return
+	}
+	_ = result{resp: resp}
+}
+
+func notAClientCall(h http.Handler, w http.ResponseWriter, r *http.Request) {
+	req, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		return
+	}
+	h.ServeHTTP(w, req)
+}
@@ -0,0 +1,68 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+func neverClosed(url string) ([]byte, error) {
+	/*! resp.Body is never closed, which leaks the connection; add defer resp.Body.Close() after the error check */
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func neverClosedDecode(client *http.Client, req *http.Request, v interface{}) error {
+	/*! res.Body is never closed, which leaks the connection; add defer res.Body.Close() after the error check */
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func closedBeforeCheck(url string) error {
+	resp, err := http.Head(url)
+	/*! resp.Body.Close() is called before the error check, resp is nil if the request fails */
+	defer resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func notClosedOnSomePaths(url string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		/*! resp.Body is not closed on this return path */
+		return nil
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func discardedResponse(url string) error {
+	/*! response of http.Get is discarded, so its Body can't be closed */
+	_, err := http.Get(url)
+	return err
+}
+
+func inClosure(url string) {
+	go func() {
+		/*! resp.Body is never closed, which leaks the connection; add defer resp.Body.Close() after the error check */
+		resp, err := http.Get(url)
+		if err != nil {
+			return
+		}
+		_ = resp.StatusCode
+	}()
+}
@@ -0,0 +1,50 @@
+package checker_test
+
+type config struct {
+	name  string
+	attrs [16]string
+}
+
+type server struct {
+	cfg  config
+	ptr  *config
+	cfgs []config
+	byID map[int]config
+}
+
+/*! cfg is heavy (272 bytes); consider passing it by pointer */
+func describe(cfg config, verbose bool) string {
+	if verbose && cfg != (config{}) {
+		return cfg.name + cfg.attrs[0]
+	}
+	return cfg.name
+}
+
+func describeAll(s *server, local config, arr [200]int) {
+	_ = describe(local, true)
+	_ = describe(s.cfg, false)
+	_ = describe(*s.ptr, false)
+	_ = describe(s.cfgs[0], false)
+	_ = describe((config{name: "default"}), false)
+	_ = bigArrayUses(arr)
+}
+
+/*! cfg is heavy (272 bytes); consider passing it by pointer */
+func notAddressable(cfg config) string {
+	return cfg.name
+}
+
+func callNotAddressable(s *server) {
+	_ = notAddressable(s.byID[0])
+	_ = notAddressable(newConfig())
+}
+
+func newConfig() config { return config{} }
+
+/*! cfg is heavy (272 bytes); consider passing it by pointer */
+func recursive(cfg config, depth int) string {
+	if depth == 0 {
+		return cfg.name
+	}
+	return recursive(cfg, depth-1)
+}
@@ -0,0 +1,14 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+type genericBox[T any] struct {
+	values [16]T
+}
+
+func genericParam[T any](x T, box genericBox[T], arr [100]T) {}
+
+/*! ints is heavy (128 bytes); consider passing it by pointer */
+/*! arr is heavy (800 bytes); consider passing it by pointer */
+func instantiatedParam[T any](ints genericBox[int], arr [100]int, x T) {}
@@ -17,3 +17,20 @@ func bigStructPtr2(x, y *bigStruct) {}
 func mixedBigObjectsPtr(x *bigStruct, y *[20][]int) {}
 
 func (x *bigStruct) bigRecvPtr(y *[2]bigStruct) {}
+
+func smallVariadic(xs ...int) {}
+
+func passedThrough(x bigStruct) {
+	bigStruct1(x)
+	bigStruct2(x, x)
+}
+
+func mutatedCopy(x bigStruct) bigStruct {
+	x.x1 = "scratch"
+	return x
+}
+
+func mutatedArray(a [200]int) [200]int {
+	a[0]++
+	return a
+}
@@ -25,3 +25,36 @@ func mixedBigObjects(x bigStruct, y [20][]int) {}
 /*! x is heavy (80 bytes); consider passing it by pointer */
 /*! y is heavy (160 bytes); consider passing it by pointer */
 func (x bigStruct) bigRecv(y [2]bigStruct) {}
+
+/*! items elements are heavy (80 bytes); consider passing them by pointer */
+func bigVariadic(items ...bigStruct) {}
+
+/*! x is heavy (80 bytes); consider passing it by pointer */
+func bigStructUses(x bigStruct) string {
+	y := x
+	if x == y {
+		return x.x1 + y.x2
+	}
+	return x.x3
+}
+
+/*! a is heavy (1600 bytes); consider passing it by pointer */
+func bigArrayUses(a [200]int) int {
+	sum := len(a)
+	for _, v := range a {
+		sum += v
+	}
+	return sum + a[0]
+}
+
+/*! x is heavy (80 bytes); consider passing it by pointer */
+func ExportedBigStruct(x bigStruct) string {
+	return x.x1
+}
+
+/*! x is heavy (80 bytes); consider passing it by pointer */
+func referencedBigStruct(x bigStruct) string {
+	return x.x1
+}
+
+var _ = referencedBigStruct
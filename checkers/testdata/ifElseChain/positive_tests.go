@@ -55,3 +55,63 @@ func describeInt(x int) string {
 		return "positive"
 	}
 }
+
+func describeValue(x int) string {
+	/*! rewrite if-else to switch statement */
+	if x == 0 {
+		return "zero"
+	} else if x == 1 || x == 2 {
+		return "small"
+	} else {
+		return "big"
+	}
+}
+
+func describeHoisted(f func() int) string {
+	/*! rewrite if-else to switch statement */
+	if x := f(); x < 0 {
+		return "negative"
+	} else if x == 0 {
+		return "zero"
+	} else {
+		return "positive"
+	}
+}
+
+func describeNotHoisted(f func() int) string {
+	x := "unused"
+	_ = x
+	/*! rewrite if-else to switch statement */
+	if x := f(); x < 0 {
+		return "negative"
+	} else if x == 0 {
+		return "zero"
+	} else {
+		return "positive"
+	}
+}
+
+func describeCommented(x int) string {
+	/*! rewrite if-else to switch statement */
+	if x < 0 {
+		// Below zero.
+		return "negative"
+	} else if x == 0 {
+		return "zero"
+	} else {
+		return "positive"
+	}
+}
+
+func breakInLoop(xs []int) {
+	for _, x := range xs {
+		/*! rewrite if-else to switch statement */
+		if x < 0 {
+			break
+		} else if x == 0 {
+			continue
+		} else {
+			println(x)
+		}
+	}
+}
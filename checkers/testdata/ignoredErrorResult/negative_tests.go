@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"strconv"
+)
+
+func checkedErrors(data []byte, s string) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	_ = n
+	return nil
+}
+
+func deferredRollback(tx *sql.Tx) error {
+	defer tx.Rollback()
+	return tx.Commit()
+}
+
+func readOnlyFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 10)
+	f.Read(buf)
+	f.Close()
+	return nil
+}
+
+func notListed(s string) {
+	strconv.Quote(s)
+	os.Remove(s)
+	_ = os.Remove(s)
+}
+
+type fakeDecoder struct{}
+
+func (fakeDecoder) Unmarshal(data []byte, v interface{}) error { return nil }
+
+func sameNameMethod(d fakeDecoder, data []byte) {
+	d.Unmarshal(data, nil)
+}
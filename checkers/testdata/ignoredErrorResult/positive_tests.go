@@ -0,0 +1,64 @@
+package checker_test
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+func unmarshal(data []byte) {
+	var v map[string]int
+	/*! error result of encoding/json.Unmarshal is not checked */
+	json.Unmarshal(data, &v)
+
+	/*! error result of encoding/json.Unmarshal is assigned to the blank identifier */
+	_ = json.Unmarshal(data, &v)
+}
+
+func parseNumbers(s string) int {
+	/*! error result of strconv.Atoi is assigned to the blank identifier */
+	n, _ := strconv.Atoi(s)
+	/*! error result of strconv.ParseFloat is assigned to the blank identifier */
+	f, _ := strconv.ParseFloat(s, 64)
+	return n + int(f)
+}
+
+func randomBytes() []byte {
+	b := make([]byte, 16)
+	/*! error result of crypto/rand.Read is not checked */
+	rand.Read(b)
+	return b
+}
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	/*! error result of net/http.ResponseWriter.Write is not checked */
+	w.Write([]byte("ok"))
+}
+
+func rollback(tx *sql.Tx) {
+	/*! error result of database/sql.Tx.Rollback is not checked */
+	tx.Rollback()
+}
+
+func writeFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString("data"); err != nil {
+		return err
+	}
+	/*! error result of os.File.Close is not checked */
+	f.Close()
+	return nil
+}
+
+func printToFile(f *os.File) {
+	fmt.Fprintln(f, "data")
+	/*! error result of os.File.Close is not checked */
+	f.Close()
+}
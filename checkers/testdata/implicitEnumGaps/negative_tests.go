@@ -0,0 +1,53 @@
+package checker_test
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	_
+	Blue
+)
+
+type Size int
+
+const (
+	_ Size = iota
+	Small
+	Medium
+	Large
+	Default = Medium
+)
+
+type Flag int
+
+type options struct {
+	Flags []Flag `db:"flags"`
+}
+
+const (
+	FlagA Flag = 1 << iota
+	_
+	FlagC
+)
+
+const (
+	maxRetries = 3
+	timeout    = 10
+	other      = 3
+)
+
+type Mode int
+
+const (
+	ModeA Mode = iota
+	ModeB
+	ModeC = 10 + iota
+	ModeD
+)
+
+const (
+	first = iota
+	second
+	third = 100
+)
@@ -0,0 +1,48 @@
+package checker_test
+
+type Status int
+
+const (
+	Pending Status = iota
+	Running
+	/*! Failed = 5 interrupts the iota sequence, so the following implicit constants repeat its value: Done = 5, Canceled = 5 */
+	Failed = 5
+	Done
+	Canceled
+)
+
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	/*! Error = 2 duplicates the value of Warn in the same iota block */
+	Error = 2
+	Fatal = iota
+)
+
+type Perm uint
+
+const (
+	Read Perm = 1 << iota
+	Write
+	Exec
+	/*! All = 4 duplicates the value of Exec in the same iota block */
+	All = 1 << 2
+)
+
+type Kind int
+
+type record struct {
+	Kind Kind `json:"kind"`
+}
+
+const (
+	KindUnknown Kind = iota
+	KindUser
+	/*! blank identifier in the iota block of persisted type Kind shifts the following values (KindGroup = 3, KindRole = 4); assign them explicitly */
+	_
+	KindGroup
+	KindRole
+)
@@ -26,3 +26,10 @@ func blankParam(_ int) {
 	_, x := 1, 2
 	_ = x
 }
+
+func rangeNoShadow(xs []string) {
+	for i, s := range xs {
+		_, _ = i, s
+	}
+	_ = func(x int) int { return x }
+}
@@ -54,3 +54,22 @@ func renamedImportShadow1(mymath1 int) {}
 
 /*! shadow of imported package 'mymath2' */
 func renamedImportShadow2(mymath2 int) {}
+
+func rangeShadow(xs []string) {
+	/*! shadow of imported package 'fmt' */
+	for _, fmt := range xs {
+		_ = fmt
+	}
+}
+
+func funcLitShadow() {
+	/*! shadow of imported package 'math' */
+	_ = func(math int) {}
+
+	f := func() {
+		/*! shadow of imported package 'fmt' */
+		fmt := 1
+		_ = fmt
+	}
+	_ = f
+}
@@ -0,0 +1,57 @@
+package checker_test
+
+import (
+	"errors"
+	"io"
+)
+
+type Shape interface {
+	Area() float64
+}
+
+type Square struct{}
+
+func (Square) Area() float64 { return 1 }
+
+type Circle struct{}
+
+func (Circle) Area() float64 { return 3 }
+
+func NewShape(round bool) Shape {
+	if round {
+		return Circle{}
+	}
+	return Square{}
+}
+
+type Cache interface {
+	Lookup(key string) bool
+}
+
+type lruCache struct{}
+
+func (lruCache) Lookup(key string) bool { return false }
+
+func NewCache() Cache {
+	return lruCache{}
+}
+
+func newStoreUnexported() Store {
+	return &MemStore{}
+}
+
+type Empty interface{}
+
+func NewEmpty() Empty { return nil }
+
+func NewReader() io.Reader { return nil }
+
+func NewError() error { return errors.New("x") }
+
+func NewStoreWithCount() (Store, int) {
+	return &MemStore{}, 0
+}
+
+func (*MemStore) Clone() Store {
+	return &MemStore{}
+}
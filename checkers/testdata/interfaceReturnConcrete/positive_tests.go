@@ -0,0 +1,27 @@
+package checker_test
+
+type Store interface {
+	Get(key string) string
+}
+
+type MemStore struct{}
+
+func (*MemStore) Get(key string) string { return "" }
+
+/*! NewStore returns interface Store that is only implemented by *MemStore; consider returning *MemStore */
+func NewStore() Store {
+	return &MemStore{}
+}
+
+type Parser interface {
+	Parse(s string) (int, error)
+}
+
+type DecimalParser struct{}
+
+func (DecimalParser) Parse(s string) (int, error) { return 0, nil }
+
+/*! OpenParser returns interface Parser that is only implemented by DecimalParser; consider returning DecimalParser */
+func OpenParser(name string) (Parser, error) {
+	return DecimalParser{}, nil
+}
@@ -0,0 +1,15 @@
+package checker_test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+func _(r io.Reader) {
+	/*! ioutil.ReadAll is deprecated, use io.ReadAll instead */
+	ioutil.ReadAll(r)
+	/*! ioutil.ReadFile is deprecated, use os.ReadFile instead */
+	ioutil.ReadFile("")
+	os.Exit(0)
+}
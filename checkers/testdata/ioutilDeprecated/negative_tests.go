@@ -13,3 +13,12 @@ func _(r io.Reader) {
 	io.NopCloser(r)
 	_ = io.Discard
 }
+
+type fakeIoutil struct{}
+
+func (fakeIoutil) ReadAll(r io.Reader) {}
+
+func _(r io.Reader) {
+	var ioutil fakeIoutil
+	ioutil.ReadAll(r)
+}
@@ -12,10 +12,18 @@ func _(r io.Reader) {
 	ioutil.ReadFile("")
 	/*! ioutil.WriteFile is deprecated, use os.WriteFile instead */
 	ioutil.WriteFile("", nil, 0)
-	/*! ioutil.ReadDir is deprecated, use os.ReadDir instead */
+	/*! ioutil.ReadDir is deprecated, use os.ReadDir instead; note that it returns []os.DirEntry instead of []os.FileInfo */
 	ioutil.ReadDir("")
 	/*! ioutil.NopCloser is deprecated, use io.NopCloser instead */
 	ioutil.NopCloser(r)
 	/*! ioutil.Discard is deprecated, use io.Discard instead */
 	_ = ioutil.Discard
+	/*! ioutil.TempDir is deprecated, use os.MkdirTemp instead */
+	ioutil.TempDir("", "x")
+	/*! ioutil.TempFile is deprecated, use os.CreateTemp instead */
+	ioutil.TempFile("", "x")
+
+	/*! ioutil.ReadAll is deprecated, use io.ReadAll instead */
+	readAll := ioutil.ReadAll
+	_ = readAll
 }
@@ -0,0 +1,41 @@
+package checker_test
+
+type consistentSnake struct {
+	UserID    string `json:"user_id"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Name      string `json:"name"`
+	URL       string `json:"URL"`
+}
+
+type styleTie struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"last_name"`
+}
+
+type ignoredFields struct {
+	Secret string `json:"-"`
+	Other  string `json:"-"`
+	hidden string `json:"hidden_value"`
+	shadow string `json:"hiddenValue"`
+	Token  string `json:"token" xml:"token value"`
+}
+
+type embeddedStruct struct {
+	consistentSnake
+	Extra string `json:"extra"`
+}
+
+type pointerOmitempty struct {
+	Child *consistentSnake `json:"child,omitempty"`
+	Items []string         `json:"items,omitempty"`
+}
+
+type notMarshaled struct {
+	ID   string `json:"id"`
+	Name string
+}
+
+type emptyName struct {
+	Name  string `json:",omitempty"`
+	Other string `json:",omitempty"`
+}
@@ -0,0 +1,63 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type snakeMajority struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	/*! json tag name middleName of field MiddleName is camel case, while snake case is expected */
+	MiddleName string `json:"middleName"`
+	Age        int    `json:"age"`
+}
+
+type camelMajority struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	/*! json tag name middle_name of field MiddleName is snake case, while camel case is expected */
+	MiddleName string `json:"middle_name,omitempty"`
+}
+
+type duplicateNames struct {
+	Name string `json:"name"`
+	/*! json tag name name of field Title is already used by field Name */
+	Title string `json:"name,omitempty"`
+}
+
+type strayspaces struct {
+	/*! json tag "name,omitempty " of field Name contains spaces */
+	Name string `json:"name,omitempty "`
+	/*! json tag "email, omitempty" of field Email contains spaces */
+	Email string `json:"email, omitempty"`
+}
+
+type address struct {
+	City string `json:"city"`
+}
+
+type uselessOmitempty struct {
+	/*! omitempty has no effect on struct field Home; use a pointer to omit it */
+	Home address `json:"home,omitempty"`
+	/*! omitempty has no effect on struct field Created; use a pointer to omit it */
+	Created time.Time `json:"created,omitempty"`
+	Work    *address  `json:"work,omitempty"`
+}
+
+type marshaledStruct struct {
+	ID string `json:"id"`
+	/*! exported field Name of marshaled struct marshaledStruct has no json tag */
+	Name string
+	note string
+}
+
+type encodedStruct struct {
+	/*! exported field Value of marshaled struct encodedStruct has no json tag */
+	Value int
+}
+
+func marshal(v marshaledStruct, enc *json.Encoder, items []*encodedStruct) {
+	json.Marshal(&v)
+	enc.Encode(items)
+}
@@ -0,0 +1,49 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+type celsius float64
+
+type myBool bool
+
+var pkgLevel = 10
+
+func keptDecls(items []string, a, b int) {
+	var x int64 = 1
+	var d time.Duration = 5
+	var temp celsius = 36.6
+	var r io.Reader = os.Stdin
+	var p *user = nil
+	var ok myBool = a < b
+	var shift uint8 = 1 << 3
+	var name string
+	var i, j = 1, 2
+	var (
+		grouped = 1
+	)
+	count := len(items)
+
+	_, _, _, _, _, _, _, _, _, _, _, _ = x, d, temp, r, p, ok, shift, name, i, j, grouped, count
+}
+
+func widenedScope(items []string) string {
+	var result = "none"
+	if len(items) != 0 {
+		result = items[0]
+	}
+
+	var last = ""
+	for _, item := range items {
+		last = item
+	}
+	return result + last
+}
+
+func interfaceFromVar() {
+	var w io.Writer = os.Stdout
+	_ = w
+}
@@ -0,0 +1,51 @@
+package checker_test
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+type user struct{ Name string }
+
+func shortDecls(items []string, u user, a, b int) {
+	/*! declare count with := instead of var */
+	var count = len(items)
+
+	/*! type string of name is redundant, the value already has this type; declare it with := */
+	var name string = u.Name
+
+	/*! type time.Duration of timeout is redundant, the value already has this type; declare it with := */
+	var timeout time.Duration = time.Second
+
+	/*! type bool of less is redundant, the value already has this type; declare it with := */
+	var less bool = a < b
+
+	/*! type *os.File of f is redundant, the value already has this type; declare it with := */
+	var f *os.File = os.Stdin
+
+	/*! type error of err is redundant, the value already has this type; declare it with := */
+	var err error = os.ErrNotExist
+
+	/*! declare r with := instead of var */
+	var r = io.Reader(f)
+
+	/*! declare n with := instead of var */
+	var n = 10
+
+	_, _, _, _, _, _, _, _ = count, name, timeout, less, r, n, f, err
+}
+
+func nestedDecls(items []string) {
+	for range items {
+		/*! declare first with := instead of var */
+		var first = items[0]
+		_ = first
+	}
+	fn := func() {
+		/*! type []string of copied is redundant, the value already has this type; declare it with := */
+		var copied []string = items
+		_ = copied
+	}
+	fn()
+}
@@ -0,0 +1,26 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func init() {
+	if os.Getenv("HOME") == "" {
+		log.Fatal("HOME is not set")
+	}
+}
+
+func logOnly(err error) {
+	log.Printf("error: %v", err)
+	log.Panic(err)
+}
+
+type exiter struct{}
+
+func (exiter) Exit(code int) {}
+
+func customExit() {
+	var e exiter
+	e.Exit(1)
+}
@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"log"
+	"os"
+)
+
+func LoadConfig(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		/*! log.Fatal terminates the process from package checker_test and skips the deferred calls of its callers; return an error instead */
+		log.Fatal(err)
+	}
+	return data
+}
+
+func validate(ok bool) {
+	if !ok {
+		/*! log.Fatalf terminates the process from package checker_test and skips the deferred calls of its callers; return an error instead */
+		log.Fatalf("validation failed: %v", ok)
+	}
+}
+
+type service struct {
+	logger *log.Logger
+}
+
+func (s *service) stop() {
+	/*! s.logger.Fatalln terminates the process from package checker_test and skips the deferred calls of its callers; return an error instead */
+	s.logger.Fatalln("stopped")
+}
+
+func exitOnError(err error) {
+	if err != nil {
+		func() {
+			/*! os.Exit terminates the process from package checker_test and skips the deferred calls of its callers; return an error instead */
+			os.Exit(1)
+		}()
+	}
+}
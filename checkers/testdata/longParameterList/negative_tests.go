@@ -0,0 +1,32 @@
+package checker_test
+
+import (
+	"io"
+	"net"
+)
+
+func sixParams(a, b, c int, d, e string, f ...int) {}
+
+func oneBool(name string, verbose bool) {}
+
+type flags struct{}
+
+func (f *flags) SetEnabled(enabled bool) {}
+
+// namedBool is not a predeclared bool.
+type namedBool bool
+
+func customBools(a, b namedBool) {}
+
+type pipe struct{}
+
+func (p *pipe) Read(b []byte) (int, error)  { return 0, nil }
+func (p *pipe) Write(b []byte) (int, error) { return 0, nil }
+
+var _ io.ReadWriter = (*pipe)(nil)
+
+type conn struct{ net.Conn }
+
+func lits() {
+	_ = func(a, b bool) {}
+}
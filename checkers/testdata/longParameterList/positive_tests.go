@@ -0,0 +1,20 @@
+package checker_test
+
+import "io"
+
+/*! render has 7 parameters, the limit is 6; consider grouping them into an options struct */
+func render(w io.Writer, tmpl string, data interface{}, a, b, c int, d string) {}
+
+/*! format has 2 bool parameters that make call sites like format(true, false) unreadable; consider an options struct */
+func format(s string, escape, indent bool) string { return s }
+
+type printer struct{}
+
+/*! print has 8 parameters, the limit is 6; consider grouping them into an options struct */
+/*! print has 5 bool parameters that make call sites like print(true, false) unreadable; consider an options struct */
+func (p *printer) print(a, b, c, d, e bool, f, g int, h ...string) {}
+
+type settings struct{}
+
+/*! SetOptions has 2 bool parameters that make call sites like SetOptions(true, false) unreadable; consider an options struct */
+func (s *settings) SetOptions(enabled, strict bool) {}
@@ -0,0 +1,76 @@
+package checker_test
+
+type wrapper struct {
+	p *point
+}
+
+func localAddr(points []point) {
+	for _, p := range points {
+		// Only used during the iteration.
+		usePoint(&p)
+		q := &p
+		_ = q
+	}
+}
+
+func copiedVar(points []point) []*point {
+	var ptrs []*point
+	for _, p := range points {
+		p := p
+		ptrs = append(ptrs, &p)
+	}
+	return ptrs
+}
+
+func indexAddr(points []point) []*point {
+	var ptrs []*point
+	for i := range points {
+		ptrs = append(ptrs, &points[i])
+	}
+	return ptrs
+}
+
+func pointerField(items []wrapper) []*int {
+	var ptrs []*int
+	for _, w := range items {
+		// Points to the memory outside of w.
+		ptrs = append(ptrs, &w.p.x)
+	}
+	return ptrs
+}
+
+func goWithArgs(items []string) {
+	for _, s := range items {
+		go func(s string) {
+			println(s)
+		}(s)
+	}
+}
+
+func assignedOutsideLoop(points []point) {
+	var ptrs []*point
+	p := point{}
+	for range points {
+		ptrs = append(ptrs, &p)
+	}
+	_ = ptrs
+}
+
+func predeclaredRangeVar(points []point) []*point {
+	var ptrs []*point
+	var p point
+	for _, p = range points {
+		ptrs = append(ptrs, &p)
+	}
+	return ptrs
+}
+
+func deferInsideLambda(items []string, run func(func())) {
+	for _, s := range items {
+		run(func() {
+			defer func() {
+				println(s)
+			}()
+		})
+	}
+}
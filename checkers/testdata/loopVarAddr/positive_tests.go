@@ -0,0 +1,83 @@
+package checker_test
+
+type point struct {
+	x, y int
+}
+
+func appendAddr(points []point) []*point {
+	var ptrs []*point
+	for _, p := range points {
+		/*! &p outlives the iteration, but range variable p declared at line 9 is reused by all iterations */
+		ptrs = append(ptrs, &p)
+	}
+	return ptrs
+}
+
+func appendFieldAddr(points []point) []*int {
+	var ptrs []*int
+	for _, p := range points {
+		/*! &p.x outlives the iteration, but range variable p declared at line 18 is reused by all iterations */
+		ptrs = append(ptrs, &p.x)
+	}
+	return ptrs
+}
+
+func assignAddr(points []point, m map[int]*point, dst []*point) *point {
+	var last *point
+	for i, p := range points {
+		/*! &p outlives the iteration, but range variable p declared at line 27 is reused by all iterations */
+		m[i] = &p
+		/*! &p outlives the iteration, but range variable p declared at line 27 is reused by all iterations */
+		dst[i] = &p
+		/*! &p outlives the iteration, but range variable p declared at line 27 is reused by all iterations */
+		last = &p
+	}
+	return last
+}
+
+func sendAddr(points []point, ch chan *point) {
+	for _, p := range points {
+		/*! &p outlives the iteration, but range variable p declared at line 39 is reused by all iterations */
+		ch <- &p
+	}
+}
+
+func goCapture(items []string) {
+	for i, s := range items {
+		go func() {
+			/*! go closure captures range variable s declared at line 46 that is reused by all iterations */
+			println(s)
+			/*! go closure captures range variable i declared at line 46 that is reused by all iterations */
+			println(i, s)
+		}()
+	}
+}
+
+func deferCapture(items []string) {
+	for _, s := range items {
+		defer func() {
+			/*! defer closure captures range variable s declared at line 57 that is reused by all iterations */
+			println(s)
+		}()
+	}
+}
+
+func goArgAddr(points []point) {
+	for _, p := range points {
+		/*! &p outlives the iteration, but range variable p declared at line 66 is reused by all iterations */
+		go usePoint(&p)
+	}
+}
+
+func usePoint(p *point) {}
+
+func goInsideLambda(items []string, run func(func())) {
+	for _, s := range items {
+		run(func() {
+			go func() {
+				/*! go closure captures range variable s declared at line 75 that is reused by all iterations */
+				println(s)
+			}()
+		})
+	}
+}
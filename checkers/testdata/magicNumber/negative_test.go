@@ -0,0 +1,5 @@
+package checker_test_test
+
+func testValues() int {
+	return 42 * 7
+}
@@ -0,0 +1,94 @@
+package checker_test
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const maxRetries = 5
+
+const (
+	kb = 1 << 10
+	mb = kb * 1024
+)
+
+var defaultLimit = 50
+
+var buf [64]byte
+
+type header struct {
+	Size int `json:"size,omitempty" max:"300"`
+	Data [16]byte
+}
+
+func allowedNumbers(xs []int) int {
+	const local = 77
+	var y = 33
+	n := 0
+	n += 1
+	n -= 1
+	n *= 2
+	n = n*10 + xs[len(xs)-1]
+	return n / 100 * 1024 + local + y
+}
+
+func shifts(x uint) uint {
+	x <<= 3
+	return x<<4 | x>>12
+}
+
+func sleep() {
+	time.Sleep(250)
+	time.Sleep(5 * time.Second)
+	_ = time.Minute * 15
+	_ = "string literals 123"
+	_ = 'x'
+}
+
+type limits struct {
+	Timeout int
+	Retries int
+}
+
+var defaultLimits = limits{Timeout: 30, Retries: 5}
+
+type version struct {
+	major, minor int
+}
+
+var go1_21 = version{1, 21}
+
+func sizes(p []byte, b byte, flags uint) ([]byte, int64) {
+	buf := make([]byte, 0, 64)
+	ch := make(chan int, 8)
+	_ = ch
+	buf = append(buf, p[4:8]...)
+	buf = append(buf, p[3], b&0x7f, b|0x20, b^0xff)
+	flags &^= 0x3
+	n, _ := strconv.ParseInt(string(p[:4]), 16, 64)
+	return buf[:len(buf):32], n + int64(4<<10)
+}
+
+func versions() []version {
+	return []version{{1, 18}, {1, 20}}
+}
+
+func canCombine(x, y string) (threshold int, ok bool) {
+	switch {
+	case x != y:
+		return 0, false
+	case x == " ":
+		return 1, true
+	default:
+		return 4, true
+	}
+}
+
+func writeFile(data []byte) error {
+	return os.WriteFile("out.txt", data, 0600)
+}
+
+func smallCounts(args []string) bool {
+	return len(args) < 3
+}
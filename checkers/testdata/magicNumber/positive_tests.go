@@ -0,0 +1,51 @@
+package checker_test
+
+import "time"
+
+func retry(attempts int) bool {
+	/*! magic number 5, consider extracting it into a named constant */
+	return attempts > 5
+}
+
+func scale(x float64) float64 {
+	/*! magic number 3.14, consider extracting it into a named constant */
+	return x * 3.14
+}
+
+func offset(xs []int) int {
+	/*! magic number -42, consider extracting it into a named constant */
+	return xs[0] + -42
+}
+
+func withTimeout(d time.Duration) {}
+
+func calls() {
+	/*! magic number 4, consider extracting it into a named constant */
+	x := 4
+	/*! magic number 0x1F, consider extracting it into a named constant */
+	_ = make([]byte, 0, x+0x1F)
+	/*! magic number 7, consider extracting it into a named constant */
+	withTimeout(time.Duration(x * 7))
+}
+
+func mapKeys() map[int]string {
+	/*! magic number 404, consider extracting it into a named constant */
+	return map[int]string{404: "not found"}
+}
+
+func masks(b byte) bool {
+	/*! magic number 0x20, consider extracting it into a named constant */
+	return b > 0x20 && b&0x7f != 0
+}
+
+func threshold(op string) (n int, ok bool) {
+	weight := func() int {
+		/*! magic number 8, consider extracting it into a named constant */
+		return 8
+	}
+	if op == "" {
+		return 0, false
+	}
+	/*! magic number 6, consider extracting it into a named constant */
+	return weight() * 6, true
+}
@@ -0,0 +1,79 @@
+package checker_test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+func sortedKeys(m map[string]int) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedBySlice(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return m[keys[i]] < m[keys[j]] })
+	return keys
+}
+
+func setUsage(m map[string]int, name string) bool {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	for _, k := range keys {
+		if k == name {
+			return true
+		}
+	}
+	return false
+}
+
+func total(m map[string]int) int {
+	var values []int
+	for _, v := range m {
+		values = append(values, v)
+	}
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func sliceRange(list []string) []string {
+	var result []string
+	for _, s := range list {
+		result = append(result, s)
+	}
+	return result
+}
+
+func dumpDebug(m map[string]int) {
+	for k, v := range m {
+		fmt.Println(k, v)
+	}
+}
+
+type attrs map[string]string
+
+func (a attrs) String() string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%s=%s,", k, a[k])
+	}
+	return sb.String()
+}
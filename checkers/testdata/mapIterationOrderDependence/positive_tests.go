@@ -0,0 +1,52 @@
+package checker_test
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+func keysOf(m map[string]int) []string {
+	var keys []string
+	/*! keys is filled while ranging over map m and returned unsorted; map iteration order is random, sort keys first */
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func valuesOf(m map[int]string) (values []string) {
+	/*! values is filled while ranging over map m and returned unsorted; map iteration order is random, sort values first */
+	for _, v := range m {
+		values = append(values, strings.ToUpper(v))
+	}
+	return
+}
+
+func sameNames(m map[string]bool, want []string) bool {
+	names := make([]string, 0, len(m))
+	/*! names is filled while ranging over map m and compared unsorted; map iteration order is random, sort names first */
+	for name := range m {
+		names = append(names, name)
+	}
+	return reflect.DeepEqual(names, want)
+}
+
+type labels map[string]string
+
+func (l labels) String() string {
+	var sb strings.Builder
+	/*! fmt.Fprintf writes entries of map l in random iteration order; sort the keys first */
+	for k, v := range l {
+		fmt.Fprintf(&sb, "%s=%s,", k, v)
+	}
+	return sb.String()
+}
+
+func (l labels) MarshalText(w io.Writer) {
+	/*! io.WriteString writes entries of map l in random iteration order; sort the keys first */
+	for k := range l {
+		io.WriteString(w, k)
+	}
+}
@@ -0,0 +1,54 @@
+package checker_test
+
+func differentKeys(m map[string]user, a, b string) {
+	if m[a].active {
+		println(m[b].name)
+	}
+}
+
+func keyModified(m map[string]user, key string) {
+	if m[key].active {
+		key = "other"
+		println(m[key].name)
+	}
+}
+
+func mapModified(m map[string]int, key string) {
+	if m[key] > 0 {
+		m[key] = 0
+		println(m[key])
+	}
+	if m[key] > 0 {
+		delete(m, key)
+		println(m[key])
+	}
+	if m[key] > 0 {
+		m[key]++
+		println(m[key])
+	}
+}
+
+func commaOk(m map[string]int, key string) {
+	if v, ok := m[key]; ok {
+		println(v, m[key])
+	}
+}
+
+func sideEffects(m map[string]int, key func() string) {
+	println(m[key()], m[key()])
+}
+
+func notMap(xs []int, i int) {
+	println(xs[i], xs[i])
+}
+
+func bodyOnly(m map[string]int, key string, cond bool) {
+	if cond {
+		println(m[key])
+		println(m[key])
+	}
+}
+
+func lambda(m map[string]int, key string) {
+	_ = func() int { return m[key] }() + m[key]
+}
@@ -0,0 +1,42 @@
+package checker_test
+
+type user struct {
+	active bool
+	name   string
+}
+
+func ifCondAndBody(m map[string]user, key string) {
+	if m[key].active {
+		/*! m[key] lookup is repeated; store the result in a local variable */
+		println(m[key].name)
+	}
+}
+
+func sameStatement(m map[string]user, key string) {
+	/*! m[key] lookup is repeated; store the result in a local variable */
+	println(m[key].name, m[key].active)
+
+	/*! m["x"] lookup is repeated; store the result in a local variable */
+	s := m["x"].name + m["x"].name + m["x"].name
+	_ = s
+}
+
+func fieldMap(s *struct{ m map[int]int }, k int) {
+	/*! s.m[k+1] lookup is repeated; store the result in a local variable */
+	_ = s.m[k+1] + s.m[k+1]
+}
+
+func ifInitAndCond(m map[string]int, key string) {
+	if v := m[key]; v > 0 {
+		/*! m[key] lookup is repeated; store the result in a local variable */
+		println(m[key] * 2)
+	}
+}
+
+func ifBodyRepeats(m map[string]user, key string) {
+	if m[key].active {
+		/*! m[key] lookup is repeated; store the result in a local variable */
+		println(m[key].name,
+			m[key].active)
+	}
+}
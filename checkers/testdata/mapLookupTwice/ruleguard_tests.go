@@ -0,0 +1,12 @@
+package checker_test
+
+import (
+	"github.com/quasilyte/go-ruleguard/dsl"
+)
+
+func badLockRule(m dsl.Matcher) {
+	m.Match(`$mu1.Lock(); $mu2.Unlock()`).
+		Where(m["mu1"].Text == m["mu2"].Text).
+		Report(`defer is missing, mutex is unlocked immediately`).
+		At(m["mu2"])
+}
@@ -0,0 +1,18 @@
+package checker_test
+
+func divisionAsIntended(lo, hi int, items []string, size int64, weight float64) {
+	_ = items[(lo+hi)/2]
+	_ = float64(size / 1024)
+	_ = float64(size / 1000)
+	_ = float64(lo) / float64(hi)
+
+	_ = 1.0 / 2 * weight
+	_ = weight / 2
+	_ = 4 / 2 * weight
+	_ = lo * 3 / 2
+	_ = float64(4 / 2)
+
+	const half = 1 / 2
+	_ = half
+	_ = 10 / 5 * lo
+}
@@ -0,0 +1,29 @@
+package checker_test
+
+func divisionRatios(done, total int, size int64, weight float64) {
+	/*! float64(done / total) truncates the integer division before the conversion; use float64(done) / float64(total) */
+	_ = float64(done / total)
+
+	/*! float32((done + 1) / total) truncates the integer division before the conversion; use float32(done + 1) / float32(total) */
+	_ = float32((done + 1) / total)
+
+	/*! float64(done * 100 / total) truncates the percentage before the conversion; use float64(done) * 100 / float64(total) */
+	percent := float64(done * 100 / total)
+	_ = percent
+
+	/*! float64(size / 3) truncates the integer division before the conversion; use float64(size) / float64(3) */
+	_ = float64(size / 3)
+
+	/*! 1 / 2 constant-folds to 0 with the integer division; use 1.0 / 2 */
+	_ = 1 / 2 * weight
+
+	/*! 2 / 3 constant-folds to 0 with the integer division; use 2.0 / 3 */
+	var ratio float64 = 2 / 3
+	_ = ratio
+
+	/*! 3 / 2 constant-folds to 1, so the product is truncated; multiply first or use the float constant */
+	_ = total * (3 / 2)
+
+	/*! 1 / 2 constant-folds to 0, so the product is truncated; multiply first or use the float constant */
+	_ = 1 / 2 * done
+}
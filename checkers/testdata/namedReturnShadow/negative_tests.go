@@ -0,0 +1,49 @@
+package checker_test
+
+func fetch() ([]byte, error) { return nil, nil }
+
+func explicitReturn(cached bool) (data []byte, err error) {
+	if cached {
+		data, err := fetch()
+		return data, err
+	}
+	return nil, nil
+}
+
+func assignsNamed(cached bool) (data []byte, err error) {
+	if cached {
+		data, err = fetch()
+	}
+	return
+}
+
+func bareReturnBefore(ok bool) (data []byte, err error) {
+	if !ok {
+		return
+	}
+	if ok {
+		data, err := fetch()
+		_, _ = data, err
+	}
+	return data, nil
+}
+
+func closureResults() (err error) {
+	f := func() (err error) {
+		if true {
+			_, err := fetch()
+			_ = err
+		}
+		return nil
+	}
+	err = f()
+	return
+}
+
+func unnamedResults() ([]byte, error) {
+	if true {
+		data, err := fetch()
+		_, _ = data, err
+	}
+	return nil, nil
+}
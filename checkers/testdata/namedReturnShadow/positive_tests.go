@@ -0,0 +1,24 @@
+package checker_test
+
+func read() ([]byte, error) { return nil, nil }
+
+func load(cached bool) (data []byte, err error) {
+	if cached {
+		/*! data shadows the named result, the bare return at line 11 returns the outer data; use = to assign it */
+		data, err := read()
+		_, _ = data, err
+	}
+	return
+}
+
+func loadLoop(n int) (total int, err error) {
+	for i := 0; i < n; i++ {
+		/*! err shadows the named result, the bare return at line 23 returns the outer err; use = to assign it */
+		_, err := read()
+		if err != nil {
+			break
+		}
+		total++
+	}
+	return
+}
@@ -0,0 +1,65 @@
+package checker_test
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+func NewCachePtr() *Cache {
+	return &Cache{}
+}
+
+func newCache() Cache {
+	return Cache{}
+}
+
+func MakeCache() Cache {
+	return Cache{}
+}
+
+type Config struct {
+	Name string
+	mu   *sync.Mutex
+}
+
+func NewConfig() Config {
+	return Config{}
+}
+
+type Snapshot struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+func (s *Snapshot) Clone() *Snapshot {
+	return &Snapshot{data: s.data}
+}
+
+func NewSnapshot() Snapshot {
+	return Snapshot{}
+}
+
+type Counter struct {
+	n atomic.Int64
+}
+
+func NewCounter() Counter {
+	return Counter{}
+}
+
+func NewMutex() sync.Mutex {
+	return sync.Mutex{}
+}
+
+type Journal struct {
+	logger log.Logger
+}
+
+func NewJournal() Journal {
+	return Journal{}
+}
+
+func (c *Cache) NewView() Cache {
+	return Cache{}
+}
@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Cache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+
+/*! NewCache returns Cache by value, but its mu field is sync.Mutex that must not be copied; return *Cache instead */
+func NewCache() Cache {
+	return Cache{items: map[string]string{}}
+}
+
+type Registry struct {
+	sync.RWMutex
+	names []string
+}
+
+/*! NewRegistry returns Registry by value, but its RWMutex field is sync.RWMutex that must not be copied; return *Registry instead */
+func NewRegistry() (Registry, error) {
+	return Registry{}, nil
+}
+
+type pool struct {
+	workers [4]struct {
+		wg sync.WaitGroup
+	}
+}
+
+/*! NewPool returns pool by value, but its workers.wg field is sync.WaitGroup that must not be copied; return *pool instead */
+func NewPool() pool {
+	return pool{}
+}
+
+type Stats struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+/*! NewStats returns Stats by value, but its hits field is atomic.Int64 that must not be copied; return *Stats instead */
+func NewStats() Stats {
+	return Stats{}
+}
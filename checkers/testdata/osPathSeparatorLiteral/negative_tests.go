@@ -0,0 +1,50 @@
+package checker_test
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+var assets embed.FS
+
+func joinedPaths(dir, name string) {
+	os.Open(filepath.Join(dir, name))
+	os.Open("/etc/hosts")
+	os.Open(dir + ".json")
+	os.ReadFile(fmt.Sprintf("%s.json", name))
+	os.Stat("config/" + "app.json")
+}
+
+func urls(host, name string) {
+	http.Get("https://" + host + "/" + name)
+	os.Open("file://" + host + "/" + name)
+	os.Open(fmt.Sprintf("http://%s/%s", host, name))
+}
+
+func fsPaths(fsys fs.FS, dir, name string) {
+	fsys.Open(dir + "/" + name)
+	fs.ReadFile(fsys, dir+"/"+name)
+	assets.ReadFile("testdata/" + name)
+	path.Join(dir+"/"+name, "x")
+
+	p := dir + "/" + name
+	fs.Stat(fsys, p)
+	filepath.Base(p)
+}
+
+func reassignedPath(dir, name string) {
+	p := dir + "/" + name
+	p = filepath.Join(dir, name)
+	os.Open(p)
+}
+
+func httpPath(w http.ResponseWriter, r *http.Request, dir, name string) {
+	p := dir + "/" + name
+	http.ServeFile(w, r, p)
+	os.Stat(p)
+}
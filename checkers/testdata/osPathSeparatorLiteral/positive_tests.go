@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const configDir = "config/"
+
+func openConfig(dir, name string) {
+	/*! dir + "/" + name builds a file path for os.Open with the hardcoded "/" separator; use filepath.Join instead */
+	os.Open(dir + "/" + name)
+
+	/*! fmt.Sprintf("%s/%s.json", dir, name) builds a file path for os.ReadFile with the hardcoded "/" separator; use filepath.Join instead */
+	os.ReadFile(fmt.Sprintf("%s/%s.json", dir, name))
+
+	/*! configDir + name builds a file path for os.Stat with the hardcoded "/" separator; use filepath.Join instead */
+	os.Stat(configDir + name)
+
+	/*! dir + "\\" + name builds a file path for os.Create with the hardcoded "\\" separator; use filepath.Join instead */
+	os.Create(dir + "\\" + name)
+
+	/*! dir + "/logs" builds a file path for os.MkdirAll with the hardcoded "/" separator; use filepath.Join instead */
+	os.MkdirAll((dir + "/logs"), 0755)
+}
+
+func buildThenOpen(dir, name string) {
+	/*! dir + "/" + name builds a file path for ioutil.ReadFile with the hardcoded "/" separator; use filepath.Join instead */
+	path := dir + "/" + name
+	ioutil.ReadFile(path)
+}
+
+func filepathFuncs(root, name string) {
+	/*! root + "/" + name builds a file path for filepath.Abs with the hardcoded "/" separator; use filepath.Join instead */
+	filepath.Abs(root + "/" + name)
+
+	/*! fmt.Sprintf("%s/bin", root) builds a file path for filepath.Join with the hardcoded "/" separator; use filepath.Join instead */
+	filepath.Join(fmt.Sprintf("%s/bin", root), name)
+}
+
+func renamePaths(dir, from, to string) {
+	/*! dir + "/" + from builds a file path for os.Rename with the hardcoded "/" separator; use filepath.Join instead */
+	/*! dir + "/" + to builds a file path for os.Rename with the hardcoded "/" separator; use filepath.Join instead */
+	os.Rename(dir+"/"+from, dir+"/"+to)
+}
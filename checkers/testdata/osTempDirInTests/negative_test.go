@@ -0,0 +1,30 @@
+package checker_test_test
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "main")
+	if err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestCustomParent(t *testing.T) {
+	parent := t.TempDir()
+	dir, err := os.MkdirTemp(parent, "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = dir
+}
+
+func notATest() {
+	dir, _ := os.MkdirTemp("", "x")
+	_ = dir
+}
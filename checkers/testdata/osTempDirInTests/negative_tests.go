@@ -0,0 +1,21 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// Non-test files are not checked.
+func tempDirHelper(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func tempDirNoTesting() string {
+	dir, _ := ioutil.TempDir("", "x")
+	return dir
+}
@@ -0,0 +1,93 @@
+package checker_test_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestMkdirTemp(t *testing.T) {
+	/*! os.MkdirTemp("", "x") can be replaced with t.TempDir(), which is removed automatically when the test ends */
+	dir, err := os.MkdirTemp("", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	_ = dir
+}
+
+func BenchmarkTempDir(b *testing.B) {
+	/*! ioutil.TempDir(os.TempDir(), "bench") can be replaced with b.TempDir(), which is removed automatically when the test ends */
+	dir, err := ioutil.TempDir(os.TempDir(), "bench")
+	if err != nil {
+		b.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	_ = dir
+}
+
+func TestErrReused(t *testing.T) {
+	/*! os.MkdirTemp("", "x") can be replaced with t.TempDir(), which is removed automatically when the test ends */
+	dir, err := os.MkdirTemp("", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.WriteFile(dir+"/a", nil, 0600)
+	_ = err
+}
+
+func TestDirDeclared(t *testing.T) {
+	var dir string
+	/*! os.MkdirTemp("", "x") can be replaced with t.TempDir(), which is removed automatically when the test ends */
+	dir, err := os.MkdirTemp("", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	_ = dir
+}
+
+func TestErrDeclared(t *testing.T) {
+	var err error
+	/*! os.MkdirTemp("", "x") can be replaced with t.TempDir(), which is removed automatically when the test ends */
+	dir, err := os.MkdirTemp("", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	_ = dir
+}
+
+func TestNoDefer(t *testing.T) {
+	/*! os.MkdirTemp("", "x") can be replaced with t.TempDir(), which is removed automatically when the test ends */
+	dir, _ := os.MkdirTemp("", "x")
+	_ = dir
+}
+
+func TestCreateTemp(t *testing.T) {
+	/*! os.CreateTemp("", "file") can use t.TempDir() as its directory, which is removed automatically when the test ends */
+	f, err := os.CreateTemp("", "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+}
+
+func TestSubtest(t *testing.T) {
+	t.Run("sub", func(st *testing.T) {
+		/*! os.MkdirTemp("", "sub") can be replaced with st.TempDir(), which is removed automatically when the test ends */
+		dir, err := os.MkdirTemp("", "sub")
+		if err != nil {
+			st.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		_ = dir
+	})
+}
+
+func tempHelper(tb testing.TB) string {
+	/*! ioutil.TempDir("", "helper") can be replaced with tb.TempDir(), which is removed automatically when the test ends */
+	dir, _ := ioutil.TempDir("", "helper")
+	return dir
+}
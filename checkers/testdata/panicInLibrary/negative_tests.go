@@ -0,0 +1,77 @@
+package checker_test
+
+import (
+	"errors"
+	"regexp"
+)
+
+func MustParse(s string) *regexp.Regexp {
+	re, err := regexp.Compile(s)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+var defaultRE *regexp.Regexp
+
+func init() {
+	re, err := regexp.Compile("x+")
+	if err != nil {
+		panic(err)
+	}
+	defaultRE = re
+}
+
+func internalOnly() {
+	panic("unreachable from exported API")
+}
+
+var errAbort = errors.New("abort")
+
+type parseError struct{ msg string }
+
+func Decode(s string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case *parseError:
+				err = errors.New(r.msg)
+			default:
+				if r == errAbort {
+					err = errAbort
+					return
+				}
+				panic(r)
+			}
+		}
+	}()
+	decode(s)
+	return nil
+}
+
+func decode(s string) {
+	if s == "" {
+		panic(errAbort)
+	}
+	if s == "?" {
+		panic(&parseError{msg: "bad input"})
+	}
+}
+
+type Color int
+
+const invalidColor = "invalid color"
+
+func (c Color) String() string {
+	switch c {
+	case 0:
+		return "red"
+	case 1:
+		return "green"
+	}
+	if c < 0 {
+		panic(invalidColor)
+	}
+	panic("unreachable")
+}
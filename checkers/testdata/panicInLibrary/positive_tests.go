@@ -0,0 +1,42 @@
+package checker_test
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Config struct{}
+
+func Parse(s string) *Config {
+	if s == "" {
+		/*! panic in exported Parse crashes the caller; return an error instead */
+		panic(errors.New("empty config"))
+	}
+	return parseConfig(s)
+}
+
+func parseConfig(s string) *Config {
+	if len(s) > 100 {
+		/*! panic in parseConfig, called from exported Parse, crashes the caller; return an error instead */
+		panic(fmt.Sprintf("config is too long: %d", len(s)))
+	}
+	return &Config{}
+}
+
+func (c *Config) Load(path string) {
+	if err := load(path); err != nil {
+		/*! panic in exported Load crashes the caller; return an error instead */
+		panic(err)
+	}
+}
+
+func load(path string) error { return errors.New("not implemented") }
+
+var errUnhandled = errors.New("unhandled")
+
+func Run() {
+	go func() {
+		/*! panic in exported Run crashes the caller; return an error instead */
+		panic(errUnhandled)
+	}()
+}
@@ -0,0 +1,43 @@
+package checker_test
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetenvSerial(t *testing.T) {
+	t.Setenv("MODE", "test")
+	os.Setenv("OTHER", "x")
+	os.Chdir("testdata")
+}
+
+func TestParallelNoMutation(t *testing.T) {
+	t.Parallel()
+	_ = os.Getenv("MODE")
+	t.Run("sub", func(t *testing.T) {
+		t.Parallel()
+		_ = os.Getwd
+	})
+}
+
+func TestSiblingSubtests(t *testing.T) {
+	t.Run("serial", func(t *testing.T) {
+		t.Setenv("MODE", "test")
+	})
+	t.Run("parallel", func(t *testing.T) {
+		t.Parallel()
+	})
+}
+
+func TestHelperWithoutT(t *testing.T) {
+	t.Parallel()
+	readMode()
+}
+
+func readMode() string {
+	return os.Getenv("MODE")
+}
+
+func notATest() {
+	os.Setenv("MODE", "test")
+}
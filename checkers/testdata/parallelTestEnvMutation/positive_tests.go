@@ -0,0 +1,76 @@
+package checker_test
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParallelSetenv(t *testing.T) {
+	t.Parallel()
+	/*! os.Setenv("MODE", "test") mutates process-global state in a parallel test and races with other tests */
+	os.Setenv("MODE", "test")
+}
+
+func TestParallelSubtestChdir(t *testing.T) {
+	/*! os.Chdir("testdata") mutates process-global state in a parallel test and races with other tests */
+	os.Chdir("testdata")
+	t.Run("sub", func(t *testing.T) {
+		t.Parallel()
+	})
+}
+
+func TestSubtestUnsetenv(t *testing.T) {
+	t.Parallel()
+	t.Run("sub", func(st *testing.T) {
+		/*! os.Unsetenv("MODE") mutates process-global state in a parallel test and races with other tests */
+		os.Unsetenv("MODE")
+	})
+}
+
+func TestSetenvParallel(t *testing.T) {
+	/*! t.Setenv("MODE", "test") panics at runtime in a parallel test */
+	t.Setenv("MODE", "test")
+	t.Parallel()
+}
+
+func TestSetenvParallelParent(t *testing.T) {
+	t.Parallel()
+	t.Run("sub", func(st *testing.T) {
+		/*! st.Setenv("MODE", "test") panics at runtime in a parallel test */
+		st.Setenv("MODE", "test")
+	})
+}
+
+func TestSetenvParallelChild(t *testing.T) {
+	/*! t.Setenv("MODE", "test") panics at runtime in a parallel test */
+	t.Setenv("MODE", "test")
+	t.Run("sub", func(st *testing.T) {
+		st.Parallel()
+	})
+}
+
+func TestHelperSetenv(t *testing.T) {
+	t.Parallel()
+	/*! setMode(t, "test") calls os.Setenv, which mutates process-global state in a parallel test and races with other tests */
+	setMode(t, "test")
+	/*! setModeT(t, "test") calls tb.Setenv, which panics at runtime in a parallel test */
+	setModeT(t, "test")
+}
+
+func TestHelperParallel(t *testing.T) {
+	markParallel(t)
+	/*! os.Setenv("MODE", "test") mutates process-global state in a parallel test and races with other tests */
+	os.Setenv("MODE", "test")
+}
+
+func setMode(t *testing.T, mode string) {
+	os.Setenv("MODE", mode)
+}
+
+func setModeT(tb testing.TB, mode string) {
+	tb.Setenv("MODE", mode)
+}
+
+func markParallel(t *testing.T) {
+	t.Parallel()
+}
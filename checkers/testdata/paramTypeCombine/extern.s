@@ -0,0 +1 @@
+// The assembly file permits the function declarations without bodies.
@@ -0,0 +1,15 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+/*! type parameters [T any, U any] could be replaced with [T, U any] */
+func genericPair[T any, U any](x T, y U) {}
+
+/*! type parameters [K comparable, V any, W any] could be replaced with [K comparable, V, W any] */
+/*! func[K comparable, V any, W any](m map[K]V, w W, k K, k2 K) could be replaced with func[K comparable, V any, W any](m map[K]V, w W, k, k2 K) */
+func genericMixed[K comparable, V any, W any](m map[K]V, w W, k K, k2 K) {}
+
+func genericGood[T, U any](x, y T, z U) {}
+
+func genericCommented[T any /* the key */, U any](x T, y U) {}
@@ -25,3 +25,7 @@ func multiline(
 ) int {
 	return 0
 }
+
+func commentSeparated(a int /* the first one */, b int) {}
+
+func commentSeparatedResults() (x int, /* the second one */ y int) { return 0, 0 }
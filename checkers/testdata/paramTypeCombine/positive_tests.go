@@ -44,3 +44,11 @@ func withBlank1(_, _ int, _ int, _ int32) {}
 
 /*! func() (_, _ int, _ int, _ int32) could be replaced with func() (_, _, _ int, _ int32) */
 func withBlank2() (_, _ int, _ int, _ int32) { return }
+
+/*! func(a int, b int, name string) could be replaced with func(a, b int, name string) */
+func withComment(a int, b int, name string /* the display name */) {}
+
+/*! func(a int, b int) (x int, y int) could be replaced with func(a, b int) (x, y int) */
+func withResults(a int, b int) (x int, y int) {
+	return a, b
+}
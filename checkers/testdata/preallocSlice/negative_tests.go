@@ -0,0 +1,98 @@
+package checker_test
+
+import "testing"
+
+func preallocated(src []item) []string {
+	names := make([]string, 0, len(src))
+	for _, x := range src {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func notEmpty(src []item) []string {
+	names := []string{"first"}
+	for _, x := range src {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func notImmediatelyBefore(src []item) []string {
+	var names []string
+	names = append(names, "first")
+	for _, x := range src {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func channelSource(src chan item) []string {
+	var names []string
+	for x := range src {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func multipleAppends(src []item) []string {
+	var names []string
+	for _, x := range src {
+		names = append(names, x.name)
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func variadicAppend(src [][]string) []string {
+	var names []string
+	for _, x := range src {
+		names = append(names, x...)
+	}
+	return names
+}
+
+func nestedLoop(src [][]string) []string {
+	var names []string
+	for _, xs := range src {
+		for _, x := range xs {
+			names = append(names, x)
+		}
+	}
+	return names
+}
+
+func otherUses(src []item) []string {
+	var names []string
+	for _, x := range src {
+		if len(names) > 10 {
+			break
+		}
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func nilMap(src []item) map[int]string {
+	var byID map[int]string
+	for _, x := range src {
+		byID[x.id] = x.name
+	}
+	return byID
+}
+
+func rangeOverCall(src func() []item) []string {
+	var names []string
+	for _, x := range src() {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func TestNames(t *testing.T) {
+	names := []string{"a", "b"}
+	m := make(map[string]bool)
+	for _, name := range names {
+		m[name] = true
+	}
+}
@@ -0,0 +1,73 @@
+package checker_test
+
+type item struct {
+	name string
+	id   int
+}
+
+func varSlice(src []item) []string {
+	/*! names can be preallocated with make([]string, 0, len(src)) */
+	var names []string
+	for _, x := range src {
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func emptyLit(src []item) []int {
+	/*! ids can be preallocated with make([]int, 0, len(src)) */
+	ids := []int{}
+	for _, x := range src {
+		ids = append(ids, x.id)
+	}
+	return ids
+}
+
+func emptyMake(src map[string]item) []item {
+	/*! items can be preallocated with make([]item, 0, len(src)) */
+	items := make([]item, 0)
+	for _, x := range src {
+		items = append(items, x)
+	}
+	return items
+}
+
+func mapFill(src []item) map[int]string {
+	/*! byID can be preallocated with make(map[int]string, len(src)) */
+	byID := map[int]string{}
+	for _, x := range src {
+		byID[x.id] = x.name
+	}
+	return byID
+}
+
+func mapMake(src []item) map[string]bool {
+	/*! set can be preallocated with make(map[string]bool, len(src)) */
+	set := make(map[string]bool)
+	for _, x := range src {
+		set[x.name] = true
+	}
+	return set
+}
+
+func conditional(src []item) []string {
+	// Capacity is an upper bound, but it's still useful.
+	/*! names can be preallocated with make([]string, 0, len(src)) */
+	var names []string
+	for _, x := range src {
+		if x.id == 0 {
+			continue
+		}
+		names = append(names, x.name)
+	}
+	return names
+}
+
+func arraySource(src [4]int) []int {
+	/*! xs can be preallocated with make([]int, 0, len(src)) */
+	var xs []int
+	for _, x := range src {
+		xs = append(xs, x*2)
+	}
+	return xs
+}
@@ -0,0 +1,30 @@
+package checker_test
+
+import "fmt"
+
+func printf(format string, args ...interface{}) {}
+
+func wrapf(format string, args ...interface{}) error {
+	return fmt.Errorf(format, args...)
+}
+
+func goodCalls(name string, n int, err error, l *logger, args []interface{}) {
+	logf("user %s logged in %d times", name, n)
+	logf("100%% done")
+	logf("%[1]s and %[1]q", name)
+	logf("%*d items", 8, n)
+	logf("%-8.2f|%+v|%#x", 1.5, name, n)
+	logf("%v", err)
+	logf("%s", args...)
+	l.Printf("%s", name)
+
+	format := "%s %s"
+	logf(format, name)
+
+	printf("%d", name)
+
+	_ = wrapErrorf(err, "open %s: %w", name, err)
+
+	// wrapf is not configured.
+	_ = wrapf("%d", name)
+}
@@ -0,0 +1,45 @@
+package checker_test
+
+import "fmt"
+
+func logf(format string, args ...interface{}) {}
+
+func wrapErrorf(err error, format string, args ...interface{}) error {
+	return fmt.Errorf(format+": %w", append(args, err)...)
+}
+
+type logger struct{}
+
+func (l *logger) Printf(format string, args ...interface{}) {}
+
+func badCalls(name, addr string, n int, err error, l *logger) {
+	/*! logf format "user %s logged in from %s" reads 2 args, but the call has 1 */
+	logf("user %s logged in from %s", name)
+
+	/*! logf format "done" reads 0 args, but the call has 1 */
+	logf("done", n)
+
+	/*! logf format "%*d items" reads 2 args, but the call has 1 */
+	logf("%*d items", n)
+
+	/*! logf verb %d is used with string argument name */
+	logf("user %d", name)
+
+	/*! logf doesn't wrap errors, %w is only supported by fmt.Errorf-like functions; use %v */
+	logf("failed: %w", err)
+
+	/*! Printf verb %[3]s refers to arg 3, but the call has only 2 */
+	l.Printf("%[1]s %[2]s %[3]s", name, addr)
+
+	/*! Printf format "%d%%" reads 1 args, but the call has 0 */
+	l.Printf("%d%%")
+
+	/*! logf format is malformed: missing verb in "%" at the end of the format */
+	logf("progress 100%", n)
+
+	/*! logf format is malformed: bad argument index [x] */
+	logf("%[x]d", n)
+
+	/*! wrapErrorf verb %d is used with string argument addr */
+	_ = wrapErrorf(err, "dial %d", addr)
+}
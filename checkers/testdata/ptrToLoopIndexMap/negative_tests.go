@@ -0,0 +1,80 @@
+package checker_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+func readCopies(readers []io.Reader) [][]byte {
+	var chunks [][]byte
+	buf := make([]byte, 64)
+	for _, r := range readers {
+		n, _ := r.Read(buf)
+		chunks = append(chunks, append([]byte(nil), buf[:n]...))
+		chunks = append(chunks, bytes.Clone(buf[:n]))
+	}
+	return chunks
+}
+
+func readStrings(readers []io.Reader) []string {
+	var out []string
+	buf := make([]byte, 64)
+	for _, r := range readers {
+		n, _ := r.Read(buf)
+		out = append(out, string(buf[:n]))
+	}
+	return out
+}
+
+func freshBuffers(readers []io.Reader) [][]byte {
+	var chunks [][]byte
+	var buf []byte
+	for _, r := range readers {
+		buf = make([]byte, 64)
+		n, _ := r.Read(buf)
+		chunks = append(chunks, buf[:n])
+	}
+	return chunks
+}
+
+func perIterationBuffers(readers []io.Reader) [][]byte {
+	var chunks [][]byte
+	for _, r := range readers {
+		buf := make([]byte, 64)
+		n, _ := r.Read(buf)
+		chunks = append(chunks, buf[:n])
+	}
+	return chunks
+}
+
+func storeValues(lines [][]byte) []entry {
+	var entries []entry
+	var e entry
+	for _, line := range lines {
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func sharedConfig(keys []string) map[string]*entry {
+	index := make(map[string]*entry)
+	defaults := entry{Key: "default"}
+	for _, key := range keys {
+		index[key] = &defaults
+	}
+	return index
+}
+
+func spreadBuffer(readers []io.Reader) []byte {
+	var all []byte
+	buf := make([]byte, 64)
+	for _, r := range readers {
+		n, _ := r.Read(buf)
+		all = append(all, buf[:n]...)
+	}
+	return all
+}
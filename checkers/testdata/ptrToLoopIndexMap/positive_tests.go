@@ -0,0 +1,73 @@
+package checker_test
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type entry struct {
+	Key   string
+	Value int
+}
+
+func readChunks(readers []io.Reader) [][]byte {
+	var chunks [][]byte
+	buf := make([]byte, 64)
+	for _, r := range readers {
+		n, _ := r.Read(buf)
+		/*! buf[:n] stored in chunks aliases buf that is reused by all iterations, every entry sees the last iteration's data; store a copy instead */
+		chunks = append(chunks, buf[:n])
+	}
+	return chunks
+}
+
+func readByName(readers map[string]io.Reader) map[string][]byte {
+	byName := make(map[string][]byte)
+	buf := make([]byte, 64)
+	for name, r := range readers {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			continue
+		}
+		/*! buf stored in byName aliases buf that is reused by all iterations, every entry sees the last iteration's data; store a copy instead */
+		byName[name] = buf
+	}
+	return byName
+}
+
+func decodeEntries(lines [][]byte) []*entry {
+	var entries []*entry
+	var e entry
+	for _, line := range lines {
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		/*! &e stored in entries aliases e that is reused by all iterations, every entry sees the last iteration's data; store a copy instead */
+		entries = append(entries, &e)
+	}
+	return entries
+}
+
+func indexEntries(keys []string) map[string]*entry {
+	index := make(map[string]*entry, len(keys))
+	var e entry
+	for i, key := range keys {
+		e.Key = key
+		e.Value = i
+		/*! &e stored in index aliases e that is reused by all iterations, every entry sees the last iteration's data; store a copy instead */
+		index[key] = &e
+	}
+	return index
+}
+
+func copyRows(rows [][]int) [][]int {
+	out := make([][]int, len(rows))
+	var row [4]int
+	for i := 0; i < len(rows); i++ {
+		copy(row[:], rows[i])
+		for j := range rows[i] {
+			/*! row[:] stored in out aliases row that is reused by all iterations, every entry sees the last iteration's data; store a copy instead */
+			out[j] = row[:]
+		}
+	}
+	return out
+}
@@ -0,0 +1,13 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+func genericElems[T any](xs [][4]T) int {
+	// OK: the element size is unknown.
+	n := 0
+	for _, x := range xs {
+		n += len(x)
+	}
+	return n
+}
@@ -39,3 +39,31 @@ func bigPointers(xs []*bigObject) int32 {
 	}
 	return v
 }
+
+func bigModified(xs []bigObject) int32 {
+	// OK: the copy is modified, so it's required.
+	v := int32(0)
+	for _, x := range xs {
+		x.x++
+		v += x.x
+	}
+	return v
+}
+
+func bigAddrTaken(xs []bigObject) {
+	// OK: the copy address is taken.
+	var ptrs []*bigObject
+	for _, x := range xs {
+		ptrs = append(ptrs, &x)
+	}
+	_ = ptrs
+}
+
+func bigGoroutine(xs []bigObject, ch chan int32) {
+	// OK: the copy is used by the goroutine.
+	for _, x := range xs {
+		go func() {
+			ch <- x.x
+		}()
+	}
+}
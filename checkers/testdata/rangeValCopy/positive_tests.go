@@ -29,3 +29,44 @@ func bigCopy(xs []bigObject) int32 {
 	}
 	return v
 }
+
+func bigCopyKey(xs []bigObject) int32 {
+	v := int32(0)
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for i, x := range xs {
+		v += x.x + int32(i)
+	}
+	return v
+}
+
+func bigArrayCopy(xs *[4]bigObject) int32 {
+	v := int32(0)
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		if x.y != 0 {
+			v += x.x / x.y
+		}
+	}
+	return v
+}
+
+func bigCopyClosure(xs []bigObject) int32 {
+	v := int32(0)
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for _, x := range xs {
+		func() {
+			v += x.x
+		}()
+	}
+	return v
+}
+
+func bigCopySliceMutated(xs []bigObject) int32 {
+	v := int32(0)
+	/*! each iteration copies 1032 bytes (consider pointers or indexing) */
+	for i, x := range xs {
+		xs[i].x = 0
+		v += x.x
+	}
+	return v
+}
@@ -0,0 +1,11 @@
+package checker_test
+
+type Client struct{}
+
+func (c *Client) Do() {}
+
+func (c Client) String() string { return "" }
+
+func (_ *Client) Reset() {}
+
+func (*Client) Close() {}
@@ -0,0 +1,25 @@
+package checker_test
+
+type Server struct{}
+
+func (s *Server) Start() {}
+
+func (s *Server) Stop() {}
+
+/*! method Server.Restart uses receiver name srv, while other methods use s */
+func (srv *Server) Restart() {}
+
+/*! receiver name this should reflect the receiver type; don't use generic names like this or self */
+func (this *Server) Addr() string { return "" }
+
+type point struct{ x, y int }
+
+/*! receiver name self should reflect the receiver type; don't use generic names like this or self */
+func (self point) X() int { return self.x }
+
+type counter int
+
+func (n counter) Inc() counter { return n + 1 }
+
+/*! method counter.Dec uses receiver name cnt, while other methods use n */
+func (cnt counter) Dec() counter { return cnt - 1 }
@@ -0,0 +1,4 @@
+package checker_test
+
+/*! method Server.Close uses receiver name server, while other methods use s */
+func (server Server) Close() error { return nil }
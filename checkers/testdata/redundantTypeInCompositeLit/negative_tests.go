@@ -0,0 +1,33 @@
+package checker_test
+
+type vertex struct{ x, y int }
+
+type otherVertex vertex
+
+type shape interface{}
+
+func elidedTypes() {
+	_ = []vertex{{1, 2}, {3, 4}}
+	_ = map[string]vertex{"a": {1, 2}}
+}
+
+func pointerElems() {
+	_ = []*vertex{&vertex{1, 2}}
+}
+
+func interfaceElems() {
+	_ = []shape{vertex{1, 2}}
+	_ = []interface{}{vertex{1, 2}}
+	_ = map[string]interface{}{"a": vertex{}}
+}
+
+func differentTypes() {
+	_ = []vertex{vertex(otherVertex{1, 2})}
+}
+
+func structFields() {
+	type segment struct {
+		from, to vertex
+	}
+	_ = segment{from: vertex{1, 2}, to: vertex{3, 4}}
+}
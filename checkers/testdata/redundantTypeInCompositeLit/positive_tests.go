@@ -0,0 +1,72 @@
+package checker_test
+
+type point struct{ x, y int }
+
+type pointAlias = point
+
+type option struct {
+	name  string
+	value int
+}
+
+func sliceElems() {
+	_ = []point{
+		/*! redundant type point in composite literal element can be elided */
+		point{1, 2},
+		/*! redundant type point in composite literal element can be elided */
+		point{3, 4},
+	}
+
+	_ = [...]point{
+		/*! redundant type point in composite literal element can be elided */
+		point{x: 1},
+	}
+
+	_ = []pointAlias{
+		/*! redundant type point in composite literal element can be elided */
+		point{},
+	}
+}
+
+func mapElems() {
+	_ = map[string]option{
+		/*! redundant type option in composite literal element can be elided */
+		"a": option{name: "a"},
+		"b": {name: "b"},
+	}
+
+	_ = map[point]string{
+		/*! redundant type point in composite literal element can be elided */
+		point{0, 0}: "origin",
+	}
+}
+
+func nestedLits() {
+	_ = [][]point{
+		/*! redundant type []point in composite literal element can be elided */
+		[]point{
+			/*! redundant type point in composite literal element can be elided */
+			point{1, 2},
+		},
+		{
+			/*! redundant type point in composite literal element can be elided */
+			point{3, 4},
+		},
+	}
+
+	_ = map[string][]option{
+		/*! redundant type []option in composite literal element can be elided */
+		"opts": []option{
+			// First option.
+			/*! redundant type option in composite literal element can be elided */
+			option{name: "first"}, // Trailing comment.
+		},
+	}
+}
+
+func commentBeforeBrace() {
+	_ = []point{
+		/*! redundant type point in composite literal element can be elided */
+		point /* origin */ {0, 0},
+	}
+}
@@ -0,0 +1,39 @@
+package checker_test
+
+import (
+	"reflect"
+)
+
+type point struct {
+	x, y int
+}
+
+func differentArgs(a, b []point) bool {
+	return reflect.DeepEqual(a[0], b[0])
+}
+
+func sideEffects(next func() [][]int) bool {
+	return reflect.DeepEqual(next(), next())
+}
+
+func nonComparableElems(a, b [][]int, m1, m2 map[string][]int) {
+	_ = reflect.DeepEqual(a, b)
+	_ = reflect.DeepEqual(m1, m2)
+}
+
+func differentTypes(a []string, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func structs(p1, p2 *point) bool {
+	return reflect.DeepEqual(p1, p2)
+}
+
+type otherDeepEqual struct{}
+
+func (otherDeepEqual) DeepEqual(x, y interface{}) bool { return false }
+
+func notReflect(f func()) bool {
+	var r otherDeepEqual
+	return r.DeepEqual(f, f)
+}
@@ -0,0 +1,54 @@
+package checker_test
+
+import (
+	"errors"
+	"reflect"
+)
+
+var errNotFound = errors.New("not found")
+
+type handler struct {
+	name     string
+	callback func()
+}
+
+type route struct {
+	path string
+	h    *handler
+}
+
+func sameArgs(a []int) {
+	/*! reflect.DeepEqual compares a with itself, the result is always true */
+	_ = reflect.DeepEqual(a, a)
+}
+
+func errorArgs(err error) {
+	/*! reflect.DeepEqual on error err compares the error internals; use errors.Is or compare the messages explicitly */
+	_ = reflect.DeepEqual(err, errNotFound)
+}
+
+func funcArgs(f, g func()) {
+	/*! f is a func, reflect.DeepEqual on funcs is only true if both are nil */
+	_ = reflect.DeepEqual(f, g)
+}
+
+func funcFields(x, y handler, r1, r2 []route) {
+	/*! x contains func field callback, reflect.DeepEqual is false unless it is nil in both values */
+	_ = reflect.DeepEqual(x, y)
+
+	/*! r1 contains func field h.callback, reflect.DeepEqual is false unless it is nil in both values */
+	_ = reflect.DeepEqual(r1, r2)
+}
+
+func byteSlices(got, want []byte) bool {
+	/*! use bytes.Equal(got, want) to compare byte slices */
+	return reflect.DeepEqual(got, want)
+}
+
+func comparableElems(a, b []string, m1, m2 map[string]int) {
+	/*! use slices.Equal(a, b) to compare slices of comparable elements */
+	_ = reflect.DeepEqual(a, b)
+
+	/*! use maps.Equal(m1, m2) to compare maps of comparable values */
+	_ = reflect.DeepEqual(m1, m2)
+}
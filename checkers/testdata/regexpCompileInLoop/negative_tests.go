@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"regexp"
+)
+
+var digitsRE = regexp.MustCompile(`^\d+$`)
+
+func hoisted(lines []string) int {
+	re := regexp.MustCompile(`^\d+$`)
+	n := 0
+	for _, s := range lines {
+		if re.MatchString(s) || digitsRE.MatchString(s) {
+			n++
+		}
+	}
+	return n
+}
+
+func dynamicPattern(patterns []string, s string) {
+	for _, p := range patterns {
+		re := regexp.MustCompile(p)
+		_ = re.MatchString(s)
+		_, _ = regexp.MatchString(`^`+p, s)
+	}
+}
+
+func rangeExpr(s string) {
+	for _, m := range regexp.MustCompile(`\w+`).FindAllString(s, -1) {
+		_ = m
+	}
+}
+
+func storedLambda(lines []string) []func() bool {
+	var fns []func() bool
+	for range lines {
+		fns = append(fns, func() bool {
+			return regexp.MustCompile(`x`).MatchString("x")
+		})
+	}
+	return fns
+}
+
+func notHandler(s string) bool {
+	return regexp.MustCompile(`x`).MatchString(s)
+}
@@ -0,0 +1,73 @@
+package checker_test
+
+import (
+	"net/http"
+	"regexp"
+)
+
+func compileInRange(lines []string) int {
+	n := 0
+	for _, s := range lines {
+		/*! regexp.MustCompile compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+		if regexp.MustCompile(`^\d+$`).MatchString(s) {
+			n++
+		}
+	}
+	return n
+}
+
+func compileInFor(lines []string) {
+	const pat = `[a-z]+`
+	for i := 0; i < len(lines); i++ {
+		/*! regexp.Compile compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+		re, err := regexp.Compile(pat)
+		_, _ = re, err
+	}
+}
+
+func matchInLoop(lines []string) {
+	for _, s := range lines {
+		/*! regexp.MatchString compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+		ok, _ := regexp.MatchString(`x+`, s)
+		_ = ok
+
+		/*! regexp.Match compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+		ok, _ = regexp.Match(`y+`, []byte(s))
+	}
+}
+
+func immediatelyInvoked(lines []string) {
+	for _, s := range lines {
+		func() {
+			/*! regexp.MustCompile compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+			_ = regexp.MustCompile(`z`).MatchString(s)
+		}()
+	}
+}
+
+func loopCondition(s string) {
+	/*! regexp.MustCompile compiles a constant pattern inside a loop; move it to a package-level regexp.MustCompile variable */
+	for regexp.MustCompile(`a$`).MatchString(s) {
+		s = s[:len(s)-1]
+	}
+}
+
+type handler struct{}
+
+func (handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	/*! regexp.MustCompile compiles a constant pattern on every request; move it to a package-level regexp.MustCompile variable */
+	re := regexp.MustCompile(`^/api/`)
+	_ = re
+}
+
+func handleFunc(w http.ResponseWriter, r *http.Request) {
+	/*! regexp.MatchString compiles a constant pattern on every request; move it to a package-level regexp.MustCompile variable */
+	_, _ = regexp.MatchString(`^/api/`, r.URL.Path)
+}
+
+func registerHandler() {
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		/*! regexp.MustCompile compiles a constant pattern on every request; move it to a package-level regexp.MustCompile variable */
+		_ = regexp.MustCompile(`^/$`)
+	})
+}
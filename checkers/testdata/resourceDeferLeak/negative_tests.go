@@ -0,0 +1,57 @@
+package checker_test
+
+import (
+	"database/sql"
+	"os"
+)
+
+func closedEagerly(files []string) {
+	for _, filename := range files {
+		f, err := os.Open(filename)
+		if err != nil {
+			return
+		}
+		f.Close()
+	}
+}
+
+func wrappedIntoFunc(files []string) {
+	for _, filename := range files {
+		func() {
+			f, err := os.Open(filename)
+			if err != nil {
+				return
+			}
+			defer f.Close()
+		}()
+	}
+}
+
+func openedOutside(filename string, n int) {
+	f, _ := os.Open(filename)
+	for i := 0; i < n; i++ {
+		defer f.Close()
+	}
+}
+
+func smallLoops(db *sql.DB) {
+	for i := 0; i < 3; i++ {
+		rows, _ := db.Query("SELECT 1")
+		defer rows.Close()
+	}
+	for _, name := range []string{"a", "b"} {
+		f, _ := os.Create(name)
+		defer f.Close()
+	}
+	for _, name := range [...]string{"a", "b", "c", "d"} {
+		f, _ := os.Create(name)
+		defer f.Close()
+	}
+}
+
+func nonResource(files []string, r *os.File) {
+	for range files {
+		f := r
+		defer f.Close()
+	}
+}
@@ -0,0 +1,62 @@
+package checker_test
+
+import (
+	"database/sql"
+	"net/http"
+	"os"
+)
+
+func openFiles(files []string) error {
+	for _, filename := range files {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		/*! f from os.Open stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function */
+		defer f.Close()
+	}
+	return nil
+}
+
+func fetchURLs(client *http.Client, reqs []*http.Request) {
+	for i := 0; i < len(reqs); i++ {
+		resp, err := client.Do(reqs[i])
+		if err != nil {
+			continue
+		}
+		/*! resp from client.Do stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function */
+		defer resp.Body.Close()
+	}
+
+	for {
+		resp, err := http.Get("http://example.com")
+		if err != nil {
+			break
+		}
+		/*! resp from http.Get stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function */
+		defer func() {
+			resp.Body.Close()
+		}()
+	}
+}
+
+func queryAll(db *sql.DB, queries []string) {
+	for _, q := range queries {
+		var rows *sql.Rows
+		var err error
+		rows, err = db.Query(q)
+		if err != nil {
+			return
+		}
+		if rows != nil {
+			/*! rows from db.Query stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function */
+			defer rows.Close()
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		rows, _ := db.Query("SELECT 1")
+		/*! rows from db.Query stays open until the function returns, resources pile up in the loop; close it eagerly or move the loop body into a function */
+		defer rows.Close()
+	}
+}
@@ -0,0 +1,43 @@
+package checker_test
+
+import (
+	"errors"
+)
+
+var errNotFound = errors.New("not found")
+
+func findUser2(id int) (*user, error) {
+	if id == 0 {
+		return nil, errNotFound
+	}
+	return &user{}, nil
+}
+
+func mapResult() (map[string]int, error) {
+	return nil, nil
+}
+
+func sliceResult() ([]int, error) {
+	return nil, nil
+}
+
+func funcResult() (func(), error) {
+	return nil, nil
+}
+
+// findOptional returns nil, nil if user is not found.
+func findOptional(id int) (*user, error) {
+	return nil, nil
+}
+
+func notErrorResult() (*user, *user) {
+	return nil, nil
+}
+
+func singleResult() error {
+	return nil
+}
+
+func threeResults() (*user, int, error) {
+	return nil, 0, nil
+}
@@ -0,0 +1,37 @@
+package checker_test
+
+import (
+	"io"
+)
+
+type user struct{}
+
+func findUser(id int) (*user, error) {
+	if id == 0 {
+		return &user{}, nil
+	}
+	/*! returning nil *user and nil error; return a sentinel error or a non-nil value instead */
+	return nil, nil
+}
+
+func openReader(name string) (io.Reader, error) {
+	/*! returning nil io.Reader and nil error; return a sentinel error or a non-nil value instead */
+	return nil, nil
+}
+
+type store struct{}
+
+func (s *store) get() (interface{}, error) {
+	f := func() (*user, error) {
+		return nil, nil
+	}
+	_ = f
+	/*! returning nil interface{} and nil error; return a sentinel error or a non-nil value instead */
+	return nil, nil
+}
+
+// lookupUser finds a user by the given name.
+func lookupUser(name string) (*user, error) {
+	/*! returning nil *user and nil error; return a sentinel error or a non-nil value instead */
+	return nil, nil
+}
@@ -0,0 +1,85 @@
+package checker_test
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+func pollWithSleep(ch chan int) {
+	for {
+		select {
+		case <-ch:
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func pollWithGosched(ch chan int) {
+	for {
+		select {
+		case <-ch:
+			return
+		default:
+			runtime.Gosched()
+		}
+	}
+}
+
+func pollWithWork(ch chan int, work func()) {
+	for {
+		select {
+		case <-ch:
+			return
+		default:
+			work()
+		}
+	}
+}
+
+func blockingSelect(ch chan int) {
+	for {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func conditionalLoop(ch chan int, running func() bool) {
+	for running() {
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+func loopWithOtherStatements(ch chan int, wg *sync.WaitGroup) {
+	for {
+		wg.Wait()
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+func sleepInCase(ch chan int) {
+	for {
+		select {
+		case <-ch:
+			time.Sleep(time.Second)
+		default:
+		}
+	}
+}
+
+func nonBlockingSend(ch chan int) {
+	select {
+	case ch <- 1:
+	default:
+	}
+}
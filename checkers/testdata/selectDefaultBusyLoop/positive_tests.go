@@ -0,0 +1,25 @@
+package checker_test
+
+func spinOnChannel(ch chan int, handle func(int)) {
+	for {
+		select {
+		case v := <-ch:
+			handle(v)
+		/*! select with an empty default case spins the endless loop; remove the default case or add a blocking wait */
+		default:
+		}
+	}
+}
+
+func spinWithContinue(ch, done chan struct{}) {
+	for {
+		select {
+		case <-ch:
+		case <-done:
+			return
+		/*! select with an empty default case spins the endless loop; remove the default case or add a blocking wait */
+		default:
+			continue
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package checker_test
+
+import "errors"
+
+func fetch() ([]byte, error) { return nil, nil }
+
+var errNotFound = errors.New("not found")
+
+func noOuterErr() error {
+	if _, err := fetch(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func notReadAfter() error {
+	_, err := fetch()
+	if err != nil {
+		return err
+	}
+	if _, err := fetch(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func reassignedAfter() error {
+	var err error
+	if _, err := fetch(); err != nil {
+		return err
+	}
+	_, err = fetch()
+	return err
+}
+
+func assignedInBlock() error {
+	var err error
+	var data []byte
+	if data, err = fetch(); err == nil {
+		_ = data
+	}
+	return err
+}
+
+func notNamedErr() error {
+	var loadErr error
+	if _, loadErr := fetch(); loadErr != nil {
+		return loadErr
+	}
+	return loadErr
+}
+
+func notErrorType() error {
+	err := "message"
+	if _, err := fetch(); err != nil {
+		return err
+	}
+	return errors.New(err)
+}
+
+func packageLevelErr() error {
+	if _, errNotFound := fetch(); errNotFound != nil {
+		return errNotFound
+	}
+	return errNotFound
+}
+
+func closures() error {
+	var err error
+	done := make(chan struct{})
+	go func() {
+		_, err := fetch()
+		_ = err
+		close(done)
+	}()
+	<-done
+	return err
+}
@@ -0,0 +1,41 @@
+package checker_test
+
+import "errors"
+
+func load() ([]byte, error) { return nil, nil }
+
+func process([]byte) {}
+
+func ifInit() error {
+	var err error
+	/*! err shadows err declared at positive_tests.go:10, which is read after this scope; assign it with = instead */
+	if data, err := load(); err == nil {
+		process(data)
+	}
+	return err
+}
+
+func nestedBlock(ok bool) error {
+	_, err := load()
+	if ok {
+		/*! err shadows err declared at positive_tests.go:19, which is read after this scope; assign it with = instead */
+		data, err := load()
+		if err != nil {
+			return err
+		}
+		process(data)
+	}
+	if err != nil {
+		return errors.New("load failed")
+	}
+	return nil
+}
+
+func loopBody(n int) (err error) {
+	for i := 0; i < n; i++ {
+		/*! err shadows err declared at positive_tests.go:34, which is read after this scope; assign it with = instead */
+		_, err := load()
+		_ = err
+	}
+	return err
+}
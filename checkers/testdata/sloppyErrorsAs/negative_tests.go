@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+type valueError struct {
+	msg string
+}
+
+func (e valueError) Error() string { return e.msg }
+
+type matchingError struct{}
+
+func (*matchingError) Error() string        { return "matching" }
+func (*matchingError) Is(target error) bool { return true }
+
+var errSentinel = errors.New("sentinel")
+
+func correctAs(err error) bool {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		return true
+	}
+	var ve valueError
+	if errors.As(err, &ve) {
+		return true
+	}
+	var iface interface{ Timeout() bool }
+	return errors.As(err, &iface)
+}
+
+func correctIs(err error) bool {
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, errSentinel) ||
+		errors.Is(err, valueError{msg: "x"}) ||
+		errors.Is(err, &matchingError{})
+}
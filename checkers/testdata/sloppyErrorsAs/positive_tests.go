@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/go-critic/go-critic/checkers/testdata/_importable/examplepkg"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string { return "code error" }
+
+func asErrorTarget(err error) bool {
+	var target error
+	/*! errors.As target &target is *error, so it matches any error; use a concrete error type */
+	return errors.As(err, &target)
+}
+
+func asUnexportedTarget(err error) bool {
+	target := examplepkg.NewTimeoutError()
+	/*! errors.As target type *examplepkg.timeoutError is unexported in examplepkg and can't be relied on; use an exported type or an interface */
+	return errors.As(err, &target)
+}
+
+func isNewPointer(err error) bool {
+	/*! errors.Is target &os.PathError{} is not an error value that is returned anywhere; use errors.As to check the error type */
+	if errors.Is(err, &os.PathError{}) {
+		return true
+	}
+	/*! errors.Is target (*codeError)(nil) is not an error value that is returned anywhere; use errors.As to check the error type */
+	return errors.Is(err, (*codeError)(nil))
+}
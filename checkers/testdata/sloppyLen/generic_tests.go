@@ -0,0 +1,9 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+func genericLen[T ~[]E, E any](xs T) bool {
+	/*! len(xs) <= 0 can be len(xs) == 0 */
+	return len(xs) <= 0
+}
@@ -9,3 +9,10 @@ func g() {
 	_ = len(a) == 0
 	_ = len(a) == 10
 }
+
+func lenVarOK(a []int) {
+	n := len(a)
+	if n > 0 {
+		println(n)
+	}
+}
@@ -10,3 +10,57 @@ func f() {
 	/*! len(a) <= 0 can be len(a) == 0 */
 	_ = len(a) <= 0
 }
+
+func yoda() {
+	a := []int{}
+
+	/*! 0 <= len(a) is always true */
+	_ = 0 <= len(a)
+	/*! 0 > len(a) is always false */
+	_ = 0 > len(a)
+	/*! 0 >= len(a) can be len(a) == 0 */
+	_ = 0 >= len(a)
+}
+
+func alwaysTrueIf(a []int) {
+	/*! the if statement can be unwrapped, len(a) >= 0 is always true */
+	if len(a) >= 0 {
+		println(a)
+		println(len(a))
+	}
+}
+
+func alwaysTrueYodaIf(a []int) {
+	/*! the if statement can be unwrapped, 0 <= len(a) is always true */
+	if 0 <= len(a) {
+		println(a)
+	}
+}
+
+func lenVar(a []int) {
+	/*! n < 0 is always false */
+	n := len(a)
+	if n < 0 {
+		println(n)
+	}
+}
+
+func lenInitVar(a []int) {
+	/*! n >= 0 is always true */
+	if n := len(a); n >= 0 {
+		println(n)
+	}
+	/*! n < 0 is always false */
+	if n := len(a); n < 0 {
+		println(n)
+	}
+}
+
+func alwaysTrueIfElse(a []int) {
+	/*! len(a) >= 0 is always true */
+	if len(a) >= 0 {
+		println(a)
+	} else {
+		println(len(a))
+	}
+}
@@ -0,0 +1,33 @@
+package checker_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+func joinHostPort(host string, port int) {
+	_, _ = net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+}
+
+func allInterfaces(port string) {
+	_ = http.ListenAndServe(":"+port, nil)
+}
+
+func constantHost(port int) {
+	_, _ = net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	_, _ = net.Dial("tcp", "127.0.0.1:"+strconv.Itoa(port))
+}
+
+func bracketedIPv6(port string) {
+	_, _ = net.Dial("tcp", "[::1]:"+port)
+}
+
+func notAnAddress(host string, port int) string {
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+func unrelatedNames(user, password string) {
+	_, _ = net.Dial("tcp", user+":"+password)
+}
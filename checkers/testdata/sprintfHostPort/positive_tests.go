@@ -0,0 +1,47 @@
+package checker_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+type config struct {
+	Host string
+	Port int
+}
+
+func dialSprintf(host string, port int) {
+	/*! host:port address built with fmt.Sprintf breaks for IPv6 hosts; use net.JoinHostPort(host, strconv.Itoa(port)) */
+	_, _ = net.Dial("tcp", fmt.Sprintf("%s:%d", host, port))
+}
+
+func dialConcat(serverAddr, serverPort string) {
+	/*! host:port address built with concatenation breaks for IPv6 hosts; use net.JoinHostPort(serverAddr, serverPort) */
+	_, _ = net.Dial("udp", serverAddr+":"+serverPort)
+}
+
+func listenVar(cfg config) {
+	/*! host:port address built with concatenation breaks for IPv6 hosts; use net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)) */
+	addr := cfg.Host + ":" + strconv.Itoa(cfg.Port)
+	_, _ = net.Listen("tcp", addr)
+}
+
+func dialContext(ctx context.Context, d *net.Dialer, ip string, port int) {
+	/*! host:port address built with fmt.Sprintf breaks for IPv6 hosts; use net.JoinHostPort(ip, strconv.Itoa(port)) */
+	_, _ = d.DialContext(ctx, "tcp", fmt.Sprintf("%v:%v", ip, port))
+}
+
+func serverAddr(cfg *config) *http.Server {
+	return &http.Server{
+		/*! host:port address built with fmt.Sprintf breaks for IPv6 hosts; use net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)) */
+		Addr: fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+	}
+}
+
+func ipv6Literal(port string) {
+	/*! host:port address built with concatenation breaks for IPv6 hosts; use net.JoinHostPort("::1", port) */
+	_, _ = net.Dial("tcp", "::1:"+port)
+}
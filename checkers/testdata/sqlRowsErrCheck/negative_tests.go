@@ -0,0 +1,70 @@
+package checker_test
+
+import (
+	"database/sql"
+)
+
+func allGood(db *sql.DB) error {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+func closedInClosure(db *sql.DB) error {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func passedToFunc(db *sql.DB) error {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+	}
+	return checkRows(rows)
+}
+
+func checkRows(rows *sql.Rows) error {
+	defer rows.Close()
+	return rows.Err()
+}
+
+func returned(db *sql.DB) *sql.Rows {
+	rows, _ := db.Query("SELECT id FROM users")
+	for rows.Next() {
+		break
+	}
+	return rows
+}
+
+func paramWithErr(rows *sql.Rows) error {
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+type fakeRows struct{}
+
+func (fakeRows) Next() bool { return false }
+
+func otherType(rows fakeRows) {
+	for rows.Next() {
+	}
+}
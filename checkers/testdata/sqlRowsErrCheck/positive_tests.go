@@ -0,0 +1,46 @@
+package checker_test
+
+import (
+	"database/sql"
+)
+
+func noErrCheck(db *sql.DB) error {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	/*! rows.Err() is not checked after the rows.Next() loop */
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func noClose(db *sql.DB) error {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return err
+	}
+	/*! rows.Close() is not deferred, rows can leak if iteration stops early */
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+func nothing(db *sql.DB) {
+	rows, _ := db.Query("SELECT id FROM users")
+	/*! rows.Err() is not checked after the rows.Next() loop */
+	/*! rows.Close() is not deferred, rows can leak if iteration stops early */
+	for rows.Next() {
+	}
+}
+
+func param(rows *sql.Rows) {
+	/*! rows.Err() is not checked after the rows.Next() loop */
+	for rows.Next() {
+	}
+}
@@ -0,0 +1,25 @@
+package checker_test
+
+func notChains(a, b string, xs []string, f func() string) {
+	if a == "x" || a == "y" {
+	}
+	if a == "x" || b == "y" || a == "z" {
+	}
+	if a == "x" || a == b || a == "z" {
+	}
+	if a == "x" || a != "y" || a == "z" {
+	}
+	if a != "x" || a != "y" || a != "z" {
+	}
+	if a == "x" && a == "y" && a == "z" {
+	}
+	if f() == "x" || f() == "y" || f() == "z" {
+	}
+	if xs[0] == "x" || xs[1] == "y" || xs[2] == "z" {
+	}
+	if a == "x" || a == "y" || a == "x" {
+	}
+	if a == "x" || a == "y" || a == "z" && b == "" {
+	}
+	_ = a == "x" || a == "y" || a == "z"
+}
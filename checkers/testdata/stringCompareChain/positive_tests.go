@@ -0,0 +1,36 @@
+package checker_test
+
+func serveImage(ext string) {}
+
+func compareChains(ext string, code int, r struct{ Method string }) {
+	/*! ext is compared against ".jpg", ".png", ".gif"; use a switch with the multi-value case or slices.Contains */
+	if ext == ".jpg" || ext == ".png" || ext == ".gif" {
+		serveImage(ext)
+	}
+
+	/*! r.Method is compared against "GET", "HEAD", "OPTIONS"; use a switch with the multi-value case or slices.Contains */
+	if r.Method == "GET" || "HEAD" == r.Method || (r.Method == "OPTIONS") {
+		println("safe")
+	} else {
+		println("unsafe")
+	}
+
+	/*! code is compared against 200, 201, 204; use a switch with the multi-value case and default or !slices.Contains */
+	if code != 200 && code != 201 && code != 204 {
+		println("failure")
+	}
+
+	/*! ext is compared against "a", "b", "c", "d"; use a switch with the multi-value case or slices.Contains */
+	if s := ext; ext == "a" || ext == "b" || ext == "c" || ext == "d" {
+		println(s)
+	} else if ext == "" {
+		println("empty")
+	}
+
+	for {
+		/*! ext is compared against "x", "y", "z"; use a switch with the multi-value case or slices.Contains */
+		if ext == "x" || ext == "y" || ext == "z" {
+			break
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package checker_test
+
+import (
+	"strings"
+)
+
+func builder(parts []string) string {
+	var sb strings.Builder
+	for _, part := range parts {
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
+
+func smallLoop() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		s += "x"
+	}
+	for i := 1; i <= 3; i++ {
+		s += "y"
+	}
+	return s
+}
+
+func declaredInside(parts []string) {
+	for _, part := range parts {
+		s := "prefix"
+		s += part
+		println(s)
+	}
+}
+
+func readInside(parts []string) string {
+	s := ""
+	for _, part := range parts {
+		s += part
+		println(s)
+	}
+	return s
+}
+
+func prepend(parts []string) string {
+	s := ""
+	for _, part := range parts {
+		s = part + s
+	}
+	return s
+}
+
+func notString(nums []int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+func smallLoopAddAssign() string {
+	s := ""
+	for i := 0; i < 3; i += 1 {
+		s += "x"
+	}
+	return s
+}
@@ -0,0 +1,87 @@
+package checker_test
+
+func rangeConcat(parts []string) string {
+	var s string
+	for _, part := range parts {
+		/*! s is concatenated in a loop; use strings.Builder, it can be pre-grown based on len(parts) */
+		s += part
+	}
+	return s
+}
+
+func explicitConcat(parts []string) string {
+	s := ""
+	for i := 0; i < len(parts); i++ {
+		/*! s is concatenated in a loop; use strings.Builder, it can be pre-grown based on len(parts) */
+		s = s + parts[i] + ","
+	}
+	return s
+}
+
+func unknownCount(next func() (string, bool)) string {
+	s := ""
+	for {
+		part, ok := next()
+		if !ok {
+			break
+		}
+		/*! s is concatenated in a loop; use strings.Builder */
+		s += part
+		s += "\n"
+	}
+	return s
+}
+
+func bigConstLoop() string {
+	s := ""
+	for i := 0; i < 100; i++ {
+		/*! s is concatenated in a loop; use strings.Builder */
+		s += "x"
+	}
+	return s
+}
+
+type label string
+
+func namedString(parts []string) label {
+	var l label
+	for _, part := range parts {
+		if len(l) > 10 {
+			break
+		}
+		/*! l is concatenated in a loop; use strings.Builder, it can be pre-grown based on len(parts) */
+		l += label(part)
+	}
+	return l
+}
+
+func nestedLoops(rows [][]string) string {
+	var s string
+	for _, row := range rows {
+		for _, col := range row {
+			/*! s is concatenated in a loop; use strings.Builder, it can be pre-grown based on len(row) */
+			s += col
+		}
+	}
+	return s
+}
+
+func otherCondVar(n int) string {
+	s := ""
+	j := 0
+	for i := 0; j < 3; i++ {
+		/*! s is concatenated in a loop; use strings.Builder */
+		s += "x"
+		j += n
+	}
+	return s
+}
+
+func decrementingLoop() string {
+	s := ""
+	for i := 0; i < 3; i-- {
+		/*! s is concatenated in a loop; use strings.Builder */
+		s += "x"
+	}
+	return s
+}
@@ -0,0 +1,44 @@
+package checker_test
+
+import "strings"
+
+func writeTo(b *strings.Builder) {
+	b.WriteString("body")
+}
+
+func consume(b strings.Builder) {}
+
+func passedByPointer() string {
+	var b strings.Builder
+	writeTo(&b)
+	b.WriteString("footer")
+	return b.String()
+}
+
+func passedLast() {
+	var b strings.Builder
+	b.WriteString("data")
+	consume(b)
+}
+
+func reset(lines []string) {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		b.Reset()
+	}
+}
+
+func stringAtTheEnd(parts []string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		b.WriteString(p)
+	}
+	s := b.String()
+	return s
+}
+
+func newBuilder() {
+	b := strings.Builder{}
+	b.WriteString("x")
+}
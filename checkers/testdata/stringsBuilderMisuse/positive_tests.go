@@ -0,0 +1,42 @@
+package checker_test
+
+import "strings"
+
+func writeBody(b strings.Builder) {}
+
+func passedByValue() string {
+	var b strings.Builder
+	b.WriteString("header")
+	/*! b is passed to writeBody by value and used afterwards; pass a pointer, copied strings.Builder panics on write */
+	writeBody(b)
+	b.WriteString("footer")
+	return b.String()
+}
+
+func resetByAssign(lines []string) {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		/*! use b.Reset() instead of assigning an empty strings.Builder */
+		b = strings.Builder{}
+	}
+}
+
+type report struct {
+	buf strings.Builder
+}
+
+func (r *report) clear() {
+	/*! use r.buf.Reset() instead of assigning an empty strings.Builder */
+	r.buf = strings.Builder{}
+}
+
+func writeAfterString() (string, string) {
+	var b strings.Builder
+	b.WriteString("first")
+	first := b.String()
+	/*! b is written after its String result is stored in first; build the string after all writes */
+	b.WriteByte(' ')
+	b.WriteString("second")
+	return first, b.String()
+}
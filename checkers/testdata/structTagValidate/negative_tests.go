@@ -0,0 +1,26 @@
+package checker_test
+
+type validTags struct {
+	Name    string   `json:"name,omitempty" db:"name"`
+	Tags    []string `yaml:"tags,flow" toml:"tags"`
+	Comment string   `validate:"required, max=10"`
+	Skip    string   `json:"-"`
+	Custom  string   `mytag:"x" js:"y"`
+	Empty   string   `json:""`
+}
+
+type embedded struct {
+	ID int
+}
+
+type namedEmbeddedTag struct {
+	embedded `json:"embedded"`
+	*Other   `json:"-"`
+	Token    string
+}
+
+type Other struct{}
+
+type untagged struct {
+	A, B int
+}
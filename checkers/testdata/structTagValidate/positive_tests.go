@@ -0,0 +1,46 @@
+package checker_test
+
+type duplicateKeys struct {
+	/*! struct tag key json is repeated in the tag of field Name, only the first one is used */
+	Name string `json:"name" db:"name" json:"full_name"`
+}
+
+type spacedOptions struct {
+	/*! json tag option " omitempty" of field Name starts with a space and is ignored; remove the space after the comma */
+	Name string `json:"name, omitempty"`
+
+	/*! yaml tag option " flow" of field Tags starts with a space and is ignored; remove the space after the comma */
+	Tags []string `yaml:"tags, flow"`
+}
+
+type misspelledKeys struct {
+	/*! struct tag key jsno of field ID is unknown, did you mean json? */
+	ID int `jsno:"id"`
+
+	/*! struct tag key yamll of field Host is unknown, did you mean yaml? */
+	Host string `yamll:"host"`
+
+	/*! struct tag key jsob of field Port is unknown, did you mean json? */
+	Port int `jsob:"port"`
+}
+
+type base struct {
+	ID int
+}
+
+type embeddedTags struct {
+	/*! json tag ",omitempty" of embedded field base is ignored; encoding/json only uses a tag name to stop promoting the fields */
+	base `json:",omitempty"`
+
+	/*! json tag ",inline" of embedded field Time is ignored; encoding/json only uses a tag name to stop promoting the fields */
+	*Time `json:",inline"`
+}
+
+type Time struct{}
+
+func anonymousStruct() {
+	_ = struct {
+		/*! struct tag key xlm of field Value is unknown, did you mean xml? */
+		Value string `xlm:"value"`
+	}{}
+}
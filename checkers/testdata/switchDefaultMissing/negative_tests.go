@@ -0,0 +1,51 @@
+package checker_test
+
+import "go/token"
+
+type size int
+
+const (
+	small size = iota
+	large
+)
+
+type single int
+
+const onlyOne single = 1
+
+func sizes(s size, b bool, str string, one single, x interface{}) {
+	switch s {
+	case small, large:
+	}
+
+	switch s {
+	case small:
+	default:
+	}
+
+	switch b {
+	case true:
+	}
+
+	switch str {
+	case "a":
+	}
+
+	switch one {
+	case onlyOne:
+	}
+
+	switch {
+	case s == small:
+	}
+
+	switch x.(type) {
+	case int:
+	}
+}
+
+func ignoredType(tok token.Token) {
+	switch tok {
+	case token.ADD, token.SUB:
+	}
+}
@@ -0,0 +1,51 @@
+package checker_test
+
+import "time"
+
+type color int
+
+const (
+	red color = iota
+	green
+	blue
+	defaultColor = red
+)
+
+func paint(c color) {
+	/*! switch on c doesn't handle blue and has no default case */
+	switch c {
+	case red:
+	case green:
+	}
+
+	/*! switch on c doesn't handle green, blue and has no default case */
+	switch c {
+	case red:
+	}
+}
+
+type mode string
+
+const (
+	modeRead  mode = "read"
+	modeWrite mode = "write"
+)
+
+func open(m mode, d time.Weekday) {
+	/*! switch on m doesn't handle modeWrite and has no default case */
+	switch m {
+	case modeRead:
+	}
+
+	/*! switch on d doesn't handle Tuesday, Wednesday, Thursday, Friday, Saturday and has no default case */
+	switch d {
+	case time.Sunday, time.Monday:
+	}
+}
+
+func monthName(m time.Month) {
+	/*! switch on m doesn't handle February, March, April, May, June and 6 more and has no default case */
+	switch m {
+	case time.January:
+	}
+}
@@ -0,0 +1,88 @@
+package checker_test
+
+func onTrue()  {}
+func onFalse() {}
+
+func singleCase(ok bool) {
+	switch ok {
+	case true:
+		onTrue()
+	}
+}
+
+func notBoolTag(x int) {
+	switch x {
+	case 1:
+		onTrue()
+	default:
+		onFalse()
+	}
+}
+
+func nonConstCases(ok, other bool) {
+	switch ok {
+	case other:
+		onTrue()
+	default:
+		onFalse()
+	}
+}
+
+func withFallthrough(ok bool) {
+	switch ok {
+	case true:
+		onTrue()
+		fallthrough
+	case false:
+		onFalse()
+	}
+}
+
+func withBreak(ok bool, xs []int) {
+	for range xs {
+		switch ok {
+		case true:
+			if len(xs) == 0 {
+				break
+			}
+			onTrue()
+		case false:
+			onFalse()
+		}
+	}
+}
+
+func multiValueCases(x int) {
+	switch x > 0 {
+	case true, false:
+		onTrue()
+	default:
+		onFalse()
+	}
+
+	switch {
+	case x > 0, x < -10:
+		onTrue()
+	default:
+		onFalse()
+	}
+}
+
+func threeCases(x int) {
+	switch {
+	case x > 0:
+		onTrue()
+	case x < 0:
+		onFalse()
+	default:
+	}
+}
+
+func twoConds(x int) {
+	switch {
+	case x > 0:
+		onTrue()
+	case x < 0:
+		onFalse()
+	}
+}
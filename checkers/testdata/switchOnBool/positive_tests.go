@@ -0,0 +1,97 @@
+package checker_test
+
+type myBool bool
+
+func onSuccess() {}
+func onFailure() {}
+
+func trueFalse(ok bool) {
+	/*! switch on bool ok can be rewritten as if-else */
+	switch ok {
+	case true:
+		onSuccess()
+	case false:
+		onFailure()
+	}
+}
+
+func falseTrue(ok bool) {
+	/*! switch on bool ok can be rewritten as if-else */
+	switch ok {
+	case false:
+		onFailure()
+	case true:
+		onSuccess()
+	}
+}
+
+func trueDefault(x int) {
+	/*! switch on bool x > 0 can be rewritten as if-else */
+	switch x > 0 {
+	case true:
+		onSuccess()
+	default:
+		onFailure()
+	}
+}
+
+func onlyFalseBody(x, y int) {
+	/*! switch on bool x == y can be rewritten as if-else */
+	switch x == y {
+	case true:
+	case false:
+		onFailure()
+	}
+}
+
+func namedBool(b myBool) {
+	/*! switch on bool b can be rewritten as if-else */
+	switch b {
+	case false:
+		onFailure()
+	default:
+		onSuccess()
+	}
+}
+
+func withInit(f func() bool) {
+	/*! switch on bool ok can be rewritten as if-else */
+	switch ok := f(); ok {
+	case true:
+		onSuccess()
+		for {
+			break
+		}
+	case false:
+		onFailure()
+	}
+}
+
+func withComments(ok bool) {
+	/*! switch on bool ok can be rewritten as if-else */
+	switch ok {
+	case true:
+		// Quick fix would lose this comment.
+		onSuccess()
+	case false:
+		onFailure()
+	}
+}
+
+func condAndDefault(x int) {
+	/*! switch with a single condition and default can be rewritten as if-else */
+	switch {
+	case x > 10:
+		onSuccess()
+	default:
+		onFailure()
+	}
+
+	/*! switch with a single condition and default can be rewritten as if-else */
+	switch {
+	default:
+		onFailure()
+	case x < 0:
+		onSuccess()
+	}
+}
@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	dbOnce sync.Once
+	db     *appConfig
+	dbErr  error
+)
+
+func openDB() (*appConfig, error) { return nil, errors.New("no db") }
+
+func getDB() (*appConfig, error) {
+	dbOnce.Do(func() {
+		db, dbErr = openDB()
+	})
+	return db, dbErr
+}
+
+var (
+	nameOnce sync.Once
+	name     string
+)
+
+func getName(fallback string) string {
+	nameOnce.Do(func() { name = fallback })
+	return name
+}
+
+var (
+	sharedOnce sync.Once
+	shared     *appConfig
+)
+
+func getShared() *appConfig {
+	sharedOnce.Do(func() { shared = loadAppConfig() })
+	return shared
+}
+
+func resetShared() {
+	shared = nil
+}
+
+var (
+	checkedOnce sync.Once
+	checked     *appConfig
+)
+
+func getChecked() *appConfig {
+	checkedOnce.Do(func() {
+		cfg := loadAppConfig()
+		if cfg == nil {
+			cfg = &appConfig{name: "default"}
+		}
+		checked = cfg
+	})
+	return checked
+}
+
+type lazyConfig struct {
+	once sync.Once
+	cfg  *appConfig
+}
+
+func (l *lazyConfig) get() *appConfig {
+	l.once.Do(func() { l.cfg = loadAppConfig() })
+	return l.cfg
+}
+
+func localOnce() *appConfig {
+	var once sync.Once
+	var cfg *appConfig
+	once.Do(func() { cfg = loadAppConfig() })
+	return cfg
+}
+
+var (
+	reusedOnce sync.Once
+	reused     string
+)
+
+func getReused() string {
+	reusedOnce.Do(func() { reused = "x" })
+	return reused
+}
+
+func alsoReused() {
+	reusedOnce.Do(func() {})
+}
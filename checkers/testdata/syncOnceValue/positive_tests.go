@@ -0,0 +1,62 @@
+package checker_test
+
+import "sync"
+
+type appConfig struct {
+	name string
+}
+
+func loadAppConfig() *appConfig { return &appConfig{} }
+
+var (
+	configOnce sync.Once
+	config     *appConfig
+)
+
+/*! getConfig guards its body with sync.Once, it can be replaced by `var getConfig = sync.OnceValue(loadAppConfig)` */
+func getConfig() *appConfig {
+	configOnce.Do(func() { config = loadAppConfig() })
+	return config
+}
+
+var (
+	portsOnce sync.Once
+	ports     []int
+)
+
+/*! defaultPorts guards its body with sync.Once, it can be replaced by `var defaultPorts = sync.OnceValue(func() []int { return []int{80, 443} })` */
+func defaultPorts() []int {
+	portsOnce.Do(func() {
+		ports = []int{80, 443}
+	})
+	return ports
+}
+
+func registerMetrics() {}
+
+var metricsOnce sync.Once
+
+/*! setupMetrics guards its body with sync.Once, it can be replaced by `var setupMetrics = sync.OnceFunc(registerMetrics)` */
+func setupMetrics() {
+	metricsOnce.Do(registerMetrics)
+}
+
+var handlersOnce sync.Once
+
+/*! setupHandlers guards its body with sync.Once, it can be replaced by `var setupHandlers = sync.OnceFunc(registerMetrics)` */
+func setupHandlers() {
+	handlersOnce.Do(func() { registerMetrics() })
+}
+
+type configReader interface{}
+
+var (
+	readerOnce sync.Once
+	reader     configReader
+)
+
+/*! getReader guards its body with sync.Once, it can be replaced by `var getReader = sync.OnceValue(func() configReader { return loadAppConfig() })` */
+func getReader() configReader {
+	readerOnce.Do(func() { reader = loadAppConfig() })
+	return reader
+}
@@ -0,0 +1,67 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestDeferIsFine(t *testing.T) {
+	srv := httptest.NewServer(nil)
+	defer srv.Close()
+	_ = srv
+}
+
+func BenchmarkDeferIsFine(b *testing.B) {
+	dir, _ := ioutil.TempDir("", "bench")
+	defer os.RemoveAll(dir)
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func withLock(t *testing.T, mu *sync.Mutex) *sync.Mutex {
+	mu.Lock()
+	defer mu.Unlock()
+	return mu
+}
+
+func recoverHelper(t *testing.T, f *os.File) *os.File {
+	defer func() {
+		if r := recover(); r != nil {
+			f.Close()
+			t.Fatal(r)
+		}
+	}()
+	return f
+}
+
+func goodServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func noTestingParam() *httptest.Server {
+	srv := httptest.NewServer(nil)
+	defer srv.Close()
+	return srv
+}
+
+func TestMain(m *testing.M) {
+	dir, _ := ioutil.TempDir("", "main")
+	defer os.RemoveAll(dir)
+	os.Exit(m.Run())
+}
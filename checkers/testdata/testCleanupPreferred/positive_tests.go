@@ -0,0 +1,58 @@
+package checker_test
+
+import (
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func startServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(nil)
+	/*! srv.Close() runs when startServer returns, not when the test ends; use t.Cleanup instead */
+	defer srv.Close()
+	return srv
+}
+
+func makeTempDir(tb testing.TB) string {
+	dir, err := os.MkdirTemp("", "test")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	/*! os.RemoveAll(dir) runs when makeTempDir returns, not when the test ends; use tb.Cleanup instead */
+	defer os.RemoveAll(dir)
+	return dir
+}
+
+func openFixture(b *testing.B, name string) *os.File {
+	f, err := os.Open(name)
+	if err != nil {
+		b.Fatal(err)
+	}
+	/*! deferred func runs when openFixture returns, not when the test ends; use b.Cleanup instead */
+	defer func() {
+		f.Close()
+	}()
+	return f
+}
+
+func setupEnv(t *testing.T) string {
+	addr, teardown := startBackend()
+	/*! teardown() runs when setupEnv returns, not when the test ends; use t.Cleanup instead */
+	defer teardown()
+	return addr
+}
+
+type fixture struct {
+	srv *httptest.Server
+}
+
+func (fx *fixture) init(t *testing.T) {}
+
+func initFixture(t *testing.T, fx *fixture) {
+	srv := httptest.NewServer(nil)
+	/*! srv.Close() runs when initFixture returns, not when the test ends; use t.Cleanup instead */
+	defer srv.Close()
+	fx.srv = srv
+}
+
+func startBackend() (string, func()) { return "", func() {} }
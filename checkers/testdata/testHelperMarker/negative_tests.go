@@ -0,0 +1,40 @@
+package checker_test
+
+import (
+	"testing"
+)
+
+func TestSomething(t *testing.T) {
+	if 1 != 1 {
+		t.Fatal("math is broken")
+	}
+	t.Run("sub", func(t *testing.T) {
+		t.Error("failed")
+	})
+}
+
+func BenchmarkSomething(b *testing.B) {
+	b.Fatal("not implemented")
+}
+
+func FuzzSomething(f *testing.F) {
+	f.Fuzz(func(t *testing.T, s string) {
+		t.Fail()
+	})
+}
+
+func withHelper(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func onlyLogs(t *testing.T, msg string) {
+	t.Log(msg)
+}
+
+func otherReceiver(t *testing.T, other *testing.T) {
+	t.Helper()
+	other.Fatal("fail")
+}
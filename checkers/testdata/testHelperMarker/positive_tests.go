@@ -0,0 +1,38 @@
+package checker_test
+
+import (
+	"testing"
+)
+
+/*! assertEqual calls t.Fatalf but not t.Helper(), so failures point at the helper lines; call t.Helper() first */
+func assertEqual(t *testing.T, got, want int) {
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+/*! checkBench calls b.Error but not b.Helper(), so failures point at the helper lines; call b.Helper() first */
+func checkBench(b *testing.B, err error) {
+
+	if err != nil {
+		b.Error(err)
+	}
+}
+
+/*! mustNotFail calls tb.FailNow but not tb.Helper(), so failures point at the helper lines; call tb.Helper() first */
+func mustNotFail(tb testing.TB, ok bool) {
+	// Stop the test right away.
+	if !ok {
+		tb.FailNow()
+	}
+}
+
+func TestWithLocalHelper(t *testing.T) {
+	/*! function literal calls t.Errorf but not t.Helper(), so failures point at the helper lines; call t.Helper() first */
+	check := func(t *testing.T, s string) {
+		if s == "" {
+			t.Errorf("empty string")
+		}
+	}
+	check(t, "x")
+}
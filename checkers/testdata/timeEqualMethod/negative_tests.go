@@ -0,0 +1,41 @@
+package checker_test
+
+import "time"
+
+type plainEvent struct {
+	Name string
+	At   int64
+}
+
+type eventRef struct {
+	At *time.Time
+}
+
+func equalMethod(a, b time.Time) bool {
+	return a.Equal(b) || !a.IsZero()
+}
+
+func comparePointers(a, b *time.Time) bool {
+	return a == b || a != nil
+}
+
+func compareDurations(a, b time.Duration) bool {
+	return a == b
+}
+
+func comparePlain(x, y plainEvent, r1, r2 eventRef) bool {
+	// Pointers to time.Time compare the addresses.
+	return x == y || r1 == r2
+}
+
+func unixKeys(events []time.Time) map[int64]time.Time {
+	byUnix := make(map[int64]time.Time)
+	for _, t := range events {
+		byUnix[t.UnixNano()] = t
+	}
+	return byUnix
+}
+
+func compareMonths(a, b time.Time) bool {
+	return a.Month() == b.Month() && a.Location() == b.Location()
+}
@@ -0,0 +1,70 @@
+package checker_test
+
+import "time"
+
+type event struct {
+	Name string
+	At   time.Time
+}
+
+type window struct {
+	ID    int
+	Edges [2]time.Time
+}
+
+type record struct {
+	Meta event
+}
+
+func sameInstant(a, b time.Time) bool {
+	/*! a == b compares time.Time values including monotonic clock readings and locations; use a.Equal(b) instead */
+	return a == b
+}
+
+func differentInstant(a, b time.Time) bool {
+	/*! a != b compares time.Time values including monotonic clock readings and locations; use !a.Equal(b) instead */
+	return a != b
+}
+
+func expired(e event, now time.Time) bool {
+	/*! e.At == now compares time.Time values including monotonic clock readings and locations; use e.At.Equal(now) instead */
+	if e.At == now {
+		return true
+	}
+	/*! time.Now().Add(time.Second) == now compares time.Time values including monotonic clock readings and locations; use time.Now().Add(time.Second).Equal(now) instead */
+	return time.Now().Add(time.Second) == now
+}
+
+func zeroTime(t time.Time, e *event) bool {
+	/*! t == (time.Time{}) is a comparison with the zero time.Time; use t.IsZero() instead */
+	if t == (time.Time{}) {
+		return true
+	}
+	/*! time.Time{} != e.At is a comparison with the zero time.Time; use !e.At.IsZero() instead */
+	return time.Time{} != e.At
+}
+
+func compareStructs(x, y event, w1, w2 window, r1, r2 record) bool {
+	/*! x == y compares event values that contain time.Time field At with ==; compare that field with Equal instead */
+	if x == y {
+		return true
+	}
+	/*! w1 != w2 compares window values that contain time.Time field Edges[i] with !=; compare that field with Equal instead */
+	if w1 != w2 {
+		return false
+	}
+	/*! r1 == r2 compares record values that contain time.Time field Meta.At with ==; compare that field with Equal instead */
+	return r1 == r2
+}
+
+/*! map with time.Time keys treats equal instants with different locations or monotonic readings as distinct keys; normalize the keys with t.UTC().Round(0) or use t.UnixNano() */
+var seen map[time.Time]bool
+
+func countByTime(events []event) int {
+	/*! map with time.Time keys treats equal instants with different locations or monotonic readings as distinct keys; normalize the keys with t.UTC().Round(0) or use t.UnixNano() */
+	counts := make(map[time.Time]int)
+	for _, e := range events {
+		counts[e.At]++
+	}
+	return len(counts)
+}
@@ -0,0 +1,9 @@
+package checker_test_test
+
+import "time"
+
+func tickInTest() {
+	for range time.Tick(time.Millisecond) {
+		break
+	}
+}
@@ -0,0 +1,56 @@
+package checker_test
+
+import "time"
+
+type poller struct {
+	ticker *time.Ticker
+}
+
+func deferredStop() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	<-ticker.C
+}
+
+func stopInClosure(done chan struct{}) {
+	timer := time.NewTimer(time.Second)
+	go func() {
+		<-done
+		timer.Stop()
+	}()
+	<-timer.C
+}
+
+func returnedTicker() *time.Ticker {
+	ticker := time.NewTicker(time.Second)
+	return ticker
+}
+
+func storedTicker(p *poller) {
+	p.ticker = time.NewTicker(time.Second)
+	t := time.NewTicker(time.Minute)
+	p.ticker = t
+}
+
+func passedTicker(consume func(*time.Ticker)) {
+	ticker := time.NewTicker(time.Second)
+	consume(ticker)
+}
+
+func structLit() poller {
+	ticker := time.NewTicker(time.Second)
+	return poller{ticker: ticker}
+}
+
+func afterFunc() {
+	time.AfterFunc(time.Second, func() {})
+	<-time.After(time.Second)
+}
+
+func firedTimer(done chan struct{}) {
+	<-time.NewTimer(time.Second).C
+	select {
+	case <-done:
+	case <-time.NewTimer(time.Second).C:
+	}
+}
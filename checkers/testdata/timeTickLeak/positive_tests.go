@@ -0,0 +1,43 @@
+package checker_test
+
+import "time"
+
+func poll() {}
+
+func tickLoop() {
+	/*! time.Tick leaks the underlying ticker; use time.NewTicker with defer ticker.Stop() instead */
+	for range time.Tick(time.Second) {
+		poll()
+	}
+}
+
+func tickerNeverStopped(done chan struct{}) {
+	/*! ticker from time.NewTicker is never stopped; add defer ticker.Stop() */
+	ticker := time.NewTicker(time.Second)
+	for {
+		select {
+		case <-ticker.C:
+			poll()
+		case <-done:
+			return
+		}
+	}
+}
+
+func timerNeverStopped(d time.Duration) {
+	/*! t from time.NewTimer is never stopped; add defer t.Stop() */
+	t := time.NewTimer(d)
+	<-t.C
+	t.Reset(d)
+}
+
+func channelOnly() {
+	/*! result of time.NewTicker is never stopped; assign it to a variable and defer its Stop */
+	c := time.NewTicker(time.Minute).C
+	<-c
+}
+
+func receivedTicker() {
+	/*! result of time.NewTicker is never stopped; assign it to a variable and defer its Stop */
+	<-time.NewTicker(time.Minute).C
+}
@@ -0,0 +1,9 @@
+package checker_test_test
+
+import (
+	"crypto/tls"
+)
+
+func insecureInTests() *tls.Config {
+	return &tls.Config{InsecureSkipVerify: true}
+}
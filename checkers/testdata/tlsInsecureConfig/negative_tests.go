@@ -0,0 +1,37 @@
+package checker_test
+
+import (
+	"crypto/tls"
+)
+
+func secure(skipVerify bool, version uint16) {
+	_ = &tls.Config{
+		InsecureSkipVerify: false,
+		MinVersion:         tls.VersionTLS12,
+	}
+	_ = &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		MaxVersion: tls.VersionTLS13,
+	}
+	_ = &tls.Config{
+		InsecureSkipVerify: skipVerify,
+		MinVersion:         version,
+	}
+
+	var cfg tls.Config
+	cfg.InsecureSkipVerify = skipVerify
+	cfg.MinVersion = 0
+	cfg.InsecureSkipVerify = false
+}
+
+type myConfig struct {
+	InsecureSkipVerify bool
+	MinVersion         uint16
+}
+
+func otherTypes() {
+	_ = myConfig{InsecureSkipVerify: true, MinVersion: tls.VersionTLS10}
+
+	var cfg myConfig
+	cfg.InsecureSkipVerify = true
+}
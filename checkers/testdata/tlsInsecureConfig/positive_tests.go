@@ -0,0 +1,45 @@
+package checker_test
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+/*! InsecureSkipVerify set to true disables TLS certificate verification */
+var globalConfig = &tls.Config{InsecureSkipVerify: true}
+
+func literals() {
+	_ = &tls.Config{
+		/*! InsecureSkipVerify set to true disables TLS certificate verification */
+		InsecureSkipVerify: true,
+		/*! MinVersion set to tls.VersionTLS10 allows insecure TLS versions; use tls.VersionTLS12 or higher */
+		MinVersion: tls.VersionTLS10,
+	}
+
+	_ = tls.Config{
+		/*! MaxVersion set to tls.VersionTLS11 limits connections to insecure TLS versions; use tls.VersionTLS12 or higher */
+		MaxVersion: tls.VersionTLS11,
+	}
+
+	_ = []tls.Config{
+		{
+			/*! MinVersion set to tls.VersionTLS11 allows insecure TLS versions; use tls.VersionTLS12 or higher */
+			MinVersion: tls.VersionTLS11,
+		},
+	}
+}
+
+type wrappedConfig struct {
+	tls.Config
+}
+
+func assignments(cfg *tls.Config, tr *http.Transport, w *wrappedConfig) {
+	/*! InsecureSkipVerify set to true disables TLS certificate verification */
+	cfg.InsecureSkipVerify = true
+
+	/*! InsecureSkipVerify set to true disables TLS certificate verification */
+	tr.TLSClientConfig.InsecureSkipVerify = true
+
+	/*! MinVersion set to tls.VersionSSL30 allows insecure TLS versions; use tls.VersionTLS12 or higher */
+	w.MinVersion = tls.VersionSSL30
+}
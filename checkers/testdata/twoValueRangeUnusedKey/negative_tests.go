@@ -0,0 +1,74 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+type big struct {
+	data [256]byte
+}
+
+func (p *point) move() { p.x++ }
+
+func goodRanges(xs []int) {
+	for i := range xs {
+		_ = i
+	}
+	for range xs {
+	}
+	for _, x := range xs {
+		_ = x
+	}
+}
+
+func indexUsed(xs []int) {
+	for i := 0; i < len(xs); i++ {
+		fmt.Println(i, xs[i])
+	}
+}
+
+func elemModified(points []point) {
+	for i := 0; i < len(points); i++ {
+		points[i] = point{}
+	}
+	for i := 0; i < len(points); i++ {
+		points[i].x = 1
+	}
+	for i := 0; i < len(points); i++ {
+		points[i].move()
+	}
+	for i := 0; i < len(points); i++ {
+		p := &points[i]
+		p.y++
+	}
+}
+
+func bigElems(xs []big) {
+	for i := 0; i < len(xs); i++ {
+		fmt.Println(xs[i].data[0])
+	}
+}
+
+func stringIndex(s string) {
+	for i := 0; i < len(s); i++ {
+		fmt.Println(s[i])
+	}
+}
+
+func differentBounds(xs, ys []int) {
+	for i := 0; i < len(xs); i++ {
+		fmt.Println(ys[i])
+	}
+	for i := 1; i < len(xs); i++ {
+		fmt.Println(xs[i])
+	}
+	for i := 0; i <= len(xs)-1; i++ {
+		fmt.Println(xs[i])
+	}
+}
+
+func usedTwice(xs []int) {
+	for i := 0; i < len(xs); i++ {
+		fmt.Println(xs[i], xs[i])
+	}
+}
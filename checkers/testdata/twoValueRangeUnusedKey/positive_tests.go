@@ -0,0 +1,54 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+func blankValue(xs []int, m map[string]int) {
+	/*! `for i, _ := range xs` can be simplified to `for i := range xs` */
+	for i, _ := range xs {
+		_ = i
+	}
+
+	/*! `for k, _ = range m` can be simplified to `for k = range m` */
+	for k, _ = range m {
+		_ = k
+	}
+}
+
+var k string
+
+func blankKey(xs []int, ch chan int) {
+	/*! range with blank identifiers can be simplified to `for range xs` */
+	for _ = range xs {
+	}
+
+	/*! range with blank identifiers can be simplified to `for range ch` */
+	for _ = range ch {
+	}
+}
+
+type point struct {
+	x, y int
+}
+
+func indexLoop(points []point, names [4]string) {
+	/*! index i is only used to read points[i]; use `for _, v := range points` */
+	for i := 0; i < len(points); i++ {
+		fmt.Println(points[i].x)
+	}
+
+	/*! index j is only used to read names[j]; use `for _, elem := range names` */
+	for j := 0; j < len(names); j++ {
+		v := names[j]
+		fmt.Println(v)
+	}
+}
+
+func indexLoopWithComment(xs []string) {
+	/*! index i is only used to read xs[i]; use `for _, v := range xs` */
+	for i := 0; i < len(xs); i++ {
+		// Print every element.
+		fmt.Println(xs[i])
+	}
+}
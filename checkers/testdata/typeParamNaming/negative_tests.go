@@ -0,0 +1,26 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+func keys[K comparable, V any](m map[K]V) []K { return nil }
+
+func convert[From, To any](x From, f func(From) To) To { return f(x) }
+
+func sum[T1, T2 ~int](a T1, b T2) int { return int(a) + int(b) }
+
+func ignored[_ any]() {}
+
+type set[E comparable] map[E]struct{}
+
+func (s set[E]) add(x E) { s[x] = struct{}{} }
+
+func (s *set[T]) remove(x T) { delete(*s, x) }
+
+func (p pair[A, B]) value() B { return p.val }
+
+func (p *pair[K, V]) setValue(v V) { p.val = v }
+
+type twoAny[A, B any] struct{}
+
+func (twoAny[B, A]) swapped() {}
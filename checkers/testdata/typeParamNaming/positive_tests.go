@@ -0,0 +1,32 @@
+//go:build go1.18
+// +build go1.18
+
+package checker_test
+
+type Request struct{ URL string }
+
+/*! type parameter elem doesn't match ^[A-Z][A-Za-z0-9]*$ */
+/*! type parameter result doesn't match ^[A-Z][A-Za-z0-9]*$ */
+func mapSlice[elem, result any](xs []elem, f func(elem) result) []result {
+	return nil
+}
+
+/*! type parameter t doesn't match ^[A-Z][A-Za-z0-9]*$ */
+func first[t any](xs []t) t { return xs[0] }
+
+/*! type parameter Request shadows the package-level type Request */
+func handle[Request any](r Request) {}
+
+/*! type parameter key_type doesn't match ^[A-Z][A-Za-z0-9]*$ */
+type cache[key_type comparable, V any] struct {
+	items map[key_type]V
+}
+
+type pair[K comparable, V any] struct {
+	key K
+	val V
+}
+
+/*! Key method names the comparable-constrained type parameter V, but pair declares V as any-constrained; use the declared names */
+/*! Key method names the any-constrained type parameter K, but pair declares K as comparable-constrained; use the declared names */
+func (p pair[V, K]) Key() V { return p.key }
@@ -0,0 +1,35 @@
+package checker_test
+
+import (
+	"os"
+	"os/signal"
+)
+
+func buffered() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+
+	signal.Notify(make(chan os.Signal, 1), os.Interrupt)
+
+	const size = 4
+	var c2 = make(chan os.Signal, size)
+	signal.Notify(c2)
+}
+
+func reassignedBuffered() {
+	c := make(chan os.Signal)
+	c = make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+}
+
+func madeElsewhere() {
+	c := newSignalChan()
+	signal.Notify(c, os.Interrupt)
+}
+
+func newSignalChan() chan os.Signal { return make(chan os.Signal, 1) }
+
+func dynamicSize(n int) {
+	c := make(chan os.Signal, n)
+	signal.Notify(c, os.Interrupt)
+}
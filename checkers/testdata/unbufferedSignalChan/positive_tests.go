@@ -0,0 +1,49 @@
+package checker_test
+
+import (
+	"os"
+	"os/signal"
+)
+
+func directMake() {
+	/*! make(chan os.Signal) is unbuffered, signal.Notify may drop signals; use a buffered channel */
+	signal.Notify(make(chan os.Signal), os.Interrupt)
+}
+
+func madeBefore() {
+	c := make(chan os.Signal)
+	/*! c is unbuffered, signal.Notify may drop signals; use a buffered channel */
+	signal.Notify(c, os.Interrupt)
+}
+
+func zeroSize() {
+	const size = 0
+	c := make(chan os.Signal, size)
+	/*! c is unbuffered, signal.Notify may drop signals; use a buffered channel */
+	signal.Notify(c, os.Interrupt)
+
+	var c2 = make(chan os.Signal, size*10)
+	/*! c2 is unbuffered, signal.Notify may drop signals; use a buffered channel */
+	signal.Notify(c2)
+}
+
+func reassigned() {
+	c := make(chan os.Signal, 1)
+	c = make(chan os.Signal)
+	/*! c is unbuffered, signal.Notify may drop signals; use a buffered channel */
+	signal.Notify(c, os.Interrupt)
+}
+
+type signalHandler struct {
+	ch chan os.Signal
+}
+
+func (h *signalHandler) fieldChan() {
+	/*! can't prove that h.ch is buffered, signal.Notify may drop signals */
+	signal.Notify(h.ch, os.Interrupt)
+}
+
+func paramChan(c chan os.Signal) {
+	/*! can't prove that c is buffered, signal.Notify may drop signals */
+	signal.Notify(c, os.Interrupt)
+}
@@ -0,0 +1,5 @@
+package checker_test_test
+
+type fake struct{}
+
+func NewFake() *fake { return nil }
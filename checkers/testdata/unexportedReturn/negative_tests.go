@@ -0,0 +1,31 @@
+package checker_test
+
+import "bytes"
+
+type conn struct{}
+
+func (c *conn) Close() error { return nil }
+
+type Closer interface {
+	Close() error
+}
+
+type ClientMaker interface {
+	Make() *conn
+}
+
+type Factory struct{}
+
+func (Factory) Make() *conn { return nil }
+
+func NewCloser() Closer { return &conn{} }
+
+func newConn() *conn { return &conn{} }
+
+func (c *conn) Clone() *conn { return c }
+
+func NewBuffer() *bytes.Buffer { return nil }
+
+func NewFactory() *Factory { return nil }
+
+func NoResults() {}
@@ -0,0 +1,22 @@
+package checker_test
+
+type client struct{}
+
+type options map[string]string
+
+type Service struct{}
+
+/*! exported NewClient returns unexported client, callers outside the package can't name it */
+func NewClient() *client { return &client{} }
+
+/*! exported Clients returns unexported client, callers outside the package can't name it */
+func Clients() ([]*client, error) { return nil, nil }
+
+/*! exported Options returns unexported options, callers outside the package can't name it */
+func Options() (opts options) { return nil }
+
+/*! exported ByName returns unexported client, callers outside the package can't name it */
+func ByName() map[string]client { return nil }
+
+/*! exported Client returns unexported client, callers outside the package can't name it */
+func (s *Service) Client() client { return client{} }
@@ -96,3 +96,13 @@ func namedPointers2() (**namedInt, *namedStruct) {
 func namedPointers3() (**namedInt, ***namedStruct) {
 	return nil, nil
 }
+
+type myBool bool
+
+func commaOk() (namedInt, bool) {
+	return 0, false
+}
+
+func namedBool() (namedInt, myBool) {
+	return 0, false
+}
@@ -80,3 +80,72 @@ func named3() (namedInt, namedStruct, namedInt) {
 func namedAndPrimitive() (namedInt, int) {
 	return 0, 0
 }
+
+/*! consider giving a name to these results */
+func size() (int, int, error) {
+	return 0, 0, nil
+}
+
+func useSize() {
+	width, height, err := size()
+	_, _, _ = width, height, err
+	width, height, _ = size()
+}
+
+type Rect struct{}
+
+/*! consider giving a name to these results */
+func scaled() (float64, float64, *Rect, error) {
+	return 0, 0, nil, nil
+}
+
+func useScaled() {
+	x, _, _, _ := scaled()
+	_, y, _, _ := scaled()
+	_, _ = x, y
+}
+
+/*! consider giving a name to these results */
+func ambiguous() (int, int) {
+	return 0, 0
+}
+
+func useAmbiguous() {
+	a, b := ambiguous()
+	c, b := ambiguous()
+	_, _, _ = a, b, c
+}
+
+/*! consider giving a name to these results */
+func nameConflict(width int) (int, int) {
+	return width, width
+}
+
+func useNameConflict() {
+	width, height := nameConflict(1)
+	_, _ = width, height
+}
+
+type HTTPClient struct{}
+
+/*! consider giving a name to these results */
+func clients() (*HTTPClient, *HTTPClient) {
+	return nil, nil
+}
+
+func useClients() {
+	primary, _ := clients()
+	_ = primary
+}
+
+type URL struct{}
+
+/*! consider giving a name to these results */
+func resolve() (*URL, *URL) {
+	return nil, nil
+}
+
+func useResolve() {
+	base, _ := resolve()
+	_ = base
+}
@@ -0,0 +1,31 @@
+//go:build go1.20
+// +build go1.20
+
+package checker_test
+
+import "unsafe"
+
+func safeConversions(b []byte, s string) (string, []byte) {
+	return unsafe.String(unsafe.SliceData(b), len(b)), unsafe.Slice(unsafe.StringData(s), len(s))
+}
+
+func mutateBeforeConversion(b []byte) string {
+	b[0] = 'x'
+	copy(b, "abc")
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+func mutateOtherSlice(b, other []byte) string {
+	s := unsafe.String(unsafe.SliceData(b), len(b))
+	other[0] = 'x'
+	b = other
+	return s
+}
+
+func pointerCasts(x *int64) *uint64 {
+	return (*uint64)(unsafe.Pointer(x))
+}
+
+func intCast(x int64) uint64 {
+	return *(*uint64)(unsafe.Pointer(&x))
+}
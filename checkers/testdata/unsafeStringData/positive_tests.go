@@ -0,0 +1,58 @@
+//go:build go1.20
+// +build go1.20
+
+package checker_test
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+func bytesToString(b []byte) string {
+	/*! *(*string)(unsafe.Pointer(&b)) depends on the slice header layout; use unsafe.String(unsafe.SliceData(b), len(b)) */
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+func stringToBytes(s string) []byte {
+	/*! *(*[]byte)(unsafe.Pointer(&s)) depends on the string header layout and leaves the slice capacity undefined; use unsafe.Slice(unsafe.StringData(s), len(s)) */
+	return *(*[]byte)(unsafe.Pointer(&s))
+}
+
+func headerRoundTrip(s string) []byte {
+	/*! reflect.StringHeader layout is not guaranteed to match the runtime representation; use unsafe.String or unsafe.StringData instead */
+	sh := (*reflect.StringHeader)(unsafe.Pointer(&s))
+	/*! reflect.SliceHeader layout is not guaranteed to match the runtime representation; use unsafe.Slice or unsafe.SliceData instead */
+	bh := reflect.SliceHeader{Data: sh.Data, Len: sh.Len, Cap: sh.Len}
+	return *(*[]byte)(unsafe.Pointer(&bh))
+}
+
+func mutateAfterCast(b []byte) string {
+	/*! *(*string)(unsafe.Pointer(&b)) depends on the slice header layout; use unsafe.String(unsafe.SliceData(b), len(b)) */
+	s := *(*string)(unsafe.Pointer(&b))
+	/*! b is mutated after it was converted to string with unsafe at line 31; the string shares its memory and must not change */
+	b[0] = 'x'
+	return s
+}
+
+func mutateAfterUnsafeString(b []byte, src []byte) string {
+	s := unsafe.String(unsafe.SliceData(b), len(b))
+	/*! b is mutated after it was converted to string with unsafe at line 38; the string shares its memory and must not change */
+	copy(b, src)
+	return s
+}
+
+func mutateArray() string {
+	var buf [16]byte
+	buf[0] = 'a'
+	s := unsafe.String(&buf[0], 1)
+	/*! buf is mutated after it was converted to string with unsafe at line 47; the string shares its memory and must not change */
+	buf[0]++
+	return s
+}
+
+func reuseBuffer(b []byte) string {
+	s := unsafe.String(&b[0], len(b))
+	/*! b is mutated after it was converted to string with unsafe at line 54; the string shares its memory and must not change */
+	b = append(b[:0], "next"...)
+	return s
+}
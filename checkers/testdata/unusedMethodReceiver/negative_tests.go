@@ -0,0 +1,54 @@
+package checker_test
+
+import (
+	"fmt"
+	"io"
+)
+
+type service struct {
+	id int
+}
+
+func (s *service) ID() int { return s.id }
+
+func (s *service) Start() {}
+
+func (s *service) Stop() error {
+	panic("not implemented")
+}
+
+func (*service) Name() string { return "service" }
+
+func (_ *service) Kind() string { return "kind" }
+
+func (s *service) String() string {
+	return fmt.Sprint(func() int { return s.id }())
+}
+
+type notFoundError struct {
+	name string
+}
+
+func (e *notFoundError) Error() string { return "not found" }
+
+type discard struct{}
+
+func (d discard) Write(p []byte) (int, error) { return len(p), nil }
+
+var _ io.Writer = discard{}
+
+type shape interface {
+	Sides() int
+}
+
+type square struct{ size int }
+
+func (sq square) Sides() int { return 4 }
+
+type parser struct {
+	src string
+}
+
+func (p *parser) Parse() string { return p.trim(p.src) }
+
+func (p *parser) trim(s string) string { return s }
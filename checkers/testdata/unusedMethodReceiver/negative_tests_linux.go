@@ -0,0 +1,3 @@
+package checker_test
+
+func (s *service) Platform() string { return "linux" }
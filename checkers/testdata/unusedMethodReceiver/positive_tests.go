@@ -0,0 +1,29 @@
+package checker_test
+
+import (
+	"fmt"
+)
+
+type config struct {
+	name string
+}
+
+/*! receiver c is not used in Kind, rename it to _ (or make Kind a function if it's not needed as a method) */
+func (c *config) Kind() string { return "config" }
+
+/*! receiver cfg is not used in Print, rename it to _ (or make Print a function if it's not needed as a method) */
+func (cfg config) Print(x int) {
+	fmt.Println(x)
+}
+
+type handler func()
+
+/*! receiver h is not used in Describe, rename it to _ (or make Describe a function if it's not needed as a method) */
+func (h handler) Describe() string {
+	return fmt.Sprint("handler")
+}
+
+/*! receiver h is not used in Panic, rename it to _ (or make Panic a function if it's not needed as a method) */
+func (h handler) Panic() {
+	panic("unexpected call")
+}
@@ -0,0 +1,43 @@
+package checker_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func joinPath(base, item string) {
+	u, _ := url.JoinPath(base, "items", item)
+	_, _ = http.Get(u)
+}
+
+func escapedQuery(base, query string, page int) {
+	_, _ = http.Get(base + "/search?q=" + url.QueryEscape(query) + "&page=" + strconv.Itoa(page))
+	_, _ = http.Get(base + "/search?" + url.Values{"q": {query}}.Encode())
+}
+
+func escapedPath(owner string) {
+	_, _ = http.Get(fmt.Sprintf("https://api.example.com/users/%s", url.PathEscape(owner)))
+}
+
+func constantURL(base string) {
+	_, _ = http.Get("https://example.com" + "/status")
+	_, _ = http.Get(base)
+}
+
+func notURL(dir, file string) string {
+	return dir + "/" + file
+}
+
+func numericSegment(base string, id int) {
+	_, _ = http.Get(fmt.Sprintf("https://example.com/items/%d", id))
+}
+
+func suffix(base, env string) {
+	_, _ = http.Get(base + "-" + env)
+}
+
+func notURLFormat(host, path string) {
+	_, _ = http.Get(fmt.Sprintf("%s%s", host, path))
+}
@@ -0,0 +1,56 @@
+package checker_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type apiClient struct {
+	baseURL string
+	http    *http.Client
+}
+
+func (c *apiClient) user(id string) {
+	/*! URL path built with concatenation; use url.JoinPath(c.baseURL, "users", id) */
+	_, _ = c.http.Get(c.baseURL + "/users/" + id)
+}
+
+func getItem(base, item string) {
+	/*! URL path built with concatenation; use url.JoinPath(base, item) */
+	_, _ = http.Get(base + "/" + item)
+}
+
+func search(base, query string, page int) {
+	/*! query parameter query is concatenated without escaping; build the query with url.Values and its Encode method */
+	u := base + "/search?page=" + strconv.Itoa(page) + "&q=" + query
+	_, _ = http.NewRequest("GET", u, nil)
+}
+
+func formatPath(owner, repo string) {
+	/*! path segment owner is inserted into URL "https://api.example.com/repos/%s/%s" without escaping; escape it with url.PathEscape */
+	_, _ = http.Get(fmt.Sprintf("https://api.example.com/repos/%s/%s", owner, repo))
+}
+
+func formatQuery(id int, name string) {
+	/*! query parameter name is inserted into URL "/api/items?id=%d&name=%s" without escaping; build the query with url.Values and its Encode method */
+	_, _ = http.Get(fmt.Sprintf("/api/items?id=%d&name=%s", id, name))
+}
+
+type webhook struct {
+	URL string
+}
+
+func newWebhook(host, token string) webhook {
+	return webhook{
+		/*! URL path built with concatenation; use url.JoinPath(host, "hooks", token) */
+		URL: host + "/hooks/" + token,
+	}
+}
+
+func fetch(targetURL string) {}
+
+func callWithURLParam(base, name string) {
+	/*! URL path built with concatenation; use url.JoinPath(base, "files", name) */
+	fetch(base + "/files/" + name)
+}
@@ -0,0 +1,55 @@
+package checker_test
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"math/rand"
+	"time"
+)
+
+func cryptoRand() string {
+	token := make([]byte, 16)
+	crand.Read(token)
+	secret := hex.EncodeToString(token)
+	return secret
+}
+
+func GenerateSessionToken() string {
+	buf := make([]byte, 16)
+	if _, err := crand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+func notSecrets(items []string) {
+	jitter := time.Duration(rand.Intn(100)) * time.Millisecond
+	time.Sleep(jitter)
+
+	rand.Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+
+	keys := rand.Perm(10)
+	_ = keys
+
+	monkey := rand.Int()
+	_ = monkey
+}
+
+func randomIndex(n int) int {
+	return rand.Intn(n)
+}
+
+func GenerateToken(n int) string {
+	// Returned value doesn't come from math/rand.
+	_ = rand.Intn(n)
+	return "static"
+}
+
+func tokenInClosure() {
+	f := func() int {
+		return rand.Int()
+	}
+	_ = f
+}
@@ -0,0 +1,53 @@
+package checker_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"math/rand"
+	"strconv"
+)
+
+type session struct {
+	id    int
+	token string
+}
+
+func readIntoSecret() {
+	token := make([]byte, 16)
+	/*! token is generated with math/rand, which is predictable; use crypto/rand for secrets */
+	rand.Read(token)
+}
+
+func derivedValue() string {
+	buf := make([]byte, 32)
+	rand.Read(buf)
+	/*! apiKey is generated with math/rand, which is predictable; use crypto/rand for secrets */
+	apiKey := hex.EncodeToString(buf)
+	return apiKey
+}
+
+func fields(s *session, r *rand.Rand) {
+	/*! token is generated with math/rand, which is predictable; use crypto/rand for secrets */
+	s.token = strconv.Itoa(r.Int())
+
+	_ = session{
+		id: rand.Int(),
+		/*! token is generated with math/rand, which is predictable; use crypto/rand for secrets */
+		token: strconv.Itoa(rand.Intn(1000000)),
+	}
+}
+
+func GenerateResetToken() string {
+	buf := make([]byte, 16)
+	for i := range buf {
+		buf[i] = byte(rand.Intn(256))
+	}
+	/*! base64.StdEncoding.EncodeToString(buf) is generated with math/rand, which is predictable; use crypto/rand for secrets */
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+func newValue() int64 {
+	/*! nonce is generated with math/rand, which is predictable; use crypto/rand for secrets */
+	var nonce = rand.Int63()
+	return nonce
+}
@@ -0,0 +1,59 @@
+package checker_test
+
+import (
+	"net/http"
+)
+
+func statusThenWrite(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("created"))
+}
+
+func branches(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		w.Write([]byte("posted"))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func exclusiveBranches(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	switch r.Method {
+	case "GET":
+		w.Write(nil)
+	default:
+		http.Error(w, "bad method", http.StatusMethodNotAllowed)
+	}
+}
+
+func returnAfterError(w http.ResponseWriter, r *http.Request) {
+	if r.URL == nil {
+		http.Error(w, "no url", http.StatusBadRequest)
+		return
+	}
+	w.Write(nil)
+}
+
+func differentWriters(w1, w2 http.ResponseWriter) {
+	w1.Write(nil)
+	w2.WriteHeader(http.StatusOK)
+}
+
+func headerAfterWrite(w http.ResponseWriter) {
+	w.Write(nil)
+	w.Header().Set("X-Test", "1")
+}
+
+type fakeWriter struct{}
+
+func (fakeWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (fakeWriter) WriteHeader(int)            {}
+
+func notResponseWriter(w fakeWriter) {
+	w.Write(nil)
+	w.WriteHeader(http.StatusOK)
+}
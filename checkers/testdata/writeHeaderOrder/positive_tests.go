@@ -0,0 +1,56 @@
+package checker_test
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func writeThenStatus(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("created"))
+	/*! w.WriteHeader has no effect after w.Write, the response status is already sent */
+	w.WriteHeader(http.StatusCreated)
+}
+
+func printThenStatus(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "hello, %s", r.URL.Path)
+	if r.Method == "POST" {
+		/*! w.WriteHeader has no effect after fmt.Fprintf, the response status is already sent */
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func doubleStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	/*! w.WriteHeader has no effect after w.WriteHeader, the response status is already sent */
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func checkedWrite(w http.ResponseWriter, r *http.Request) {
+	if _, err := w.Write(nil); err != nil {
+		return
+	}
+	/*! w.WriteHeader has no effect after w.Write, the response status is already sent */
+	w.WriteHeader(http.StatusOK)
+}
+
+func writesAfterError(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "bad method", http.StatusMethodNotAllowed)
+		/*! w.Write writes to the response after http.Error; return after sending the error */
+		w.Write([]byte("ok"))
+	}
+}
+
+func statusAfterError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+	/*! w.WriteHeader writes to the response after http.Error; return after sending the error */
+	w.WriteHeader(http.StatusOK)
+}
+
+func handlerLit() http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write(nil)
+		/*! rw.WriteHeader has no effect after rw.Write, the response status is already sent */
+		rw.WriteHeader(http.StatusOK)
+	}
+}
@@ -0,0 +1,157 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "timeEqualMethod"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"includeStructs": {
+			Value: false,
+			Usage: "whether to report == on structs that contain time.Time fields",
+		},
+	}
+	info.Summary = "Detects time.Time values compared with == and !="
+	info.Before = `
+if deadline == now {
+	expire()
+}`
+	info.After = `
+if deadline.Equal(now) {
+	expire()
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForExpr(&timeEqualMethodChecker{
+			ctx:            ctx,
+			includeStructs: info.Params.Bool("includeStructs"),
+		}), nil
+	})
+}
+
+type timeEqualMethodChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	includeStructs bool
+}
+
+func (c *timeEqualMethodChecker) VisitExpr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BinaryExpr:
+		if expr.Op == token.EQL || expr.Op == token.NEQ {
+			c.checkCompare(expr)
+		}
+	case *ast.MapType:
+		if c.isTime(c.ctx.TypeOf(expr.Key)) {
+			c.ctx.Warn(expr, "map with time.Time keys treats equal instants with different locations or monotonic readings as distinct keys; normalize the keys with t.UTC().Round(0) or use t.UnixNano()")
+		}
+	}
+}
+
+func (c *timeEqualMethodChecker) checkCompare(cmp *ast.BinaryExpr) {
+	x, y := cmp.X, cmp.Y
+	if c.isTime(c.ctx.TypeOf(x)) && c.isTime(c.ctx.TypeOf(y)) {
+		if c.isZeroTime(x) {
+			x, y = y, x
+		}
+		if c.isZeroTime(y) {
+			c.warnFixable(cmp, "%s is a comparison with the zero time.Time; use %s instead", c.suggest(cmp.Op, x, "IsZero"))
+			return
+		}
+		c.warnFixable(cmp, "%s compares time.Time values including monotonic clock readings and locations; use %s instead", c.suggest(cmp.Op, x, "Equal", y))
+		return
+	}
+
+	if !c.includeStructs {
+		return
+	}
+	typ := c.ctx.TypeOf(x)
+	if _, ok := typ.Underlying().(*types.Struct); !ok || !types.Identical(typ, c.ctx.TypeOf(y)) {
+		return
+	}
+	if field := c.timeField(typ); field != "" {
+		c.ctx.Warn(cmp, "%s compares %s values that contain time.Time field %s with %s; compare that field with Equal instead",
+			cmp, types.TypeString(typ, types.RelativeTo(c.ctx.Pkg)), field, cmp.Op)
+	}
+}
+
+func (c *timeEqualMethodChecker) warnFixable(cmp *ast.BinaryExpr, format string, suggestion ast.Expr) {
+	c.ctx.WarnFixable(cmp, linter.QuickFix{
+		From:        cmp.Pos(),
+		To:          cmp.End(),
+		Replacement: []byte(astfmt.Sprint(suggestion)),
+	}, format, cmp, suggestion)
+}
+
+// suggest returns a call of the time.Time method on recv,
+// negated if it replaces the != comparison.
+func (c *timeEqualMethodChecker) suggest(op token.Token, recv ast.Expr, method string, args ...ast.Expr) ast.Expr {
+	switch recv.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.CallExpr, *ast.IndexExpr, *ast.ParenExpr, *ast.CompositeLit:
+	default:
+		recv = &ast.ParenExpr{X: recv}
+	}
+	var call ast.Expr = &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: recv, Sel: ast.NewIdent(method)},
+		Args: args,
+	}
+	if op == token.NEQ {
+		call = &ast.UnaryExpr{Op: token.NOT, X: call}
+	}
+	return call
+}
+
+// timeField returns the path to the first time.Time field of the struct typ
+// that is compared by ==, or an empty string if there are none.
+func (c *timeEqualMethodChecker) timeField(typ types.Type) string {
+	return strings.TrimPrefix(c.timePath(typ), ".")
+}
+
+func (c *timeEqualMethodChecker) timePath(typ types.Type) string {
+	if c.isTime(typ) {
+		return ""
+	}
+	switch u := typ.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if c.isTime(field.Type()) {
+				return "." + field.Name()
+			}
+			if path := c.timePath(field.Type()); path != "" {
+				return "." + field.Name() + path
+			}
+		}
+		return ""
+	case *types.Array:
+		if c.isTime(u.Elem()) {
+			return "[i]"
+		}
+		if path := c.timePath(u.Elem()); path != "" {
+			return "[i]" + path
+		}
+	}
+	return ""
+}
+
+func (c *timeEqualMethodChecker) isZeroTime(x ast.Expr) bool {
+	lit, ok := astutil.Unparen(x).(*ast.CompositeLit)
+	return ok && len(lit.Elts) == 0
+}
+
+func (c *timeEqualMethodChecker) isTime(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	return ok && named.String() == "time.Time"
+}
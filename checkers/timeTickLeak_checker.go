@@ -0,0 +1,154 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "timeTickLeak"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects tickers and timers that are never stopped"
+	info.Before = `
+for range time.Tick(time.Second) {
+	poll()
+}`
+	info.After = `
+ticker := time.NewTicker(time.Second)
+defer ticker.Stop()
+for range ticker.C {
+	poll()
+}`
+	info.Note = "time.Tick is only reported for Go versions before 1.23, where unreferenced tickers are not collected"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&timeTickLeakChecker{ctx: ctx}), nil
+	})
+}
+
+type timeTickLeakChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	checkTick bool
+}
+
+func (c *timeTickLeakChecker) EnterFile(f *ast.File) bool {
+	// Since Go 1.23 the garbage collector can recover unreferenced tickers.
+	v := c.ctx.GoVersion
+	c.checkTick = !strings.HasSuffix(c.ctx.Filename, "_test.go") &&
+		(v.IsAny() || !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 23}))
+	return true
+}
+
+func (c *timeTickLeakChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	isMain := c.ctx.Pkg.Name() == "main" && decl.Recv == nil && decl.Name.Name == "main"
+
+	// timers maps the ticker and timer variables to their constructor calls.
+	timers := make(map[types.Object]*ast.CallExpr)
+	var order []types.Object
+	// fired is a set of timer channels that are received right away,
+	// such timers are released after they fire.
+	fired := make(map[*ast.SelectorExpr]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if sel := astcast.ToSelectorExpr(n.X); n.Op == token.ARROW &&
+				calledFuncName(c.ctx.TypesInfo, astcast.ToCallExpr(sel.X)) == "time.NewTimer" {
+				fired[sel] = true
+			}
+		case *ast.CallExpr:
+			if c.checkTick && !isMain && calledFuncName(c.ctx.TypesInfo, n) == "time.Tick" {
+				c.ctx.Warn(n, "time.Tick leaks the underlying ticker; use time.NewTicker with defer ticker.Stop() instead")
+			}
+		case *ast.SelectorExpr:
+			// time.NewTicker(d).C can't be stopped at all.
+			if call := astcast.ToCallExpr(n.X); n.Sel.Name == "C" && !fired[n] && c.isTimerConstructor(call) {
+				c.ctx.Warn(call, "result of %s is never stopped; assign it to a variable and defer its Stop", call.Fun)
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				break
+			}
+			call := astcast.ToCallExpr(n.Rhs[0])
+			if !c.isTimerConstructor(call) {
+				break
+			}
+			if obj := c.ctx.TypesInfo.ObjectOf(astcast.ToIdent(n.Lhs[0])); obj != nil {
+				if _, ok := timers[obj]; !ok {
+					order = append(order, obj)
+				}
+				timers[obj] = call
+			}
+		}
+		return true
+	})
+	if len(timers) == 0 {
+		return
+	}
+
+	// handled is a set of timers that are stopped or passed elsewhere.
+	handled := make(map[types.Object]bool)
+	var stack []ast.Node
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+		id, ok := n.(*ast.Ident)
+		if !ok || len(stack) < 2 {
+			return true
+		}
+		obj := c.ctx.TypesInfo.Uses[id]
+		if _, ok := timers[obj]; !ok {
+			return true
+		}
+		switch p := stack[len(stack)-2].(type) {
+		case *ast.SelectorExpr:
+			if p.X == id && p.Sel.Name == "Stop" {
+				handled[obj] = true
+			}
+		case *ast.CallExpr:
+			if p.Fun != id {
+				handled[obj] = true
+			}
+		case *ast.AssignStmt:
+			for _, rhs := range p.Rhs {
+				if rhs == id {
+					handled[obj] = true
+				}
+			}
+		case *ast.ReturnStmt, *ast.CompositeLit, *ast.KeyValueExpr, *ast.SendStmt, *ast.UnaryExpr, *ast.ValueSpec:
+			handled[obj] = true
+		}
+		return true
+	})
+
+	for _, obj := range order {
+		if !handled[obj] {
+			call := timers[obj]
+			c.ctx.Warn(call, "%s from %s is never stopped; add defer %s.Stop()",
+				obj.Name(), call.Fun, obj.Name())
+		}
+	}
+}
+
+func (c *timeTickLeakChecker) isTimerConstructor(call *ast.CallExpr) bool {
+	switch calledFuncName(c.ctx.TypesInfo, call) {
+	case "time.NewTicker", "time.NewTimer":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,129 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "tlsInsecureConfig"
+	info.Tags = []string{"security", "experimental"}
+	info.Params = linter.CheckerParams{
+		"allowInTests": {
+			Value: true,
+			Usage: "whether to skip _test.go files",
+		},
+	}
+	info.Summary = "Detects tls.Config settings that disable certificate verification or allow old TLS versions"
+	info.Before = `
+cfg := &tls.Config{
+	InsecureSkipVerify: true,
+	MinVersion:         tls.VersionTLS10,
+}`
+	info.After = `
+cfg := &tls.Config{
+	MinVersion: tls.VersionTLS12,
+}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &tlsInsecureConfigChecker{
+			ctx:          ctx,
+			allowInTests: info.Params.Bool("allowInTests"),
+		}, nil
+	})
+}
+
+type tlsInsecureConfigChecker struct {
+	ctx *linter.CheckerContext
+
+	allowInTests bool
+}
+
+// minSecureTLSVersion is tls.VersionTLS12 value.
+const minSecureTLSVersion = 0x0303
+
+func (c *tlsInsecureConfigChecker) WalkFile(f *ast.File) {
+	if c.allowInTests && strings.HasSuffix(c.ctx.Filename, "_test.go") {
+		return
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			c.checkCompositeLit(n)
+		case *ast.AssignStmt:
+			c.checkAssign(n)
+		}
+		return true
+	})
+}
+
+func (c *tlsInsecureConfigChecker) checkCompositeLit(lit *ast.CompositeLit) {
+	if !c.isTLSConfig(c.ctx.TypeOf(lit)) {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			c.checkField(kv, key, kv.Value)
+		}
+	}
+}
+
+func (c *tlsInsecureConfigChecker) checkAssign(assign *ast.AssignStmt) {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		if sel, ok := lhs.(*ast.SelectorExpr); ok {
+			c.checkField(assign, sel.Sel, assign.Rhs[i])
+		}
+	}
+}
+
+func (c *tlsInsecureConfigChecker) checkField(cause ast.Node, field *ast.Ident, value ast.Expr) {
+	obj, ok := c.ctx.TypesInfo.ObjectOf(field).(*types.Var)
+	if !ok || !obj.IsField() || obj.Pkg() == nil || obj.Pkg().Path() != "crypto/tls" {
+		return
+	}
+	cv := c.ctx.TypesInfo.Types[value].Value
+	if cv == nil {
+		return // Can't check dynamic values
+	}
+
+	switch field.Name {
+	case "InsecureSkipVerify":
+		if constant.BoolVal(cv) {
+			c.ctx.Warn(cause, "%s set to %s disables TLS certificate verification", field, value)
+		}
+	case "MinVersion":
+		if v, ok := constant.Int64Val(cv); ok && v != 0 && v < minSecureTLSVersion {
+			c.ctx.Warn(cause, "%s set to %s allows insecure TLS versions; use tls.VersionTLS12 or higher",
+				field, value)
+		}
+	case "MaxVersion":
+		if v, ok := constant.Int64Val(cv); ok && v != 0 && v < minSecureTLSVersion {
+			c.ctx.Warn(cause, "%s set to %s limits connections to insecure TLS versions; use tls.VersionTLS12 or higher",
+				field, value)
+		}
+	}
+}
+
+func (c *tlsInsecureConfigChecker) isTLSConfig(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "crypto/tls" && named.Obj().Name() == "Config"
+}
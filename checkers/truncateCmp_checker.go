@@ -109,7 +109,8 @@ func (c *truncateCmpChecker) checkCmp(cmpX, cmpY ast.Expr) {
 		}
 	}
 
-	c.warn(xcast, xsize*8, ysize*8, xtyp.String())
+	const bitsPerByte = 8
+	c.warn(xcast, xsize*bitsPerByte, ysize*bitsPerByte, xtyp.String())
 }
 
 func (c *truncateCmpChecker) warn(cause ast.Expr, xsize, ysize int64, suggest string) {
@@ -0,0 +1,299 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astcopy"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"github.com/go-toolsmith/typep"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "twoValueRangeUnusedKey"
+	info.Tags = []string{"style", "experimental"}
+	info.Params = linter.CheckerParams{
+		"suggestRangeValue": {
+			Value: false,
+			Usage: "whether to suggest ranging over the values in the index loops that only read the elements",
+		},
+		"sizeThreshold": {
+			Value: 128,
+			Usage: "max element size in bytes for the range value suggestion, keep it in sync with rangeValCopy",
+		},
+	}
+	info.Summary = "Detects range loops with redundant blank identifiers and index loops that can use range"
+	info.Before = `
+for i, _ := range xs {}
+for _ = range xs {}`
+	info.After = `
+for i := range xs {}
+for range xs {}`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForStmt(&twoValueRangeUnusedKeyChecker{
+			ctx:               ctx,
+			suggestRangeValue: info.Params.Bool("suggestRangeValue"),
+			sizeThreshold:     int64(info.Params.Int("sizeThreshold")),
+		}), nil
+	})
+}
+
+type twoValueRangeUnusedKeyChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	suggestRangeValue bool
+	sizeThreshold     int64
+
+	file *ast.File
+}
+
+func (c *twoValueRangeUnusedKeyChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
+}
+
+func (c *twoValueRangeUnusedKeyChecker) VisitStmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.RangeStmt:
+		c.checkRange(stmt)
+	case *ast.ForStmt:
+		if c.suggestRangeValue {
+			c.checkIndexLoop(stmt)
+		}
+	}
+}
+
+func (c *twoValueRangeUnusedKeyChecker) checkRange(rng *ast.RangeStmt) {
+	if rng.Key == nil {
+		return
+	}
+	keyBlank := c.isBlank(rng.Key)
+	valueBlank := rng.Value == nil || c.isBlank(rng.Value)
+
+	switch {
+	case !keyBlank && rng.Value != nil && valueBlank:
+		c.warnFixable(rng, rng.Key.End(), rng.Value.End(), "",
+			"`for %s, _ %s range %s` can be simplified to `for %s %s range %s`",
+			rng.Key, rng.Tok, rng.X, rng.Key, rng.Tok, rng.X)
+	case keyBlank && valueBlank:
+		// for range x was added in Go 1.4.
+		v := c.ctx.GoVersion
+		if !v.IsAny() && !v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 4}) {
+			return
+		}
+		c.warnFixable(rng, rng.Key.Pos(), rng.X.Pos(), "range ",
+			"range with blank identifiers can be simplified to `for range %s`", rng.X)
+	}
+}
+
+// warnFixable reports the warning with a quick fix that replaces [from, to),
+// the fix is omitted if there are comments inside the range.
+func (c *twoValueRangeUnusedKeyChecker) warnFixable(cause ast.Node, from, to token.Pos, replacement, format string, args ...interface{}) {
+	if hasCommentsBetween(c.file, from, to) {
+		c.ctx.Warn(cause, format, args...)
+		return
+	}
+	c.ctx.WarnFixable(cause, linter.QuickFix{
+		From:        from,
+		To:          to,
+		Replacement: []byte(replacement),
+	}, format, args...)
+}
+
+func (c *twoValueRangeUnusedKeyChecker) isBlank(x ast.Expr) bool {
+	return astcast.ToIdent(x).Name == "_"
+}
+
+// checkIndexLoop checks `for i := 0; i < len(s); i++` loops that
+// only read s[i] once and never use i otherwise.
+func (c *twoValueRangeUnusedKeyChecker) checkIndexLoop(loop *ast.ForStmt) {
+	index, slice := c.indexLoopVars(loop)
+	if index == nil {
+		return
+	}
+	indexObj := c.ctx.TypesInfo.ObjectOf(index)
+
+	var elem *ast.IndexExpr
+	uses := 0
+	modified := false
+	var stack []ast.Node
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, n)
+
+		switch n := n.(type) {
+		case *ast.Ident:
+			if c.ctx.TypesInfo.ObjectOf(n) == indexObj {
+				uses++
+				if len(stack) >= 2 {
+					if idx, ok := stack[len(stack)-2].(*ast.IndexExpr); ok && idx.Index == n && astequal.Expr(idx.X, slice) {
+						elem = idx
+						if !c.isElemRead(stack[:len(stack)-1]) {
+							modified = true
+						}
+					}
+				}
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if astequal.Expr(lhs, slice) {
+					modified = true
+				}
+			}
+		}
+		return true
+	})
+	if uses != 1 || elem == nil || modified {
+		return
+	}
+
+	name := c.valueName(loop.Body)
+	suggestion := "for _, " + name + " := range " + astfmt.Sprint(slice)
+	format := "index %s is only used to read %s; use `%s`"
+	if name == "" || hasCommentsIn(c.file, loop) {
+		if name == "" {
+			suggestion = "for _, v := range " + astfmt.Sprint(slice)
+		}
+		c.ctx.Warn(loop, format, index, elem, suggestion)
+		return
+	}
+	c.ctx.WarnFixable(loop, linter.QuickFix{
+		From:        loop.Pos(),
+		To:          loop.End(),
+		Replacement: []byte(c.rangeLoop(loop, slice, elem, name)),
+	}, format, index, elem, suggestion)
+}
+
+// indexLoopVars returns i and s from the `for i := 0; i < len(s); i++` loop.
+func (c *twoValueRangeUnusedKeyChecker) indexLoopVars(loop *ast.ForStmt) (*ast.Ident, ast.Expr) {
+	init, ok := loop.Init.(*ast.AssignStmt)
+	if !ok || init.Tok != token.DEFINE || len(init.Lhs) != 1 || len(init.Rhs) != 1 {
+		return nil, nil
+	}
+	index, ok := init.Lhs[0].(*ast.Ident)
+	if !ok || astcast.ToBasicLit(init.Rhs[0]).Value != "0" {
+		return nil, nil
+	}
+	post, ok := loop.Post.(*ast.IncDecStmt)
+	if !ok || post.Tok != token.INC || astcast.ToIdent(post.X).Name != index.Name {
+		return nil, nil
+	}
+	cond := astcast.ToBinaryExpr(loop.Cond)
+	if cond.Op != token.LSS || astcast.ToIdent(cond.X).Name != index.Name {
+		return nil, nil
+	}
+	lenCall := astcast.ToCallExpr(cond.Y)
+	if !isBuiltinCall(c.ctx.TypesInfo, lenCall, "len") || len(lenCall.Args) != 1 {
+		return nil, nil
+	}
+	slice := lenCall.Args[0]
+	if !typep.SideEffectFree(c.ctx.TypesInfo, slice) {
+		return nil, nil
+	}
+
+	var elemType types.Type
+	switch typ := c.ctx.TypeOf(slice).Underlying().(type) {
+	case *types.Slice:
+		elemType = typ.Elem()
+	case *types.Array:
+		elemType = typ.Elem()
+	default:
+		// Strings are ranged over runes, maps have no index order.
+		return nil, nil
+	}
+	if c.ctx.SizesInfo.Sizeof(elemType) >= c.sizeThreshold {
+		// Copying the element is what rangeValCopy reports.
+		return nil, nil
+	}
+	return index, slice
+}
+
+// isElemRead reports whether the element expression on top
+// of the stack is only read.
+func (c *twoValueRangeUnusedKeyChecker) isElemRead(stack []ast.Node) bool {
+	// Find the outermost expression of the s[i].x.y chain.
+	i := len(stack) - 1
+	for ; i > 0; i-- {
+		if sel, ok := stack[i-1].(*ast.SelectorExpr); ok && sel.X == stack[i] {
+			if s := c.ctx.TypesInfo.Selections[sel]; s != nil && s.Kind() == types.MethodVal {
+				if _, ok := s.Obj().Type().(*types.Signature).Recv().Type().(*types.Pointer); ok {
+					return false
+				}
+			}
+			continue
+		}
+		if idx, ok := stack[i-1].(*ast.IndexExpr); ok && idx.X == stack[i] {
+			continue
+		}
+		break
+	}
+	if i == 0 {
+		return true
+	}
+	top := stack[i]
+	switch parent := stack[i-1].(type) {
+	case *ast.UnaryExpr:
+		return parent.Op != token.AND
+	case *ast.IncDecStmt:
+		return false
+	case *ast.AssignStmt:
+		for _, lhs := range parent.Lhs {
+			if lhs == top {
+				return false
+			}
+		}
+	case *ast.RangeStmt:
+		return parent.Key != top && parent.Value != top
+	}
+	return true
+}
+
+// valueName returns a name for the range value variable that doesn't
+// clash with the identifiers used in body or an empty string.
+func (c *twoValueRangeUnusedKeyChecker) valueName(body *ast.BlockStmt) string {
+	used := make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			used[id.Name] = true
+		}
+		return true
+	})
+	for _, name := range []string{"v", "elem", "item"} {
+		if !used[name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// rangeLoop returns the range loop that replaces the index loop.
+func (c *twoValueRangeUnusedKeyChecker) rangeLoop(loop *ast.ForStmt, slice ast.Expr, elem *ast.IndexExpr, name string) string {
+	body := astcopy.BlockStmt(loop.Body)
+	body = astutil.Apply(body, nil, func(cur *astutil.Cursor) bool {
+		if idx, ok := cur.Node().(*ast.IndexExpr); ok && idx.Lbrack == elem.Lbrack {
+			cur.Replace(ast.NewIdent(name))
+		}
+		return true
+	}).(*ast.BlockStmt)
+	rng := &ast.RangeStmt{
+		Key:   ast.NewIdent("_"),
+		Value: ast.NewIdent(name),
+		Tok:   token.DEFINE,
+		X:     slice,
+		Body:  body,
+	}
+
+	return reindent(c.ctx.FileSet, loop.Pos(), astfmt.Sprint(rng))
+}
@@ -0,0 +1,101 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "typeParamNaming"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Params = linter.CheckerParams{
+		"pattern": {
+			Value: `^[A-Z][A-Za-z0-9]*$`,
+			Usage: "regexp that matches the acceptable type parameter names",
+		},
+	}
+	info.Summary = "Detects type parameters with unconventional or confusing names"
+	info.Before = `func Map[elem, result any](xs []elem, f func(elem) result) []result`
+	info.After = `func Map[T, R any](xs []T, f func(T) R) []R`
+	info.Note = "Type parameters are expected to be single uppercase letters or CamelCase names by default"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		re, err := regexp.Compile(info.Params.String("pattern"))
+		if err != nil {
+			return nil, fmt.Errorf("pattern: %v", err)
+		}
+		return astwalk.WalkerForTypeParam(&typeParamNamingChecker{ctx: ctx, pattern: re}), nil
+	})
+}
+
+type typeParamNamingChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	pattern *regexp.Regexp
+}
+
+func (c *typeParamNamingChecker) VisitTypeParams(decl ast.Node, params *ast.FieldList) {
+	if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+		c.checkReceiver(fn, params)
+		return
+	}
+	for _, field := range params.List {
+		for _, name := range field.Names {
+			c.checkName(name)
+		}
+	}
+}
+
+func (c *typeParamNamingChecker) checkName(name *ast.Ident) {
+	if name.Name == "_" {
+		return
+	}
+	if !c.pattern.MatchString(name.Name) {
+		c.ctx.Warn(name, "type parameter %s doesn't match %s", name, c.pattern)
+		return
+	}
+	if _, ok := c.ctx.Pkg.Scope().Lookup(name.Name).(*types.TypeName); ok {
+		c.ctx.Warn(name, "type parameter %s shadows the package-level type %s", name, name)
+	}
+}
+
+// checkReceiver reports the receiver type parameters that reuse the name
+// of a differently constrained type parameter of the type declaration.
+func (c *typeParamNamingChecker) checkReceiver(fn *ast.FuncDecl, params *ast.FieldList) {
+	recv := c.ctx.TypesInfo.TypeOf(fn.Recv.List[0].Type)
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return
+	}
+	declared := named.Origin().TypeParams()
+	if declared == nil || declared.Len() != len(params.List) {
+		return
+	}
+
+	for i, field := range params.List {
+		name := field.Names[0]
+		for j := 0; j < declared.Len(); j++ {
+			other := declared.At(j)
+			if j == i || other.Obj().Name() != name.Name {
+				continue
+			}
+			param := declared.At(i)
+			if types.Identical(param.Constraint(), other.Constraint()) {
+				continue
+			}
+			c.ctx.Warn(name, "%s method names the %s-constrained type parameter %s, but %s declares %s as %s-constrained; use the declared names",
+				fn.Name, types.TypeString(param.Constraint(), types.RelativeTo(c.ctx.Pkg)), name,
+				named.Obj().Name(), name, types.TypeString(other.Constraint(), types.RelativeTo(c.ctx.Pkg)))
+		}
+	}
+}
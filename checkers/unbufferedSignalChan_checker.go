@@ -0,0 +1,152 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "unbufferedSignalChan"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Params = linter.CheckerParams{
+		"aggressive": {
+			Value: false,
+			Usage: "whether to warn about channels that come from params and struct fields",
+		},
+	}
+	info.Summary = "Detects signal.Notify calls that use unbuffered channels"
+	info.Before = `
+c := make(chan os.Signal)
+signal.Notify(c, os.Interrupt)`
+	info.After = `
+c := make(chan os.Signal, 1)
+signal.Notify(c, os.Interrupt)`
+	info.Note = "signal.Notify does not block sending to the channel, so signals may be dropped"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&unbufferedSignalChanChecker{
+			ctx:        ctx,
+			aggressive: info.Params.Bool("aggressive"),
+		}), nil
+	})
+}
+
+type unbufferedSignalChanChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	aggressive bool
+}
+
+func (c *unbufferedSignalChanChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if calledFuncName(c.ctx.TypesInfo, call) != "os/signal.Notify" {
+			return true
+		}
+		c.checkChan(decl, call, call.Args[0])
+		return true
+	})
+}
+
+func (c *unbufferedSignalChanChecker) checkChan(decl *ast.FuncDecl, call *ast.CallExpr, ch ast.Expr) {
+	switch ch := ch.(type) {
+	case *ast.CallExpr:
+		if c.isUnbufferedMake(ch) {
+			c.warnUnbuffered(ch)
+		}
+	case *ast.Ident:
+		obj, ok := c.ctx.TypesInfo.ObjectOf(ch).(*types.Var)
+		if !ok {
+			return
+		}
+		if c.isParam(decl, obj) {
+			if c.aggressive {
+				c.warnUnproven(ch)
+			}
+			return
+		}
+		if init := c.findLastInit(decl.Body, obj, call.Pos()); init != nil && c.isUnbufferedMake(init) {
+			c.warnUnbuffered(ch)
+		}
+	case *ast.SelectorExpr:
+		if c.aggressive {
+			c.warnUnproven(ch)
+		}
+	}
+}
+
+// findLastInit returns the last make() call assigned to obj before pos.
+// Returns nil if obj was last assigned something other than make() result.
+func (c *unbufferedSignalChanChecker) findLastInit(body *ast.BlockStmt, obj *types.Var, pos token.Pos) *ast.CallExpr {
+	var init ast.Expr
+	assigned := func(lhs *ast.Ident, rhs ast.Expr) {
+		if lhs.Pos() < pos && c.ctx.TypesInfo.ObjectOf(lhs) == obj {
+			init = rhs
+		}
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					assigned(id, n.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) != len(n.Values) {
+				return true
+			}
+			for i, id := range n.Names {
+				assigned(id, n.Values[i])
+			}
+		}
+		return true
+	})
+
+	call := astcast.ToCallExpr(init)
+	if !isBuiltinCall(c.ctx.TypesInfo, call, "make") {
+		return nil
+	}
+	return call
+}
+
+func (c *unbufferedSignalChanChecker) isUnbufferedMake(call *ast.CallExpr) bool {
+	if !isBuiltinCall(c.ctx.TypesInfo, call, "make") {
+		return false
+	}
+	switch len(call.Args) {
+	case 1:
+		return true
+	case 2:
+		size := c.ctx.TypesInfo.Types[call.Args[1]].Value
+		return size != nil && constant.Sign(size) == 0
+	default:
+		return false
+	}
+}
+
+func (c *unbufferedSignalChanChecker) isParam(decl *ast.FuncDecl, obj *types.Var) bool {
+	return obj.Pos() >= decl.Type.Pos() && obj.Pos() < decl.Type.End()
+}
+
+func (c *unbufferedSignalChanChecker) warnUnbuffered(cause ast.Expr) {
+	c.ctx.Warn(cause, "%s is unbuffered, signal.Notify may drop signals; use a buffered channel", cause)
+}
+
+func (c *unbufferedSignalChanChecker) warnUnproven(cause ast.Expr) {
+	c.ctx.Warn(cause, "can't prove that %s is buffered, signal.Notify may drop signals", cause)
+}
@@ -0,0 +1,97 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "unexportedReturn"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects exported functions that return unexported types"
+	info.Before = `func NewClient() *client`
+	info.After = `func NewClient() *Client`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&unexportedReturnChecker{ctx: ctx}), nil
+	})
+}
+
+type unexportedReturnChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *unexportedReturnChecker) EnterFile(f *ast.File) bool {
+	return !strings.HasSuffix(c.ctx.Filename, "_test.go")
+}
+
+func (c *unexportedReturnChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if !decl.Name.IsExported() || decl.Type.Results == nil {
+		return
+	}
+	fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func)
+	if !ok {
+		return
+	}
+	if decl.Recv != nil {
+		recv := fn.Type().(*types.Signature).Recv().Type()
+		if ptr, ok := recv.(*types.Pointer); ok {
+			recv = ptr.Elem()
+		}
+		named, ok := recv.(*types.Named)
+		if !ok || !named.Obj().Exported() || c.implementsExportedIface(fn) {
+			return
+		}
+	}
+
+	for _, field := range decl.Type.Results.List {
+		named := c.unexportedNamed(c.ctx.TypeOf(field.Type))
+		if named == nil {
+			continue
+		}
+		c.ctx.Warn(field.Type, "exported %s returns unexported %s, callers outside the package can't name it",
+			decl.Name, named.Obj().Name())
+	}
+}
+
+// unexportedNamed returns the unexported named type from the current package
+// that is used as typ or its pointer, slice, array, map or chan element.
+func (c *unexportedReturnChecker) unexportedNamed(typ types.Type) *types.Named {
+	for {
+		switch t := typ.(type) {
+		case *types.Named:
+			obj := t.Obj()
+			if obj.Pkg() == c.ctx.Pkg && !obj.Exported() {
+				return t
+			}
+			return nil
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		case *types.Chan:
+			typ = t.Elem()
+		case *types.Map:
+			if named := c.unexportedNamed(t.Key()); named != nil {
+				return named
+			}
+			typ = t.Elem()
+		default:
+			return nil
+		}
+	}
+}
+
+// implementsExportedIface reports whether method fn can be a part of some
+// exported interface from the current package implementation.
+func (c *unexportedReturnChecker) implementsExportedIface(fn *types.Func) bool {
+	return isIfaceMethod(fn, []*types.Package{c.ctx.Pkg}, (*types.TypeName).Exported)
+}
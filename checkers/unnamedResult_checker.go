@@ -2,10 +2,16 @@ package checkers
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
+	"strings"
+	"unicode"
 
 	"github.com/go-critic/go-critic/checkers/internal/astwalk"
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astequal"
+	"github.com/go-toolsmith/astfmt"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 func init() {
@@ -15,16 +21,30 @@ func init() {
 	info.Params = linter.CheckerParams{
 		"checkExported": {
 			Value: false,
-			Usage: "whether to check exported functions",
+			Usage: "deprecated, same as exportedOnly",
+		},
+		"exportedOnly": {
+			Value: false,
+			Usage: "whether to check only exported functions",
+		},
+		"minResults": {
+			Value: 2,
+			Usage: "min number of results that makes the warning trigger",
 		},
 	}
 	info.Summary = "Detects unnamed results that may benefit from names"
 	info.Before = `func f() (float64, float64)`
 	info.After = `func f() (x, y float64)`
+	info.Note = `
+The quick fix takes the result names from the call sites in the same file
+and from the result types. When no name can be derived for some result,
+no quick fix is offered; placeholders like (v1, v2 int) are a possible
+starting point for such results.`
 
 	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
 		c := &unnamedResultChecker{ctx: ctx}
-		c.checkExported = info.Params.Bool("checkExported")
+		c.exportedOnly = info.Params.Bool("exportedOnly") || info.Params.Bool("checkExported")
+		c.minResults = info.Params.Int("minResults")
 		return astwalk.WalkerForFuncDecl(c), nil
 	})
 }
@@ -33,11 +53,19 @@ type unnamedResultChecker struct {
 	astwalk.WalkHandler
 	ctx *linter.CheckerContext
 
-	checkExported bool
+	file *ast.File
+
+	exportedOnly bool
+	minResults   int
+}
+
+func (c *unnamedResultChecker) EnterFile(f *ast.File) bool {
+	c.file = f
+	return true
 }
 
 func (c *unnamedResultChecker) VisitFuncDecl(decl *ast.FuncDecl) {
-	if c.checkExported && !ast.IsExported(decl.Name.Name) {
+	if c.exportedOnly && !ast.IsExported(decl.Name.Name) {
 		return
 	}
 	results := decl.Type.Results
@@ -46,11 +74,13 @@ func (c *unnamedResultChecker) VisitFuncDecl(decl *ast.FuncDecl) {
 		return // Function has no results
 	case len(results.List) != 0 && results.List[0].Names != nil:
 		return // Skip named results
+	case results.NumFields() < c.minResults:
+		return
 	}
 
 	typeName := func(x ast.Expr) string { return c.typeName(c.ctx.TypeOf(x)) }
-	isError := func(x ast.Expr) bool { return qualifiedName(x) == "error" }
-	isBool := func(x ast.Expr) bool { return qualifiedName(x) == "bool" }
+	isError := func(x ast.Expr) bool { return isErrorType(c.ctx.TypeOf(x)) }
+	isBool := func(x ast.Expr) bool { return types.Identical(c.ctx.TypeOf(x), types.Typ[types.Bool]) }
 
 	// Main difference with case of len=2 is that we permit any
 	// typ1 as long as second type is either error or bool.
@@ -98,6 +128,144 @@ func (c *unnamedResultChecker) typeName(typ types.Type) string {
 	}
 }
 
-func (c *unnamedResultChecker) warn(n ast.Node) {
-	c.ctx.Warn(n, "consider giving a name to these results")
+// resultNames returns the names for the decl results, or nil if some result
+// can't be named from the call sites or its type.
+func (c *unnamedResultChecker) resultNames(decl *ast.FuncDecl) []string {
+	if hasCommentsIn(c.file, decl.Type.Results) {
+		return nil // The comments would be lost
+	}
+	results := decl.Type.Results.List
+	names := c.callSiteNames(decl)
+	if names == nil {
+		names = make([]string, len(results))
+	}
+	taken := make(map[string]bool)
+	ast.Inspect(decl, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			taken[id.Name] = true
+		}
+		return true
+	})
+	for i, r := range results {
+		if names[i] == "" {
+			names[i] = c.typeDerivedName(c.ctx.TypeOf(r.Type))
+		}
+		if names[i] == "" || taken[names[i]] || token.IsKeyword(names[i]) {
+			return nil
+		}
+		taken[names[i]] = true
+	}
+	return names
+}
+
+// callSiteNames returns the variable names the decl results are assigned to
+// by the call sites in the current file.
+// Names that differ between the call sites are left empty.
+func (c *unnamedResultChecker) callSiteNames(decl *ast.FuncDecl) []string {
+	fn := c.ctx.TypesInfo.ObjectOf(decl.Name)
+	var names []string
+	conflicts := make(map[int]bool)
+	ast.Inspect(c.file, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Rhs) != 1 || len(assign.Lhs) != len(decl.Type.Results.List) {
+			return true
+		}
+		call, ok := astutil.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+		if !ok || c.calledFunc(call) != fn {
+			return true
+		}
+		if names == nil {
+			names = make([]string, len(assign.Lhs))
+		}
+		for i, lhs := range assign.Lhs {
+			id, ok := lhs.(*ast.Ident)
+			switch {
+			case !ok || id.Name == "_":
+				continue
+			case names[i] == "":
+				names[i] = id.Name
+			case names[i] != id.Name:
+				conflicts[i] = true
+			}
+		}
+		return true
+	})
+	for i := range conflicts {
+		names[i] = ""
+	}
+	return names
+}
+
+func (c *unnamedResultChecker) calledFunc(call *ast.CallExpr) types.Object {
+	switch fn := astutil.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		return c.ctx.TypesInfo.ObjectOf(fn)
+	case *ast.SelectorExpr:
+		return c.ctx.TypesInfo.ObjectOf(fn.Sel)
+	default:
+		return nil
+	}
+}
+
+// typeDerivedName returns the name suggested by the result type,
+// like err for error or config for *Config.
+func (c *unnamedResultChecker) typeDerivedName(typ types.Type) string {
+	if isErrorType(typ) {
+		return "err"
+	}
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return ""
+	}
+	// Lowercase the leading uppercase run, so URL becomes url.
+	// The last letter of the run is kept when it starts the next word,
+	// so HTTPClient becomes httpClient.
+	runes := []rune(named.Obj().Name())
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// formatResults returns the results list with the given names,
+// adjacent results of the same type share the type.
+func (c *unnamedResultChecker) formatResults(results *ast.FieldList, names []string) string {
+	var parts []string
+	group := []string{names[0]}
+	for i := 1; i <= len(results.List); i++ {
+		prev := results.List[i-1].Type
+		if i < len(results.List) && astequal.Expr(prev, results.List[i].Type) {
+			group = append(group, names[i])
+			continue
+		}
+		parts = append(parts, strings.Join(group, ", ")+" "+astfmt.Sprint(prev))
+		if i < len(results.List) {
+			group = []string{names[i]}
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func (c *unnamedResultChecker) warn(decl *ast.FuncDecl) {
+	results := decl.Type.Results
+	names := c.resultNames(decl)
+	if names == nil {
+		c.ctx.Warn(decl, "consider giving a name to these results")
+		return
+	}
+	c.ctx.WarnFixable(decl, linter.QuickFix{
+		From:        results.Pos(),
+		To:          results.End(),
+		Replacement: []byte(c.formatResults(results, names)),
+	}, "consider giving a name to these results")
 }
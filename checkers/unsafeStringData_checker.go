@@ -0,0 +1,270 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "unsafeStringData"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects layout-dependent unsafe string and slice conversions and mutation of the converted bytes"
+	info.Before = `s := *(*string)(unsafe.Pointer(&b))`
+	info.After = `s := unsafe.String(unsafe.SliceData(b), len(b))`
+	info.Note = `
+The unsafely converted strings keep the source arrays alive,
+so only the mutation of the source after the conversion is reported.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return &unsafeStringDataChecker{ctx: ctx}, nil
+	})
+}
+
+type unsafeStringDataChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// unsafeStringConv is a []byte to string conversion that shares memory.
+type unsafeStringConv struct {
+	node ast.Node
+	src  types.Object
+}
+
+func (c *unsafeStringDataChecker) WalkFile(f *ast.File) {
+	if !c.importsUnsafe(f) {
+		return
+	}
+	for _, decl := range f.Decls {
+		ast.Inspect(decl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				c.checkHeader(sel)
+			}
+			return true
+		})
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Body != nil {
+			c.checkFunc(decl.Body)
+		}
+	}
+}
+
+func (c *unsafeStringDataChecker) importsUnsafe(f *ast.File) bool {
+	for _, spec := range f.Imports {
+		if spec.Path.Value == `"unsafe"` {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *unsafeStringDataChecker) hasUnsafeSlices() bool {
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 20})
+}
+
+func (c *unsafeStringDataChecker) checkHeader(sel *ast.SelectorExpr) {
+	obj, ok := c.ctx.TypesInfo.ObjectOf(sel.Sel).(*types.TypeName)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != "reflect" {
+		return
+	}
+	var replacement string
+	switch obj.Name() {
+	case "SliceHeader":
+		replacement = "unsafe.Slice or unsafe.SliceData"
+	case "StringHeader":
+		replacement = "unsafe.String or unsafe.StringData"
+	default:
+		return
+	}
+	if !c.hasUnsafeSlices() {
+		c.ctx.Warn(sel, "reflect.%s layout is not guaranteed to match the runtime representation; don't reinterpret values through it",
+			obj.Name())
+		return
+	}
+	c.ctx.Warn(sel, "reflect.%s layout is not guaranteed to match the runtime representation; use %s instead",
+		obj.Name(), replacement)
+}
+
+func (c *unsafeStringDataChecker) checkFunc(body *ast.BlockStmt) {
+	var convs []unsafeStringConv
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.StarExpr:
+			if conv, ok := c.checkPointerCast(n); ok {
+				convs = append(convs, conv)
+			}
+		case *ast.CallExpr:
+			if src := c.unsafeStringSource(n); src != nil {
+				convs = append(convs, unsafeStringConv{node: n, src: src})
+			}
+		}
+		return true
+	})
+
+	for _, conv := range convs {
+		if mutation := c.findMutation(body, conv); mutation != nil {
+			c.ctx.Warn(mutation, "%s is mutated after it was converted to string with unsafe at line %d; the string shares its memory and must not change",
+				conv.src.Name(), c.ctx.FileSet.Position(conv.node.Pos()).Line)
+		}
+	}
+}
+
+// checkPointerCast reports *(*string)(unsafe.Pointer(&b)) style conversions.
+// For the []byte to string conversions, the conversion info is returned.
+func (c *unsafeStringDataChecker) checkPointerCast(star *ast.StarExpr) (unsafeStringConv, bool) {
+	cast, ok := astutil.Unparen(star.X).(*ast.CallExpr)
+	if !ok || len(cast.Args) != 1 || !c.ctx.TypesInfo.Types[cast.Fun].IsType() {
+		return unsafeStringConv{}, false
+	}
+	ptr, ok := c.ctx.TypeOf(cast.Fun).(*types.Pointer)
+	if !ok {
+		return unsafeStringConv{}, false
+	}
+	unsafePtr, ok := astutil.Unparen(cast.Args[0]).(*ast.CallExpr)
+	if !ok || len(unsafePtr.Args) != 1 || !isUnsafePointer(c.ctx.TypeOf(unsafePtr.Fun)) {
+		return unsafeStringConv{}, false
+	}
+	addr, ok := astutil.Unparen(unsafePtr.Args[0]).(*ast.UnaryExpr)
+	if !ok || addr.Op != token.AND {
+		return unsafeStringConv{}, false
+	}
+
+	srcType := c.ctx.TypeOf(addr.X)
+	if srcType == nil {
+		return unsafeStringConv{}, false
+	}
+	switch {
+	case isStringType(ptr.Elem()) && isByteSlice(srcType):
+		if c.hasUnsafeSlices() {
+			c.ctx.Warn(star, "%s depends on the slice header layout; use unsafe.String(unsafe.SliceData(%s), len(%s))",
+				star, addr.X, addr.X)
+		} else {
+			c.ctx.Warn(star, "%s depends on the slice header layout, the string shares memory with %s", star, addr.X)
+		}
+		if id, ok := astutil.Unparen(addr.X).(*ast.Ident); ok {
+			if obj := c.ctx.TypesInfo.ObjectOf(id); obj != nil {
+				return unsafeStringConv{node: star, src: obj}, true
+			}
+		}
+	case isByteSlice(ptr.Elem()) && isStringType(srcType):
+		if c.hasUnsafeSlices() {
+			c.ctx.Warn(star, "%s depends on the string header layout and leaves the slice capacity undefined; use unsafe.Slice(unsafe.StringData(%s), len(%s))",
+				star, addr.X, addr.X)
+		} else {
+			c.ctx.Warn(star, "%s depends on the string header layout and leaves the slice capacity undefined", star)
+		}
+	}
+	return unsafeStringConv{}, false
+}
+
+// unsafeStringSource returns the bytes source of the
+// unsafe.String(unsafe.SliceData(b), n) or unsafe.String(&b[0], n) call.
+func (c *unsafeStringDataChecker) unsafeStringSource(call *ast.CallExpr) types.Object {
+	if !c.isUnsafeCall(call, "String") || len(call.Args) != 2 {
+		return nil
+	}
+	var src ast.Expr
+	switch arg := astutil.Unparen(call.Args[0]).(type) {
+	case *ast.CallExpr:
+		if c.isUnsafeCall(arg, "SliceData") && len(arg.Args) == 1 {
+			src = arg.Args[0]
+		}
+	case *ast.UnaryExpr:
+		if index, ok := astutil.Unparen(arg.X).(*ast.IndexExpr); ok && arg.Op == token.AND {
+			src = index.X
+		}
+	}
+	if src == nil {
+		return nil
+	}
+	if slice, ok := astutil.Unparen(src).(*ast.SliceExpr); ok {
+		// Slicing a local array, like buf[:n].
+		src = slice.X
+	}
+	id, ok := astutil.Unparen(src).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return c.ctx.TypesInfo.ObjectOf(id)
+}
+
+func (c *unsafeStringDataChecker) isUnsafeCall(call *ast.CallExpr, name string) bool {
+	sel, ok := astutil.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := c.ctx.TypesInfo.ObjectOf(pkg).(*types.PkgName)
+	return ok && pkgName.Imported().Path() == "unsafe"
+}
+
+// findMutation returns the first write to the conv source bytes after the conversion.
+func (c *unsafeStringDataChecker) findMutation(body *ast.BlockStmt, conv unsafeStringConv) ast.Node {
+	var mutation ast.Node
+	isSource := func(x ast.Expr) bool {
+		x = astutil.Unparen(x)
+		for {
+			switch e := x.(type) {
+			case *ast.IndexExpr:
+				x = astutil.Unparen(e.X)
+			case *ast.SliceExpr:
+				x = astutil.Unparen(e.X)
+			case *ast.Ident:
+				return c.ctx.TypesInfo.ObjectOf(e) == conv.src
+			default:
+				return false
+			}
+		}
+	}
+	isElem := func(x ast.Expr) bool {
+		index, ok := astutil.Unparen(x).(*ast.IndexExpr)
+		return ok && isSource(index.X)
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		if mutation != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if lhs.Pos() > conv.node.End() && isElem(lhs) {
+					mutation = n
+				}
+			}
+		case *ast.IncDecStmt:
+			if n.Pos() > conv.node.End() && isElem(n.X) {
+				mutation = n
+			}
+		case *ast.CallExpr:
+			if n.Pos() <= conv.node.End() || len(n.Args) == 0 {
+				break
+			}
+			switch {
+			case isBuiltinCall(c.ctx.TypesInfo, n, "copy"):
+				if isSource(n.Args[0]) {
+					mutation = n
+				}
+			case isBuiltinCall(c.ctx.TypesInfo, n, "append"):
+				if slice, ok := astutil.Unparen(n.Args[0]).(*ast.SliceExpr); ok && isSource(slice) {
+					mutation = n
+				}
+			}
+		}
+		return true
+	})
+	return mutation
+}
+
+func isUnsafePointer(typ types.Type) bool {
+	basic, ok := typ.(*types.Basic)
+	return ok && basic.Kind() == types.UnsafePointer
+}
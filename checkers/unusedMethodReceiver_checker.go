@@ -0,0 +1,151 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "unusedMethodReceiver"
+	info.Tags = []string{"style", "opinionated", "experimental"}
+	info.Summary = "Detects named method receivers that are not used in the method body"
+	info.Before = `func (c *config) Name() string { return "config" }`
+	info.After = `func (*config) Name() string { return "config" }`
+	info.Note = `Only exported methods are checked: unexported helpers are often
+grouped as methods of the type that uses them, for namespacing.`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&unusedMethodReceiverChecker{ctx: ctx}), nil
+	})
+}
+
+// buildVariantSuffixes is a set of GOOS and GOARCH file name suffixes.
+var buildVariantSuffixes = map[string]bool{
+	"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true,
+	"illumos": true, "ios": true, "js": true, "linux": true, "netbsd": true,
+	"openbsd": true, "plan9": true, "solaris": true, "wasip1": true, "windows": true,
+	"386": true, "amd64": true, "arm": true, "arm64": true, "loong64": true,
+	"mips": true, "mipsle": true, "mips64": true, "mips64le": true, "ppc64": true,
+	"ppc64le": true, "riscv64": true, "s390x": true, "wasm": true,
+}
+
+type unusedMethodReceiverChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+func (c *unusedMethodReceiverChecker) EnterFile(f *ast.File) bool {
+	// Other build variants of the file may use the receiver.
+	return !c.isBuildVariant(f)
+}
+
+func (c *unusedMethodReceiverChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Recv == nil || decl.Body == nil || len(decl.Recv.List) != 1 {
+		return
+	}
+	if !ast.IsExported(decl.Name.Name) {
+		return
+	}
+	names := decl.Recv.List[0].Names
+	if len(names) != 1 || names[0].Name == "_" {
+		return
+	}
+	recv := names[0]
+	if c.isStub(decl.Body) {
+		return
+	}
+
+	obj := c.ctx.TypesInfo.ObjectOf(recv)
+	if obj == nil {
+		return
+	}
+	// The interface dictates the method set, not the implementation.
+	if fn, ok := c.ctx.TypesInfo.ObjectOf(decl.Name).(*types.Func); ok && c.implementsIface(fn) {
+		return
+	}
+	used := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && c.ctx.TypesInfo.Uses[id] == obj {
+			used = true
+		}
+		return !used
+	})
+	if used {
+		return
+	}
+
+	c.ctx.WarnFixable(recv, linter.QuickFix{
+		From:        recv.Pos(),
+		To:          recv.End(),
+		Replacement: []byte("_"),
+	}, "receiver %s is not used in %s, rename it to _ (or make %s a function if it's not needed as a method)",
+		recv, decl.Name, decl.Name)
+}
+
+// implementsIface reports whether method fn is required by the error interface
+// or some interface declared in the current or directly imported packages.
+func (c *unusedMethodReceiverChecker) implementsIface(fn *types.Func) bool {
+	errorIface := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	if m := errorIface.Method(0); m.Name() == fn.Name() && types.Identical(m.Type(), fn.Type()) {
+		return true
+	}
+	pkgs := append([]*types.Package{c.ctx.Pkg}, c.ctx.Pkg.Imports()...)
+	return isIfaceMethod(fn, pkgs, nil)
+}
+
+// isStub reports whether body is empty or only panics like the
+// unimplemented methods do.
+func (c *unusedMethodReceiverChecker) isStub(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return true
+	}
+	if len(body.List) != 1 {
+		return false
+	}
+	stmt, ok := body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	call := astcast.ToCallExpr(stmt.X)
+	if !isBuiltinCall(c.ctx.TypesInfo, call, "panic") || len(call.Args) != 1 {
+		return false
+	}
+	tv := c.ctx.TypesInfo.Types[call.Args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return false
+	}
+	msg := strings.ToLower(constant.StringVal(tv.Value))
+	return strings.Contains(msg, "not implemented") || strings.Contains(msg, "unimplemented")
+}
+
+// isBuildVariant reports whether f has build constraints
+// or the GOOS and GOARCH name suffixes.
+func (c *unusedMethodReceiverChecker) isBuildVariant(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, comment := range cg.List {
+			if strings.HasPrefix(comment.Text, "//go:build") || strings.HasPrefix(comment.Text, "// +build") {
+				return true
+			}
+		}
+	}
+	name := filepath.Base(c.ctx.FileSet.Position(f.Pos()).Filename)
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".go"), "_test")
+	parts := strings.Split(name, "_")
+	for _, part := range parts[1:] {
+		if buildVariantSuffixes[part] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,303 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"github.com/go-toolsmith/astfmt"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "urlPathJoin"
+	info.Tags = []string{"style", "experimental"}
+	info.Summary = "Detects URLs that are built with string concatenation or fmt.Sprintf"
+	info.Before = `
+resp, err := http.Get(baseURL + "/users/" + id)
+resp, err := http.Get(baseURL + "/search?q=" + query)`
+	info.After = `
+resp, err := http.Get(url.JoinPath(baseURL, "users", id))
+resp, err := http.Get(baseURL + "/search?" + url.Values{"q": {query}}.Encode())`
+	info.Note = "url.JoinPath is only suggested for Go 1.19 and later"
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&urlPathJoinChecker{ctx: ctx}), nil
+	})
+}
+
+// urlSinks maps the functions that accept URL strings to their URL argument index.
+var urlSinks = map[string]int{
+	"net/http.Get":                   0,
+	"net/http.Head":                  0,
+	"net/http.Post":                  0,
+	"net/http.PostForm":              0,
+	"net/http.NewRequest":            1,
+	"net/http.NewRequestWithContext": 2,
+	"(*net/http.Client).Get":         0,
+	"(*net/http.Client).Head":        0,
+	"(*net/http.Client).Post":        0,
+	"(*net/http.Client).PostForm":    0,
+	"net/http.Redirect":              2,
+	"net/http/httptest.NewRequest":   1,
+}
+
+// urlEscapeFuncs is a set of functions which results are safe to put into URLs.
+var urlEscapeFuncs = map[string]bool{
+	"net/url.QueryEscape":     true,
+	"net/url.PathEscape":      true,
+	"(net/url.Values).Encode": true,
+	"strconv.Itoa":            true,
+	"strconv.FormatInt":       true,
+	"strconv.FormatUint":      true,
+	"strconv.FormatBool":      true,
+}
+
+type urlPathJoinChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	// values maps the local variables to their initialization expressions.
+	values map[types.Object]ast.Expr
+	// checked is a set of already checked URL expressions.
+	checked map[ast.Expr]bool
+}
+
+func (c *urlPathJoinChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	c.values = make(map[types.Object]ast.Expr)
+	c.checked = make(map[ast.Expr]bool)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			c.visitAssign(n)
+		case *ast.CallExpr:
+			c.visitCall(n)
+		case *ast.CompositeLit:
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok && isURLName(astcast.ToIdent(kv.Key).Name) {
+					c.checkURL(kv.Value)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *urlPathJoinChecker) visitAssign(assign *ast.AssignStmt) {
+	if len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		switch lhs := lhs.(type) {
+		case *ast.Ident:
+			if assign.Tok == token.DEFINE {
+				if obj := c.ctx.TypesInfo.ObjectOf(lhs); obj != nil {
+					c.values[obj] = assign.Rhs[i]
+				}
+			}
+		case *ast.SelectorExpr:
+			if isURLName(lhs.Sel.Name) {
+				c.checkURL(assign.Rhs[i])
+			}
+		}
+	}
+}
+
+func (c *urlPathJoinChecker) visitCall(call *ast.CallExpr) {
+	if i, ok := urlSinks[calledFuncName(c.ctx.TypesInfo, call)]; ok {
+		if i < len(call.Args) {
+			c.checkURL(call.Args[i])
+		}
+		return
+	}
+	sig, ok := c.ctx.TypeOf(call.Fun).(*types.Signature)
+	if !ok {
+		return
+	}
+	params := sig.Params()
+	for i := 0; i < params.Len() && i < len(call.Args); i++ {
+		if sig.Variadic() && i == params.Len()-1 {
+			break
+		}
+		if isURLName(params.At(i).Name()) {
+			c.checkURL(call.Args[i])
+		}
+	}
+}
+
+// isURLName reports whether name is a name of the field or parameter that holds URLs.
+func isURLName(name string) bool {
+	return strings.EqualFold(name, "url") || strings.HasSuffix(name, "URL")
+}
+
+func (c *urlPathJoinChecker) checkURL(x ast.Expr) {
+	if id, ok := x.(*ast.Ident); ok {
+		if v := c.values[c.ctx.TypesInfo.ObjectOf(id)]; v != nil {
+			x = v
+		}
+	}
+	if c.checked[x] {
+		return
+	}
+	c.checked[x] = true
+	if typ, ok := c.ctx.TypeOf(x).Underlying().(*types.Basic); !ok || typ.Kind() != types.String {
+		return
+	}
+
+	switch x := x.(type) {
+	case *ast.BinaryExpr:
+		if x.Op == token.ADD {
+			c.checkConcat(x)
+		}
+	case *ast.CallExpr:
+		if calledFuncName(c.ctx.TypesInfo, x) == "fmt.Sprintf" {
+			c.checkSprintf(x)
+		}
+	}
+}
+
+func (c *urlPathJoinChecker) checkConcat(concat *ast.BinaryExpr) {
+	var operands []ast.Expr
+	var flatten func(x ast.Expr)
+	flatten = func(x ast.Expr) {
+		if bin, ok := x.(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+			flatten(bin.X)
+			flatten(bin.Y)
+			return
+		}
+		operands = append(operands, x)
+	}
+	flatten(concat)
+
+	// inQuery is set after the first literal with '?'.
+	inQuery := false
+	for i, operand := range operands {
+		if s, ok := c.constString(operand); ok {
+			if strings.Contains(s, "?") {
+				inQuery = true
+			}
+			continue
+		}
+		if !inQuery || i == 0 || c.isEscaped(operand) {
+			continue
+		}
+		if s, _ := c.constString(operands[i-1]); strings.HasSuffix(s, "=") {
+			c.ctx.Warn(concat, "query parameter %s is concatenated without escaping; build the query with url.Values and its Encode method",
+				operand)
+			return
+		}
+	}
+	if inQuery {
+		return
+	}
+
+	if !c.canUseJoinPath() || len(operands) < 3 {
+		return
+	}
+	if _, ok := c.constString(operands[0]); ok {
+		return
+	}
+	// args are the url.JoinPath arguments.
+	args := []string{astfmt.Sprint(operands[0])}
+	hasSlash := false
+	for _, operand := range operands[1:] {
+		s, ok := c.constString(operand)
+		if !ok {
+			args = append(args, astfmt.Sprint(operand))
+			continue
+		}
+		if !strings.HasPrefix(s, "/") {
+			// Not a path segments concatenation, like base + "-" + suffix.
+			return
+		}
+		hasSlash = true
+		for _, segment := range strings.Split(s, "/") {
+			if segment != "" {
+				args = append(args, strconv.Quote(segment))
+			}
+		}
+	}
+	if hasSlash && len(args) > 1 {
+		c.ctx.Warn(concat, "URL path built with concatenation; use url.JoinPath(%s)", strings.Join(args, ", "))
+	}
+}
+
+func (c *urlPathJoinChecker) checkSprintf(call *ast.CallExpr) {
+	if len(call.Args) < 2 {
+		return
+	}
+	format, ok := c.constString(call.Args[0])
+	if !ok || !c.isURLFormat(format) {
+		return
+	}
+	args := call.Args[1:]
+
+	parts := splitFormat(format)
+	inQuery := false
+	for i, part := range parts {
+		if part.arg == -1 {
+			if strings.Contains(part.text, "?") {
+				inQuery = true
+			}
+			continue
+		}
+		if part.arg >= len(args) || (part.text != "%s" && part.text != "%v") {
+			continue
+		}
+		arg := args[part.arg]
+		if c.isEscaped(arg) {
+			continue
+		}
+		if typ, ok := c.ctx.TypeOf(arg).Underlying().(*types.Basic); !ok || typ.Kind() != types.String {
+			continue
+		}
+		if inQuery {
+			if i > 0 && strings.HasSuffix(parts[i-1].text, "=") {
+				c.ctx.Warn(call, "query parameter %s is inserted into URL %q without escaping; build the query with url.Values and its Encode method",
+					arg, format)
+				return
+			}
+			continue
+		}
+		c.ctx.Warn(call, "path segment %s is inserted into URL %q without escaping; escape it with url.PathEscape",
+			arg, format)
+		return
+	}
+}
+
+// isURLFormat reports whether format looks like an absolute URL or a path.
+func (c *urlPathJoinChecker) isURLFormat(format string) bool {
+	if strings.HasPrefix(format, "http://") || strings.HasPrefix(format, "https://") {
+		return true
+	}
+	return strings.HasPrefix(format, "/") && strings.Count(format, "/") > 1
+}
+
+func (c *urlPathJoinChecker) isEscaped(x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	return ok && urlEscapeFuncs[calledFuncName(c.ctx.TypesInfo, call)]
+}
+
+func (c *urlPathJoinChecker) canUseJoinPath() bool {
+	// url.JoinPath was added in Go 1.19.
+	v := c.ctx.GoVersion
+	return v.IsAny() || v.GreaterOrEqual(linter.GoVersion{Major: 1, Minor: 19})
+}
+
+func (c *urlPathJoinChecker) constString(x ast.Expr) (string, bool) {
+	tv, ok := c.ctx.TypesInfo.Types[x]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
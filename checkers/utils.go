@@ -2,10 +2,15 @@ package checkers
 
 import (
 	"go/ast"
+	"go/constant"
+	"go/token"
 	"go/types"
+	"regexp"
 	"strings"
 
 	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // goStdlib contains `go list std` command output list.
@@ -307,3 +312,276 @@ func identOf(x ast.Node) *ast.Ident {
 		return nil
 	}
 }
+
+// calledFunc returns the function object statically called by the expression.
+// Returns nil for calls of function values, builtins and conversions.
+func calledFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fn := astutil.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return nil
+	}
+	fn, _ := info.ObjectOf(id).(*types.Func)
+	return fn
+}
+
+// calledFuncName returns the fully-qualified name of the function
+// statically called by the expression, like "context.WithValue"
+// or "(*database/sql.Rows).Next".
+//
+// Returns empty string if callee can't be resolved (see calledFunc).
+func calledFuncName(info *types.Info, call *ast.CallExpr) string {
+	fn := calledFunc(info, call)
+	if fn == nil {
+		return ""
+	}
+	return fn.FullName()
+}
+
+// isBuiltinCall reports whether call invokes a builtin function with the specified name.
+func isBuiltinCall(info *types.Info, call *ast.CallExpr, name string) bool {
+	id, ok := astutil.Unparen(call.Fun).(*ast.Ident)
+	if !ok || id.Name != name {
+		return false
+	}
+	_, ok = info.ObjectOf(id).(*types.Builtin)
+	return ok
+}
+
+// isErrorType reports whether typ is a predeclared error type.
+func isErrorType(typ types.Type) bool {
+	return types.Identical(typ, types.Universe.Lookup("error").Type())
+}
+
+// isNil reports whether x is a predeclared nil identifier.
+func isNil(info *types.Info, x ast.Expr) bool {
+	id, ok := astutil.Unparen(x).(*ast.Ident)
+	return ok && info.ObjectOf(id) == types.Universe.Lookup("nil")
+}
+
+// constTripCount returns the number of iterations for loops like
+// `for i := c1; i < c2; i++` where c1 and c2 are constant expressions.
+// If the iterations count can't be computed, false is returned.
+func constTripCount(info *types.Info, loop *ast.ForStmt) (int64, bool) {
+	init := astcast.ToAssignStmt(loop.Init)
+	cond := astcast.ToBinaryExpr(loop.Cond)
+	if len(init.Lhs) != 1 || len(init.Rhs) != 1 {
+		return 0, false
+	}
+	v := info.ObjectOf(astcast.ToIdent(init.Lhs[0]))
+	if v == nil || info.ObjectOf(astcast.ToIdent(cond.X)) != v || !isIncrementOf(info, loop.Post, v) {
+		return 0, false
+	}
+	from := info.Types[init.Rhs[0]].Value
+	to := info.Types[cond.Y].Value
+	if from == nil || to == nil {
+		return 0, false
+	}
+	n, ok := constant.Int64Val(constant.BinaryOp(to, token.SUB, from))
+	if !ok {
+		return 0, false
+	}
+	switch cond.Op {
+	case token.LSS:
+		return n, true
+	case token.LEQ:
+		return n + 1, true
+	default:
+		return 0, false
+	}
+}
+
+// isIncrementOf reports whether stmt is `v++` or `v += 1`.
+func isIncrementOf(info *types.Info, stmt ast.Stmt, v types.Object) bool {
+	switch stmt := stmt.(type) {
+	case *ast.IncDecStmt:
+		return stmt.Tok == token.INC && info.ObjectOf(astcast.ToIdent(stmt.X)) == v
+	case *ast.AssignStmt:
+		if stmt.Tok != token.ADD_ASSIGN || len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return false
+		}
+		one := info.Types[stmt.Rhs[0]].Value
+		return info.ObjectOf(astcast.ToIdent(stmt.Lhs[0])) == v &&
+			one != nil && one.Kind() == constant.Int && constant.Compare(one, token.EQL, constant.MakeInt64(1))
+	default:
+		return false
+	}
+}
+
+// testingParam returns the first named *testing.T, *testing.B, *testing.F
+// or testing.TB parameter from params, or nil if there is none.
+func testingParam(info *types.Info, params *ast.FieldList) *ast.Ident {
+	for _, field := range params.List {
+		typ := info.TypeOf(field.Type)
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		named, ok := typ.(*types.Named)
+		if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "testing" {
+			continue
+		}
+		switch named.Obj().Name() {
+		case "T", "B", "F", "TB":
+			if len(field.Names) != 0 {
+				return field.Names[0]
+			}
+		}
+	}
+	return nil
+}
+
+// loopVars returns a set of the variables declared by the
+// for and range statements from the stack.
+func loopVars(info *types.Info, stack []ast.Node) map[types.Object]bool {
+	vars := make(map[types.Object]bool)
+	for _, n := range stack {
+		var idents []ast.Expr
+		switch n := n.(type) {
+		case *ast.ForStmt:
+			if init, ok := n.Init.(*ast.AssignStmt); ok && init.Tok == token.DEFINE {
+				idents = init.Lhs
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				idents = []ast.Expr{n.Key, n.Value}
+			}
+		}
+		for _, x := range idents {
+			if obj := info.ObjectOf(astcast.ToIdent(x)); obj != nil {
+				vars[obj] = true
+			}
+		}
+	}
+	return vars
+}
+
+// isRuleguardFile reports whether f imports the ruleguard DSL package.
+// Such files describe the rules with Go syntax, but they are never executed.
+func isRuleguardFile(f *ast.File) bool {
+	for _, imp := range f.Imports {
+		if strings.HasPrefix(imp.Path.Value, `"github.com/quasilyte/go-ruleguard/dsl`) {
+			return true
+		}
+	}
+	return false
+}
+
+var generatedFileRE = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// isGeneratedFile reports whether f has a "Code generated ... DO NOT EDIT."
+// comment before the package clause.
+func isGeneratedFile(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, comment := range cg.List {
+			if generatedFileRE.MatchString(comment.Text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sizeDependsOnTypeParam reports whether the size of typ
+// can't be known until the type parameters are instantiated.
+func sizeDependsOnTypeParam(typ types.Type) bool {
+	switch typ := typ.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Named:
+		return sizeDependsOnTypeParam(typ.Underlying())
+	case *types.Array:
+		return sizeDependsOnTypeParam(typ.Elem())
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if sizeDependsOnTypeParam(typ.Field(i).Type()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasUnlabeledBreak reports whether n contains break statements that bind
+// to the enclosing statement, they would break out of a switch instead.
+func hasUnlabeledBreak(n ast.Node) bool {
+	if n == nil {
+		return false
+	}
+	found := false
+	astutil.Apply(n, func(cur *astutil.Cursor) bool {
+		switch n := cur.Node().(type) {
+		case *ast.BranchStmt:
+			if n.Label == nil && n.Tok == token.BREAK {
+				found = true
+			}
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SelectStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.FuncLit:
+			return false
+		}
+		return true
+	}, nil)
+	return found
+}
+
+// hasCommentsIn reports whether there are comments inside n,
+// they would be lost if the node is replaced with its printed copy.
+func hasCommentsIn(f *ast.File, n ast.Node) bool {
+	return hasCommentsBetween(f, n.Pos(), n.End())
+}
+
+// hasCommentsBetween reports whether there are comments inside the [from, to] range.
+func hasCommentsBetween(f *ast.File, from, to token.Pos) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() >= from && cg.End() <= to {
+			return true
+		}
+	}
+	return false
+}
+
+// reindent adds the indentation of the pos line to all lines of s except the first one.
+//
+// The printed statements are not indented, so s can't replace
+// the statement at pos without the reindentation.
+func reindent(fset *token.FileSet, pos token.Pos, s string) string {
+	indent := strings.Repeat("\t", fset.Position(pos).Column-1)
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isIfaceMethod reports whether method fn is required by some interface
+// declared in pkgs. Only the interfaces accepted by the filter are checked,
+// the nil filter accepts all of them.
+func isIfaceMethod(fn *types.Func, pkgs []*types.Package, filter func(*types.TypeName) bool) bool {
+	for _, pkg := range pkgs {
+		scope := pkg.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || (filter != nil && !filter(obj)) {
+				continue
+			}
+			iface, ok := obj.Type().Underlying().(*types.Interface)
+			if !ok {
+				continue
+			}
+			for i := 0; i < iface.NumMethods(); i++ {
+				m := iface.Method(i)
+				if m.Name() == fn.Name() && types.Identical(m.Type(), fn.Type()) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
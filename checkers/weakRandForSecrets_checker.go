@@ -0,0 +1,203 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "weakRandForSecrets"
+	info.Tags = []string{"security", "experimental"}
+	info.Params = linter.CheckerParams{
+		"secretNamePattern": {
+			Value: `(?i)(?:token|secret|passw(?:or)?d|nonce|salt|(?:api|auth|private|secret|session|signing|encryption)key)s?$`,
+			Usage: "regexp that matches the names of variables, fields and functions that hold secrets",
+		},
+	}
+	info.Summary = "Detects secrets that are generated with math/rand"
+	info.Before = `
+token := make([]byte, 16)
+rand.Read(token) // math/rand`
+	info.After = `
+token := make([]byte, 16)
+rand.Read(token) // crypto/rand`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		re, err := regexp.Compile(info.Params.String("secretNamePattern"))
+		if err != nil {
+			return nil, err
+		}
+		return astwalk.WalkerForFuncDecl(&weakRandForSecretsChecker{
+			ctx:          ctx,
+			secretNameRE: re,
+		}), nil
+	})
+}
+
+// weakRandFuncs is a set of math/rand functions and methods
+// that produce pseudo-random values.
+var weakRandFuncs = map[string]bool{
+	"Int":     true,
+	"Intn":    true,
+	"Int31":   true,
+	"Int31n":  true,
+	"Int63":   true,
+	"Int63n":  true,
+	"Uint32":  true,
+	"Uint64":  true,
+	"Float32": true,
+	"Float64": true,
+	"Perm":    true,
+	"Read":    true,
+}
+
+type weakRandForSecretsChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+
+	secretNameRE *regexp.Regexp
+
+	// tainted is a set of variables and fields with math/rand values.
+	tainted       map[types.Object]bool
+	reportedNodes map[ast.Node]bool
+}
+
+func (c *weakRandForSecretsChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.tainted = make(map[types.Object]bool)
+	c.reportedNodes = make(map[ast.Node]bool)
+	c.walk(decl.Body, c.secretNameRE.MatchString(decl.Name.Name))
+}
+
+// walk propagates math/rand values in n.
+// If checkReturns is true, returning such values is reported.
+func (c *weakRandForSecretsChecker) walk(n ast.Node, checkReturns bool) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			c.walk(n.Body, false)
+			return false
+		case *ast.AssignStmt:
+			c.walkAssign(n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			c.walkAssign(lhs, n.Values)
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok && c.isTainted(n.Value) {
+				c.checkSecret(n, key)
+			}
+		case *ast.CallExpr:
+			if c.weakRandFunc(n) == "Read" && len(n.Args) == 1 {
+				c.taint(n, n.Args[0])
+			}
+		case *ast.ReturnStmt:
+			if !checkReturns {
+				break
+			}
+			for _, result := range n.Results {
+				if c.isTainted(result) {
+					c.warn(result, result)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (c *weakRandForSecretsChecker) walkAssign(lhs, rhs []ast.Expr) {
+	switch {
+	case len(lhs) == len(rhs):
+		for i := range lhs {
+			if c.isTainted(rhs[i]) {
+				c.taint(rhs[i], lhs[i])
+			}
+		}
+	case len(rhs) == 1:
+		if c.isTainted(rhs[0]) {
+			for _, x := range lhs {
+				c.taint(rhs[0], x)
+			}
+		}
+	}
+}
+
+// taint marks x as a math/rand value holder.
+// If x looks like a secret, a warning is reported at cause.
+func (c *weakRandForSecretsChecker) taint(cause ast.Node, x ast.Expr) {
+	var id *ast.Ident
+	switch x := x.(type) {
+	case *ast.Ident:
+		id = x
+	case *ast.SelectorExpr:
+		id = x.Sel
+	case *ast.IndexExpr:
+		c.taint(cause, x.X)
+		return
+	case *ast.StarExpr:
+		c.taint(cause, x.X)
+		return
+	default:
+		return
+	}
+	obj := c.ctx.TypesInfo.ObjectOf(id)
+	if obj == nil || id.Name == "_" {
+		return
+	}
+	c.tainted[obj] = true
+	c.checkSecret(cause, id)
+}
+
+func (c *weakRandForSecretsChecker) checkSecret(cause ast.Node, id *ast.Ident) {
+	if c.secretNameRE.MatchString(id.Name) {
+		c.warn(cause, id)
+	}
+}
+
+// isTainted reports whether x contains math/rand values.
+func (c *weakRandForSecretsChecker) isTainted(x ast.Expr) bool {
+	found := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if c.weakRandFunc(n) != "" {
+				found = true
+			}
+		case *ast.Ident:
+			if c.tainted[c.ctx.TypesInfo.ObjectOf(n)] {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// weakRandFunc returns the called math/rand function name.
+// For other calls, empty string is returned.
+func (c *weakRandForSecretsChecker) weakRandFunc(call *ast.CallExpr) string {
+	fn := calledFunc(c.ctx.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "math/rand" || !weakRandFuncs[fn.Name()] {
+		return ""
+	}
+	return fn.Name()
+}
+
+func (c *weakRandForSecretsChecker) warn(cause ast.Node, secret ast.Expr) {
+	if c.reportedNodes[cause] {
+		return
+	}
+	c.reportedNodes[cause] = true
+	c.ctx.Warn(cause, "%s is generated with math/rand, which is predictable; use crypto/rand for secrets", secret)
+}
@@ -0,0 +1,213 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/go-critic/go-critic/checkers/internal/astwalk"
+	"github.com/go-critic/go-critic/framework/linter"
+	"github.com/go-toolsmith/astcast"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+func init() {
+	var info linter.CheckerInfo
+	info.Name = "writeHeaderOrder"
+	info.Tags = []string{"diagnostic", "experimental"}
+	info.Summary = "Detects WriteHeader calls that have no effect because the response is already written"
+	info.Before = `
+w.Write(data)
+w.WriteHeader(http.StatusCreated)`
+	info.After = `
+w.WriteHeader(http.StatusCreated)
+w.Write(data)`
+
+	collection.AddChecker(&info, func(ctx *linter.CheckerContext) (linter.FileWalker, error) {
+		return astwalk.WalkerForFuncDecl(&writeHeaderOrderChecker{ctx: ctx}), nil
+	})
+}
+
+// responseWriteFuncs maps the functions that write to
+// the http.ResponseWriter to the writer argument index.
+var responseWriteFuncs = map[string]int{
+	"net/http.Error":    0,
+	"net/http.NotFound": 0,
+	"net/http.Redirect": 0,
+	"fmt.Fprint":        0,
+	"fmt.Fprintf":       0,
+	"fmt.Fprintln":      0,
+	"io.WriteString":    0,
+	"io.Copy":           0,
+	"io.CopyN":          0,
+}
+
+type writeHeaderOrderChecker struct {
+	astwalk.WalkHandler
+	ctx *linter.CheckerContext
+}
+
+// responseState describes what is known to be written to the response writers
+// along the straight-line path to the current statement.
+type responseState struct {
+	// written maps the response writers to the first write call.
+	written map[types.Object]*ast.CallExpr
+	// errorSent maps the response writers to the http.Error call.
+	errorSent map[types.Object]*ast.CallExpr
+}
+
+func (st responseState) clone() responseState {
+	cloned := responseState{
+		written:   make(map[types.Object]*ast.CallExpr, len(st.written)),
+		errorSent: make(map[types.Object]*ast.CallExpr, len(st.errorSent)),
+	}
+	for k, v := range st.written {
+		cloned.written[k] = v
+	}
+	for k, v := range st.errorSent {
+		cloned.errorSent[k] = v
+	}
+	return cloned
+}
+
+func (c *writeHeaderOrderChecker) VisitFuncDecl(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+	c.checkFunc(decl.Type, decl.Body)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.FuncLit); ok {
+			c.checkFunc(lit.Type, lit.Body)
+		}
+		return true
+	})
+}
+
+func (c *writeHeaderOrderChecker) checkFunc(typ *ast.FuncType, body *ast.BlockStmt) {
+	for _, field := range typ.Params.List {
+		if c.isResponseWriter(c.ctx.TypeOf(field.Type)) {
+			st := responseState{
+				written:   make(map[types.Object]*ast.CallExpr),
+				errorSent: make(map[types.Object]*ast.CallExpr),
+			}
+			c.walkStmts(body.List, st)
+			return
+		}
+	}
+}
+
+// walkStmts follows the statements list and records the writes.
+// Nested blocks get a copy of the state, so the writes there
+// are not visible after the branch.
+func (c *writeHeaderOrderChecker) walkStmts(list []ast.Stmt, st responseState) {
+	for _, stmt := range list {
+		if !c.walkStmt(stmt, st) {
+			return
+		}
+	}
+}
+
+// walkStmt returns false if the statements after stmt are not reachable.
+func (c *writeHeaderOrderChecker) walkStmt(stmt ast.Stmt, st responseState) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return false
+	case *ast.LabeledStmt:
+		return c.walkStmt(stmt.Stmt, st)
+	case *ast.BlockStmt:
+		c.walkStmts(stmt.List, st)
+	case *ast.ExprStmt:
+		if call, ok := astutil.Unparen(stmt.X).(*ast.CallExpr); ok {
+			c.visitCall(call, st)
+		}
+	case *ast.AssignStmt:
+		for _, rhs := range stmt.Rhs {
+			if call, ok := astutil.Unparen(rhs).(*ast.CallExpr); ok {
+				c.visitCall(call, st)
+			}
+		}
+	case *ast.IfStmt:
+		if stmt.Init != nil {
+			c.walkStmt(stmt.Init, st)
+		}
+		c.walkStmts(stmt.Body.List, st.clone())
+		if stmt.Else != nil {
+			c.walkStmt(stmt.Else, st.clone())
+		}
+	case *ast.ForStmt:
+		c.walkStmts(stmt.Body.List, st.clone())
+	case *ast.RangeStmt:
+		c.walkStmts(stmt.Body.List, st.clone())
+	case *ast.SwitchStmt:
+		c.walkClauses(stmt.Body, st)
+	case *ast.TypeSwitchStmt:
+		c.walkClauses(stmt.Body, st)
+	case *ast.SelectStmt:
+		c.walkClauses(stmt.Body, st)
+	}
+	return true
+}
+
+func (c *writeHeaderOrderChecker) walkClauses(body *ast.BlockStmt, st responseState) {
+	for _, clause := range body.List {
+		switch clause := clause.(type) {
+		case *ast.CaseClause:
+			c.walkStmts(clause.Body, st.clone())
+		case *ast.CommClause:
+			c.walkStmts(clause.Body, st.clone())
+		}
+	}
+}
+
+func (c *writeHeaderOrderChecker) visitCall(call *ast.CallExpr, st responseState) {
+	w, isWriteHeader := c.responseWrite(call)
+	if w == nil {
+		return
+	}
+	if errCall := st.errorSent[w]; errCall != nil {
+		c.ctx.Warn(call, "%s writes to the response after %s; return after sending the error",
+			call.Fun, errCall.Fun)
+		return
+	}
+	if prev := st.written[w]; prev != nil && isWriteHeader {
+		c.ctx.Warn(call, "%s has no effect after %s, the response status is already sent",
+			call.Fun, prev.Fun)
+	}
+	if st.written[w] == nil {
+		st.written[w] = call
+	}
+	if calledFuncName(c.ctx.TypesInfo, call) == "net/http.Error" {
+		st.errorSent[w] = call
+	}
+}
+
+// responseWrite returns the response writer that is written to by call.
+// isWriteHeader is true for the WriteHeader method calls.
+func (c *writeHeaderOrderChecker) responseWrite(call *ast.CallExpr) (w types.Object, isWriteHeader bool) {
+	var x ast.Expr
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && c.isResponseWriter(c.ctx.TypeOf(sel.X)) {
+		switch sel.Sel.Name {
+		case "Write", "WriteHeader":
+			x = sel.X
+		}
+		isWriteHeader = sel.Sel.Name == "WriteHeader"
+	} else if i, ok := responseWriteFuncs[calledFuncName(c.ctx.TypesInfo, call)]; ok && i < len(call.Args) {
+		x = call.Args[i]
+	}
+	if x == nil || !c.isResponseWriter(c.ctx.TypeOf(x)) {
+		return nil, false
+	}
+	id := astcast.ToIdent(astutil.Unparen(x))
+	if id.Name == "" {
+		return nil, false
+	}
+	return c.ctx.TypesInfo.ObjectOf(id), isWriteHeader
+}
+
+func (c *writeHeaderOrderChecker) isResponseWriter(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "net/http" && obj.Name() == "ResponseWriter"
+}
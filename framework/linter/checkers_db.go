@@ -1,6 +1,7 @@
 package linter
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -111,7 +112,7 @@ var validIdentRE = regexp.MustCompile(`^\w+$`)
 
 func validateCheckerName(info *CheckerInfo) error {
 	if !validIdentRE.MatchString(info.Name) {
-		return fmt.Errorf("checker name contains illegal chars")
+		return errors.New("checker name contains illegal chars")
 	}
 	return nil
 }
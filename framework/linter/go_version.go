@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GoVersion describes a Go language version the checked code is targeting.
+//
+// Zero value describes an unspecified version, see IsAny.
+type GoVersion struct {
+	Major int
+	Minor int
+}
+
+// ParseGoVersion parses a version string like "1.16" or "go1.21.3".
+// Patch version and pre-release suffixes are ignored.
+//
+// An empty string results in an unspecified version (see GoVersion.IsAny).
+func ParseGoVersion(version string) (GoVersion, error) {
+	var v GoVersion
+	s := strings.TrimPrefix(version, "go")
+	if s == "" {
+		return v, nil
+	}
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return v, fmt.Errorf("invalid go version %q: expected major.minor", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return v, fmt.Errorf("invalid go version %q: bad major version", version)
+	}
+	minor, err := strconv.Atoi(strings.TrimRightFunc(parts[1], func(r rune) bool {
+		return r < '0' || r > '9'
+	}))
+	if err != nil {
+		return v, fmt.Errorf("invalid go version %q: bad minor version", version)
+	}
+	v.Major = major
+	v.Minor = minor
+	return v, nil
+}
+
+// IsAny reports whether the version is unspecified.
+//
+// Checkers should not do any version-specific filtering
+// when target version is unknown.
+func (v GoVersion) IsAny() bool { return v == GoVersion{} }
+
+// GreaterOrEqual reports whether v is the same or a newer version than other.
+func (v GoVersion) GreaterOrEqual(other GoVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+// String returns the version in "major.minor" form.
+func (v GoVersion) String() string {
+	if v.IsAny() {
+		return "any"
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
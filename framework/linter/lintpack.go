@@ -130,6 +130,16 @@ func (c *Checker) Check(f *ast.File) []Warning {
 	return c.ctx.warnings
 }
 
+// CheckPackage runs the package-level analysis for rule checkers
+// that implement PackageWalker; for other checkers it does nothing.
+//
+// Must be called before the Check calls for the package files.
+func (c *Checker) CheckPackage(files []*ast.File) {
+	if w, ok := c.fileWalker.(PackageWalker); ok {
+		w.WalkPackage(files)
+	}
+}
+
 // Warning represents issue that is found by checker.
 type Warning struct {
 	// Node is an AST node that caused warning to trigger.
@@ -138,6 +148,46 @@ type Warning struct {
 
 	// Text is warning message without source location info.
 	Text string
+
+	// Suggestion is a quick fix for a given problem.
+	// QuickFix edits are analysis.TextEdit and can be used to
+	// construct an analysis.SuggestedFix object.
+	//
+	// For convenience, there is Warning.HasQuickFix() method
+	// that reports whether Suggestion has something meaningful.
+	Suggestion QuickFix
+}
+
+// HasQuickFix reports whether this warning has a suggested fix.
+func (warn *Warning) HasQuickFix() bool {
+	return warn.Suggestion.Replacement != nil
+}
+
+// QuickFix is our analysis.TextEdit; we're using it here to avoid
+// direct analysis package dependency for now.
+type QuickFix struct {
+	From        token.Pos
+	To          token.Pos
+	Replacement []byte
+
+	// AdditionalEdits must be applied together with the edit above,
+	// like the call site updates for a changed function signature.
+	// They can belong to the other files of the package.
+	AdditionalEdits []TextEdit
+}
+
+// TextEdit is a single replacement of the QuickFix text.
+type TextEdit struct {
+	From        token.Pos
+	To          token.Pos
+	Replacement []byte
+}
+
+// Edits returns all fix edits, starting from the main one.
+func (fix QuickFix) Edits() []TextEdit {
+	edits := make([]TextEdit, 0, len(fix.AdditionalEdits)+1)
+	edits = append(edits, TextEdit{From: fix.From, To: fix.To, Replacement: fix.Replacement})
+	return append(edits, fix.AdditionalEdits...)
 }
 
 // NewChecker returns initialized checker identified by an info.
@@ -166,6 +216,10 @@ type Context struct {
 	// Filename is a currently checked file name.
 	Filename string
 
+	// GoVersion is a target Go version.
+	// Unspecified (see GoVersion.IsAny) if unknown.
+	GoVersion GoVersion
+
 	// Require records what optional resources are required
 	// by the checkers set that use this context.
 	//
@@ -243,6 +297,15 @@ func (ctx *CheckerContext) Warn(node ast.Node, format string, args ...interface{
 	})
 }
 
+// WarnFixable emits a warning with a fix suggestion provided by the caller.
+func (ctx *CheckerContext) WarnFixable(node ast.Node, fix QuickFix, format string, args ...interface{}) {
+	ctx.warnings = append(ctx.warnings, Warning{
+		Text:       ctx.printer.Sprintf(format, args...),
+		Node:       node,
+		Suggestion: fix,
+	})
+}
+
 // UnknownType is a special sentinel value that is returned from the CheckerContext.TypeOf
 // method instead of the nil type.
 var UnknownType types.Type = types.Typ[types.Invalid]
@@ -271,3 +334,14 @@ func (ctx *CheckerContext) TypeOf(x ast.Expr) types.Type {
 type FileWalker interface {
 	WalkFile(*ast.File)
 }
+
+// PackageWalker is an optional interface that can be implemented
+// by a FileWalker that needs to inspect the whole package.
+//
+// The WalkPackage method is executed once for every package,
+// before the WalkFile calls for the package files.
+// It's not expected to report warnings, they should be emitted
+// from the WalkFile instead.
+type PackageWalker interface {
+	WalkPackage(files []*ast.File)
+}
@@ -79,6 +79,8 @@ type program struct {
 	gopath  string
 	goroot  string
 
+	goVersion linter.GoVersion
+
 	exitCode           int
 	checkTests         bool
 	checkGenerated     bool
@@ -108,6 +110,10 @@ func (p *program) runCheckers() error {
 
 func (p *program) checkPackage(pkg *packages.Package) {
 	p.ctx.SetPackageInfo(pkg.TypesInfo, pkg.Types)
+	p.ctx.GoVersion = p.packageGoVersion(pkg)
+	for _, c := range p.checkers {
+		c.CheckPackage(pkg.Syntax)
+	}
 	for _, f := range pkg.Syntax {
 		filename := p.getFilename(f)
 		if !p.checkTests && strings.HasSuffix(filename, "_test.go") {
@@ -121,6 +127,22 @@ func (p *program) checkPackage(pkg *packages.Package) {
 	}
 }
 
+// packageGoVersion returns the Go version pkg is targeting.
+// Explicitly specified version has the priority over the module go directive.
+func (p *program) packageGoVersion(pkg *packages.Package) linter.GoVersion {
+	if !p.goVersion.IsAny() || pkg.Module == nil {
+		return p.goVersion
+	}
+	v, err := linter.ParseGoVersion(pkg.Module.GoVersion)
+	if err != nil {
+		if p.verbose {
+			log.Printf("\tdebug: %s: %v", pkg.String(), err)
+		}
+		return p.goVersion
+	}
+	return v
+}
+
 func (p *program) checkFile(f *ast.File) {
 	warnings := make([][]linter.Warning, len(p.checkers))
 
@@ -257,7 +279,8 @@ func (p *program) loadProgram() error {
 		packages.NeedTypes |
 		packages.NeedSyntax |
 		packages.NeedTypesInfo |
-		packages.NeedTypesSizes
+		packages.NeedTypesSizes |
+		packages.NeedModule
 	cfg := packages.Config{
 		Mode:  mode,
 		Tests: true,
@@ -328,7 +351,7 @@ func (p *program) checkerParamKey(info *linter.CheckerInfo, pname string) string
 
 // bindDefaultEnabledList calculates the default value for -enable param.
 func (p *program) bindDefaultEnabledList() error {
-	var enabled []string
+	enabled := make([]string, 0, len(p.infoList))
 	for _, info := range p.infoList {
 		enable := !info.HasTag("experimental") &&
 			!info.HasTag("opinionated") &&
@@ -359,9 +382,17 @@ func (p *program) parseArgs() error {
 		`whether to use colored output`)
 	flag.BoolVar(&p.verbose, "v", false,
 		`whether to print output useful during linter debugging`)
+	goVersion := flag.String("go", "",
+		`target Go version, like 1.16; if empty, the module go directive is used`)
 
 	flag.Parse()
 
+	v, err := linter.ParseGoVersion(*goVersion)
+	if err != nil {
+		return err
+	}
+	p.goVersion = v
+
 	p.packages = flag.Args()
 	p.filters.enable = strings.Split(*enable, ",")
 	p.filters.disable = strings.Split(*disable, ",")
@@ -446,14 +477,12 @@ func (p *program) shortenLocation(loc string) string {
 }
 
 func printWarning(p *program, rule, loc, warn string) {
-	switch {
-	case p.coloredOutput:
+	if p.coloredOutput {
 		log.Printf("%v: %v: %v\n",
 			aurora.Magenta(aurora.Bold(loc)),
 			aurora.Red(rule),
 			warn)
-
-	default:
+	} else {
 		log.Printf("%s: %s: %s\n", loc, rule, warn)
 	}
 }
@@ -1,7 +1,7 @@
 package hotload
 
 import (
-	"fmt"
+	"errors"
 	"plugin"
 
 	"github.com/go-critic/go-critic/framework/linter"
@@ -24,7 +24,7 @@ func CheckersFromDylib(infoList []*linter.CheckerInfo, path string) ([]*linter.C
 	maybeUpdatedList := linter.GetCheckersInfo()
 	checkersAfter := len(maybeUpdatedList)
 	if checkersBefore == checkersAfter {
-		return infoList, fmt.Errorf("loaded plugin doesn't provide any gocritic-compatible checkers")
+		return infoList, errors.New("loaded plugin doesn't provide any gocritic-compatible checkers")
 	}
 	return maybeUpdatedList, nil
 }
@@ -15,12 +15,9 @@ type Config struct {
 	Name    string
 }
 
-var config *Config
-
 // Run executes corresponding main after sub-command resolving.
 // Does not return.
 func Run(cfg Config) {
-	config = &cfg // TODO(quasilyte): don't use global var for this
 	log.SetFlags(0)
 
 	// makeExample replaces all ${linter} placeholders to a bound linter name.
@@ -42,7 +39,7 @@ func Run(cfg Config) {
 				"%s check -v -enable='#diagnostic' -disable='#experimental,#opinionated' ./..."),
 		},
 		{
-			Main:     printVersion,
+			Main:     func() { printVersion(&cfg) },
 			Name:     "version",
 			Short:    "print linter version",
 			Examples: makeExamples("%s version"),
@@ -61,6 +58,6 @@ func Run(cfg Config) {
 	cmdutil.DispatchCommand(subCommands)
 }
 
-func printVersion() {
+func printVersion(config *Config) {
 	log.Println(config.Version)
 }
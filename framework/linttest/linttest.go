@@ -1,6 +1,7 @@
 package linttest
 
 import (
+	"bytes"
 	"go/ast"
 	"go/token"
 	"go/types"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"testing"
 
@@ -45,6 +47,7 @@ func saneCheckersList(t *testing.T, checkers []*linter.CheckerInfo) []*linter.Ch
 						saneList = append(saneList, info)
 					}
 				}()
+				c.CheckPackage(pkg.Syntax)
 				for _, f := range pkg.Syntax {
 					ctx.SetFileInfo(getFilename(fset, f), f)
 					_ = c.Check(f)
@@ -131,13 +134,22 @@ func checkTarget(t *testing.T, target lintTarget, info *linter.CheckerInfo) {
 		if err != nil {
 			t.Errorf("Unexpected error: %v\n%s", err, debug.Stack())
 		}
+		c.CheckPackage(pkg.Syntax)
+		var warnings []linter.Warning
 		for _, f := range pkg.Syntax {
-			checkFile(t, c, ctx, f)
+			warnings = append(warnings, checkFile(t, c, ctx, f)...)
+		}
+		// The fixes can edit the other files of the package,
+		// so they are checked after all files warnings are collected.
+		for _, f := range pkg.Syntax {
+			testFilename := filepath.Join("testdata", info.Name, getFilename(fset, f))
+			checkQuickFixes(t, fset, testFilename, fset.File(f.Pos()), warnings)
 		}
 	}
 }
 
-func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File) {
+func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File) []linter.Warning {
+	t.Helper()
 	filename := getFilename(ctx.FileSet, f)
 	testFilename := filepath.Join("testdata", c.Info.Name, filename)
 
@@ -156,7 +168,8 @@ func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File
 	ctx.SetFileInfo(filename, f)
 
 	matched := make(map[*string]struct{})
-	for _, warn := range c.Check(f) {
+	warnings := c.Check(f)
+	for _, warn := range warnings {
 		line := ctx.FileSet.Position(warn.Node.Pos()).Line
 
 		if w := ws.find(line, warn.Text); w != nil {
@@ -172,6 +185,62 @@ func checkFile(t *testing.T, c *linter.Checker, ctx *linter.Context, f *ast.File
 	}
 
 	checkUnmatched(ws, matched, t, testFilename)
+	return warnings
+}
+
+// checkQuickFixes applies all suggested fixes edits of the file to the test file
+// and compares the result with the "{testFilename}.golden" file contents.
+// If there is no golden file, this check is skipped.
+func checkQuickFixes(t *testing.T, fset *token.FileSet, testFilename string, file *token.File, warnings []linter.Warning) {
+	t.Helper()
+	want, err := os.ReadFile(testFilename + ".golden")
+	if err != nil {
+		if !os.IsNotExist(err) {
+			t.Fatalf("read golden file: %v", err)
+		}
+		return
+	}
+	src, err := os.ReadFile(testFilename)
+	if err != nil {
+		t.Fatalf("read file %q: %v", testFilename, err)
+	}
+
+	var fixes []linter.TextEdit
+	for _, warn := range warnings {
+		if !warn.HasQuickFix() {
+			continue
+		}
+		for _, edit := range warn.Suggestion.Edits() {
+			if fset.File(edit.From) == file {
+				fixes = append(fixes, edit)
+			}
+		}
+	}
+	// Apply fixes from the end of the file, so the
+	// offsets of the remaining fixes stay valid.
+	sort.Slice(fixes, func(i, j int) bool {
+		return fixes[i].From > fixes[j].From
+	})
+	have := src
+	for i, fix := range fixes {
+		if i != 0 && fix.To > fixes[i-1].From {
+			t.Errorf("%s: overlapping quick fixes at %s",
+				testFilename, fset.Position(fix.From))
+			return
+		}
+		from := fset.Position(fix.From).Offset
+		to := fset.Position(fix.To).Offset
+		var buf bytes.Buffer
+		buf.Write(have[:from])
+		buf.Write(fix.Replacement)
+		buf.Write(have[to:])
+		have = buf.Bytes()
+	}
+
+	if !bytes.Equal(have, want) {
+		t.Errorf("%s: quick fixes result doesn't match the golden file:\n%s",
+			testFilename, have)
+	}
 }
 
 // stripDirectives replaces "///" comments with empty single-line
@@ -193,6 +262,7 @@ func getFilename(fset *token.FileSet, f *ast.File) string {
 }
 
 func checkUnmatched(ws warnings, matched map[*string]struct{}, t *testing.T, testFilename string) {
+	t.Helper()
 	for line, sl := range ws {
 		for i, w := range sl {
 			if _, ok := matched[&sl[i]]; !ok {
@@ -203,6 +273,7 @@ func checkUnmatched(ws warnings, matched map[*string]struct{}, t *testing.T, tes
 }
 
 func newPackages(t *testing.T, pattern string, fset *token.FileSet) []*packages.Package {
+	t.Helper()
 	mode := packages.NeedName |
 		packages.NeedFiles |
 		packages.NeedCompiledGoFiles |
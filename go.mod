@@ -1,6 +1,6 @@
 module github.com/go-critic/go-critic
 
-go 1.12
+go 1.18
 
 require (
 	github.com/go-toolsmith/astcast v1.0.0
@@ -14,10 +14,15 @@ require (
 	github.com/google/go-cmp v0.5.2
 	github.com/logrusorgru/aurora v0.0.0-20181002194514-a7b3b318ed4e
 	github.com/mattn/goveralls v0.0.2
-	github.com/pborman/uuid v1.2.0 // indirect
 	github.com/quasilyte/go-consistent v0.0.0-20190521200055-c6f3937de18c
 	github.com/quasilyte/go-ruleguard v0.3.7
 	github.com/quasilyte/go-ruleguard/dsl v0.3.6
 	github.com/quasilyte/regex/syntax v0.0.0-20200407221936-30656e2c4a95
 	golang.org/x/tools v0.0.0-20201230224404-63754364767c
 )
+
+require (
+	github.com/pborman/uuid v1.2.0 // indirect
+	golang.org/x/mod v0.3.0 // indirect
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
+)